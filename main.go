@@ -13,7 +13,6 @@ import (
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers" // Load the providers
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/providers/registry"
 	"github.com/hashicorp/go-hclog"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
@@ -40,9 +39,7 @@ func main() {
 
 	providers := []func() tfprotov6.ProviderServer{
 		sdkProviderV6,
-		providerserver.NewProtocol6(
-			akamai.NewFrameworkProvider(registry.Subproviders()...)(),
-		),
+		akamai.NewProtoV6FrameworkProvider(registry.Subproviders()...),
 	}
 
 	muxServer, err := tf6muxserver.NewMuxServer(context.Background(), providers...)
@@ -7,8 +7,12 @@ import (
 
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -59,6 +63,26 @@ func (dummy) FrameworkResources() []func() resource.Resource {
 	return nil
 }
 
+// FrameworkFunctions implements subprovider.Subprovider.
+func (dummy) FrameworkFunctions() []func() function.Function {
+	return nil
+}
+
+// FrameworkEphemeralResources implements subprovider.Subprovider.
+func (dummy) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return nil
+}
+
+// FrameworkListResources implements subprovider.Subprovider.
+func (dummy) FrameworkListResources() []func() list.ListResource {
+	return nil
+}
+
+// FrameworkActions implements subprovider.Subprovider.
+func (dummy) FrameworkActions() []func() action.Action {
+	return nil
+}
+
 type dummyDataSource struct{}
 
 type dummyDataSourceModel struct {
@@ -0,0 +1,124 @@
+package akamai
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	akalog "github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type operationFunc = func(context.Context, *schema.ResourceData, any) diag.Diagnostics
+
+// withCacheStatistics makes CRUD operations of the resources log how they used the cache
+func withCacheStatistics(resources map[string]*schema.Resource) {
+	for name, res := range resources {
+		if res.CreateContext != nil {
+			res.CreateContext = logCacheStatistics(name, "create", res.CreateContext)
+		}
+		if res.ReadContext != nil {
+			res.ReadContext = logCacheStatistics(name, "read", res.ReadContext)
+		}
+		if res.UpdateContext != nil {
+			res.UpdateContext = logCacheStatistics(name, "update", res.UpdateContext)
+		}
+		if res.DeleteContext != nil {
+			res.DeleteContext = logCacheStatistics(name, "delete", res.DeleteContext)
+		}
+	}
+}
+
+// logCacheStatistics logs the cache operations made by the operation in the operation log
+func logCacheStatistics(name, operation string, f operationFunc) operationFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		ctx = cache.ContextWithOperationStatistics(ctx)
+		diags := f(ctx, d, m)
+
+		var logger akalog.Interface = log.FromContext(ctx, "Cache", name)
+		if opMeta, ok := m.(meta.Meta); ok {
+			logger = opMeta.Log("Cache", name)
+		}
+		logOperationStatistics(ctx, logger, operation)
+		return diags
+	}
+}
+
+// logOperationStatistics logs the counters of cache operations made with the context, for every bucket used
+func logOperationStatistics(ctx context.Context, logger akalog.Interface, operation string) {
+	stats := cache.OperationStatistics(ctx)
+	buckets := make([]string, 0, len(stats))
+	for bucket := range stats {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+
+	for _, bucket := range buckets {
+		s := stats[bucket]
+		if s.IsZero() {
+			continue
+		}
+		logger.Infof("cache statistics of %s for bucket %s: %d hits, %d misses, %d evictions, %d bytes",
+			operation, bucket, s.Hits, s.Misses, s.Evictions, s.Bytes)
+	}
+}
+
+// frameworkProviderServer is the set of RPCs served by the framework provider
+type frameworkProviderServer interface {
+	tfprotov6.ProviderServer
+	tfprotov6.ListResourceServer
+	tfprotov6.ActionServer
+}
+
+// cacheStatisticsServer makes CRUD operations of the framework resources and data sources log how they used the cache,
+// like withCacheStatistics does for the SDK ones
+type cacheStatisticsServer struct {
+	frameworkProviderServer
+}
+
+// withFrameworkCacheStatistics wraps the server of the framework provider, so that its operations log how they used the cache
+func withFrameworkCacheStatistics(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	fwServer, ok := server.(frameworkProviderServer)
+	if !ok {
+		return server
+	}
+	return cacheStatisticsServer{fwServer}
+}
+
+func (s cacheStatisticsServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx = cache.ContextWithOperationStatistics(ctx)
+	resp, err := s.frameworkProviderServer.ReadResource(ctx, req)
+	logOperationStatistics(ctx, log.FromContext(ctx, "Cache", req.TypeName), "read")
+	return resp, err
+}
+
+func (s cacheStatisticsServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	operation := "update"
+	if isNullValue(req.PriorState) {
+		operation = "create"
+	} else if isNullValue(req.PlannedState) {
+		operation = "delete"
+	}
+
+	ctx = cache.ContextWithOperationStatistics(ctx)
+	resp, err := s.frameworkProviderServer.ApplyResourceChange(ctx, req)
+	logOperationStatistics(ctx, log.FromContext(ctx, "Cache", req.TypeName), operation)
+	return resp, err
+}
+
+func (s cacheStatisticsServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx = cache.ContextWithOperationStatistics(ctx)
+	resp, err := s.frameworkProviderServer.ReadDataSource(ctx, req)
+	logOperationStatistics(ctx, log.FromContext(ctx, "Cache", req.TypeName), "read")
+	return resp, err
+}
+
+// isNullValue tells whether the value sent by terraform is null, that is missing or encoded as msgpack nil
+func isNullValue(v *tfprotov6.DynamicValue) bool {
+	return v == nil || (len(v.MsgPack) == 0 && len(v.JSON) == 0) || bytes.Equal(v.MsgPack, []byte{0xc0})
+}
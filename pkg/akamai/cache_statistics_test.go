@@ -0,0 +1,122 @@
+package akamai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCacheStatistics(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, cache.Configure(cache.Config{}))
+		cache.ResetStatistics()
+	})
+	require.NoError(t, cache.Configure(cache.Config{Enabled: true}))
+
+	var calls []string
+	operation := func(name string) operationFunc {
+		return func(ctx context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+			calls = append(calls, name)
+			var out string
+			_ = cache.Get(ctx, cache.BucketName("statistics"), name, &out)
+			assert.Equal(t, cache.Stats{Misses: 1}, cache.OperationStatistics(ctx)["statistics"])
+			return diag.Errorf("%s failed", name)
+		}
+	}
+	resources := map[string]*schema.Resource{
+		"akamai_resource": {
+			CreateContext: operation("create"),
+			ReadContext:   operation("read"),
+			UpdateContext: operation("update"),
+			DeleteContext: operation("delete"),
+		},
+		"akamai_data_source": {
+			ReadContext: operation("data"),
+		},
+	}
+
+	withCacheStatistics(resources)
+
+	sess, err := session.New()
+	require.NoError(t, err)
+	opMeta, err := meta.New(sess, hclog.NewNullLogger(), "operation")
+	require.NoError(t, err)
+	ctx := context.Background()
+	res, ds := resources["akamai_resource"], resources["akamai_data_source"]
+	assert.Equal(t, diag.Errorf("create failed"), res.CreateContext(ctx, nil, opMeta))
+	assert.Equal(t, diag.Errorf("read failed"), res.ReadContext(ctx, nil, opMeta))
+	assert.Equal(t, diag.Errorf("update failed"), res.UpdateContext(ctx, nil, opMeta))
+	assert.Equal(t, diag.Errorf("delete failed"), res.DeleteContext(ctx, nil, opMeta))
+	assert.Equal(t, diag.Errorf("data failed"), ds.ReadContext(ctx, nil, nil))
+	assert.Nil(t, ds.CreateContext)
+
+	assert.Equal(t, []string{"create", "read", "update", "delete", "data"}, calls)
+	assert.Equal(t, uint64(5), cache.Statistics()["statistics"].Misses)
+}
+
+type stubFrameworkServer struct {
+	frameworkProviderServer
+	t *testing.T
+}
+
+func (s stubFrameworkServer) operation(ctx context.Context, name string) {
+	var out string
+	_ = cache.Get(ctx, cache.BucketName("statistics"), name, &out)
+	assert.Equal(s.t, cache.Stats{Misses: 1}, cache.OperationStatistics(ctx)["statistics"])
+}
+
+func (s stubFrameworkServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	s.operation(ctx, req.TypeName)
+	return &tfprotov6.ReadResourceResponse{}, nil
+}
+
+func (s stubFrameworkServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	s.operation(ctx, req.TypeName)
+	return &tfprotov6.ApplyResourceChangeResponse{}, nil
+}
+
+func (s stubFrameworkServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	s.operation(ctx, req.TypeName)
+	return &tfprotov6.ReadDataSourceResponse{}, nil
+}
+
+func TestWithFrameworkCacheStatistics(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, cache.Configure(cache.Config{}))
+		cache.ResetStatistics()
+	})
+	require.NoError(t, cache.Configure(cache.Config{Enabled: true}))
+	cache.ResetStatistics()
+
+	server := withFrameworkCacheStatistics(stubFrameworkServer{t: t})
+	_, ok := server.(tfprotov6.ActionServer)
+	assert.True(t, ok)
+
+	ctx := context.Background()
+	state := &tfprotov6.DynamicValue{MsgPack: []byte{0x80}}
+	_, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{TypeName: "akamai_resource"})
+	require.NoError(t, err)
+	_, err = server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{TypeName: "akamai_resource", PriorState: state})
+	require.NoError(t, err)
+	_, err = server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{TypeName: "akamai_data_source"})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(3), cache.Statistics()["statistics"].Misses)
+	assert.Nil(t, cache.OperationStatistics(ctx))
+}
+
+func TestIsNullValue(t *testing.T) {
+	assert.True(t, isNullValue(nil))
+	assert.True(t, isNullValue(&tfprotov6.DynamicValue{}))
+	assert.True(t, isNullValue(&tfprotov6.DynamicValue{MsgPack: []byte{0xc0}}))
+	assert.False(t, isNullValue(&tfprotov6.DynamicValue{MsgPack: []byte{0x80}}))
+}
@@ -2,12 +2,16 @@ package akamai
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,21 +21,48 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/ratelimit"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/recorder"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/retryablehttp"
 	"github.com/google/uuid"
 	"github.com/spf13/cast"
 )
 
+type rateLimitConfig struct {
+	api               string
+	requestsPerSecond int
+	burst             int
+}
+
+// retryRule configures retries of API requests to paths starting with pathPrefix
+type retryRule struct {
+	pathPrefix  string
+	methods     []string
+	statusCodes []int
+	maxAttempts int
+	backoff     string
+}
+
+const (
+	backoffExponential  = "exponential"
+	backoffLinearJitter = "linear_jitter"
+)
+
+// retryableMethods are idempotent methods, whose requests can be safely sent again
+var retryableMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
 type contextConfig struct {
 	edgegridConfig *edgegrid.Config
 	userAgent      string
 	ctx            context.Context
 	requestLimit   int
-	enableCache    bool
+	cache          cache.Config
+	rateLimits     map[string]ratelimit.Limit
 	retryMax       int
 	retryWaitMin   time.Duration
 	retryWaitMax   time.Duration
 	retryDisabled  bool
+	retryPolicy    []retryRule
 }
 
 func configureContext(cfg contextConfig) (*meta.OperationMeta, error) {
@@ -48,23 +79,96 @@ func configureContext(cfg contextConfig) (*meta.OperationMeta, error) {
 	var sess session.Session
 	var err error
 	if cfg.retryDisabled {
-		sess, err = sessionWithoutRetry(opts)
+		sess, err = sessionWithoutRetry(cfg, opts)
 	} else {
 		sess, err = sessionWithRetry(cfg, opts)
 	}
 	if err != nil {
 		return nil, err
 	}
-	cache.Enable(cfg.enableCache)
+	if err := configureCache(cfg); err != nil {
+		return nil, err
+	}
 
 	return meta.New(sess, log.HCLog(), operationID)
 }
 
-func sessionWithoutRetry(opts []session.Option) (session.Session, error) {
+// configureCache sets up the cache backend. Objects cached on disk are kept separately
+// for each set of credentials, so that they are never served to a different account.
+func configureCache(cfg contextConfig) error {
+	cacheConfig := cfg.cache
+	if cacheConfig.Backend == cache.BackendFile && cacheConfig.Dir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return fmt.Errorf("cannot determine the cache directory, provide it in 'cache_dir': %w", err)
+		}
+		cacheConfig.Dir = filepath.Join(dir, "terraform-provider-akamai")
+	}
+	credentials := strings.Join([]string{cfg.edgegridConfig.Host, cfg.edgegridConfig.ClientToken, cfg.edgegridConfig.AccountKey}, "\n")
+	sum := sha256.Sum256([]byte(credentials))
+	cacheConfig.Namespace = hex.EncodeToString(sum[:8])
+
+	return cache.Configure(cacheConfig)
+}
+
+// cacheBucketTTLs converts TTLs in seconds configured for cache buckets into durations
+func cacheBucketTTLs(ttls map[string]int) (map[string]time.Duration, error) {
+	if len(ttls) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]time.Duration, len(ttls))
+	for bucket, ttl := range ttls {
+		if ttl < 0 {
+			return nil, fmt.Errorf("wrong cache values: TTL of bucket %q (%d) cannot be negative", bucket, ttl)
+		}
+		result[bucket] = time.Duration(ttl) * time.Second
+	}
+	return result, nil
+}
+
+// recordingTransport wraps base with a transport recording API interactions to a cassette file or replaying them,
+// when enabled with AKAMAI_HTTP_RECORDING_MODE. The cassette file is given in AKAMAI_HTTP_CASSETTE.
+func recordingTransport(base http.RoundTripper) (http.RoundTripper, error) {
+	mode := os.Getenv("AKAMAI_HTTP_RECORDING_MODE")
+	if mode == "" {
+		return base, nil
+	}
+	transport, err := recorder.NewTransport(base, recorder.Mode(mode), os.Getenv("AKAMAI_HTTP_CASSETTE"))
+	if err != nil {
+		return nil, fmt.Errorf("configuring HTTP recording failed: %w", err)
+	}
+	return transport, nil
+}
+
+func sessionWithoutRetry(cfg contextConfig, opts []session.Option) (session.Session, error) {
+	base, err := recordingTransport(http.DefaultTransport)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := ratelimit.NewTransport(base, cfg.rateLimits)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, session.WithClient(&http.Client{Transport: transport}))
 	return session.New(opts...)
 }
 
-func overrideRetryPolicy(basePolicy retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+// rateLimits converts rate limits configured for APIs, making sure that every API is configured once
+func rateLimits(configs []rateLimitConfig) (map[string]ratelimit.Limit, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	limits := make(map[string]ratelimit.Limit, len(configs))
+	for _, c := range configs {
+		if _, ok := limits[c.api]; ok {
+			return nil, fmt.Errorf("wrong rate limit values: rate limit of API %q is configured more than once", c.api)
+		}
+		limits[c.api] = ratelimit.Limit{RequestsPerSecond: c.requestsPerSecond, Burst: c.burst}
+	}
+	return limits, nil
+}
+
+func overrideRetryPolicy(basePolicy retryablehttp.CheckRetry, rules []retryRule) retryablehttp.CheckRetry {
 	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
 
 		// do not retry on context.Canceled or context.DeadlineExceeded
@@ -72,6 +176,11 @@ func overrideRetryPolicy(basePolicy retryablehttp.CheckRetry) retryablehttp.Chec
 			return false, ctx.Err()
 		}
 
+		// Configured rules take precedence over the policy below
+		if rule := applyingRetryRule(rules, resp, err); rule != nil {
+			return rule.shouldRetry(ctx, basePolicy, resp, err)
+		}
+
 		// Below we handle 429 Too Many Requests for a valid response
 		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
 			// If the request is PATCH hostname bucket resulting in 429 (default cert limit exceeded), do not retry it
@@ -108,6 +217,77 @@ func overrideRetryPolicy(basePolicy retryablehttp.CheckRetry) retryablehttp.Chec
 	}
 }
 
+// findRetryRule returns the first rule applying to the request path, or nil if there is none
+func findRetryRule(rules []retryRule, path string) *retryRule {
+	for i := range rules {
+		if strings.HasPrefix(path, rules[i].pathPrefix) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// applyingRetryRule returns the rule deciding about retrying the request which resulted in the response or error,
+// or nil if the request is handled like requests without a rule. Both the retry decision and the retry settings
+// of the request come from this rule.
+func applyingRetryRule(rules []retryRule, resp *http.Response, err error) *retryRule {
+	method, path, ok := requestMethodAndPath(resp, err)
+	if !ok {
+		return nil
+	}
+	rule := findRetryRule(rules, path)
+	if rule == nil || !rule.appliesTo(method, resp) {
+		return nil
+	}
+	return rule
+}
+
+// appliesTo reports whether the rule applies to the request with the method and its response. The rule applies only
+// to requests with the configured methods, GET by default, and only to responses with the configured status codes, if any.
+func (r retryRule) appliesTo(method string, resp *http.Response) bool {
+	if !slices.Contains(r.retriedMethods(), method) {
+		return false
+	}
+	return resp == nil || len(r.statusCodes) == 0 || slices.Contains(r.statusCodes, resp.StatusCode)
+}
+
+// shouldRetry decides about retrying the request according to the rule applying to it. Responses with
+// the configured status codes are retried. Without configured status codes, responses are retried like
+// GET requests by default.
+func (r retryRule) shouldRetry(ctx context.Context, basePolicy retryablehttp.CheckRetry, resp *http.Response, err error) (bool, error) {
+	if resp == nil {
+		return basePolicy(ctx, resp, err)
+	}
+	if len(r.statusCodes) > 0 || resp.StatusCode == http.StatusConflict {
+		return true, nil
+	}
+	return basePolicy(ctx, resp, err)
+}
+
+// retriedMethods returns the methods of requests the rule applies to
+func (r retryRule) retriedMethods() []string {
+	if len(r.methods) == 0 {
+		return []string{http.MethodGet}
+	}
+	return r.methods
+}
+
+// requestMethodAndPath returns the method and path of the request which resulted in the response or error
+func requestMethodAndPath(resp *http.Response, err error) (string, string, bool) {
+	if resp != nil && resp.Request != nil {
+		return resp.Request.Method, resp.Request.URL.Path, true
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		u, e := url.Parse(urlErr.URL)
+		if e != nil {
+			return "", "", false
+		}
+		return strings.ToUpper(urlErr.Op), u.Path, true
+	}
+	return "", "", false
+}
+
 // getRateLimitBackoff extracts the backoff duration from the given headerKey
 // in the http.Response headers. Supports "X-RateLimit-Next" and "Akamai-RateLimit-Next".
 // Note that Date's resolution is seconds (e.g. Mon, 01 Jul 2024 14:32:14 GMT),
@@ -190,6 +370,17 @@ func sessionWithRetry(cfg contextConfig, opts []session.Option) (session.Session
 	retryClient.RetryWaitMin = cfg.retryWaitMin
 	retryClient.RetryWaitMax = cfg.retryWaitMax
 
+	// every attempt is recorded and limited, so that retries also count towards the rate limits
+	base, err := recordingTransport(retryClient.HTTPClient.Transport)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := ratelimit.NewTransport(base, cfg.rateLimits)
+	if err != nil {
+		return nil, err
+	}
+	retryClient.HTTPClient.Transport = transport
+
 	opts = append(opts, session.WithClient(retryClient.StandardClient()))
 	sess, err := session.New(opts...)
 	if err != nil {
@@ -204,9 +395,24 @@ func sessionWithRetry(cfg contextConfig, opts []session.Option) (session.Session
 		return sess.Sign(req)
 	}
 
-	retryClient.CheckRetry = overrideRetryPolicy(retryablehttp.DefaultRetryPolicy)
+	retryClient.CheckRetry = overrideRetryPolicy(retryablehttp.DefaultRetryPolicy, cfg.retryPolicy)
 	l := sess.Log(cfg.ctx)
 	retryClient.Backoff = overrideBackoff(retryablehttp.DefaultBackoff, l)
+	retryClient.RetrySettings = func(resp *http.Response, err error) (int, retryablehttp.Backoff) {
+		rule := applyingRetryRule(cfg.retryPolicy, resp, err)
+		if rule == nil {
+			return cfg.retryMax, retryClient.Backoff
+		}
+		retryMax := cfg.retryMax
+		if rule.maxAttempts > 0 {
+			retryMax = rule.maxAttempts - 1
+		}
+		backoff := retryablehttp.DefaultBackoff
+		if rule.backoff == backoffLinearJitter {
+			backoff = retryablehttp.LinearJitterBackoff
+		}
+		return retryMax, overrideBackoff(backoff, l)
+	}
 	retryClient.Logger = session.GetRetryableLogger(l)
 	return sess, nil
 }
@@ -231,5 +437,22 @@ func validateRetryConfiguration(cfg contextConfig) error {
 		return fmt.Errorf("wrong retry values: retry wait time too long, minimum retry wait time (%v) cannot be higher than %v or maximum retry wait time (%v) cannot be higher than %v", cfg.retryWaitMin, maxWaitTime, cfg.retryWaitMax, maxWaitTime)
 
 	}
+
+	for _, rule := range cfg.retryPolicy {
+		if !strings.HasPrefix(rule.pathPrefix, "/") {
+			return fmt.Errorf("wrong retry policy values: path prefix %q has to start with '/'", rule.pathPrefix)
+		}
+		for _, method := range rule.methods {
+			if !slices.Contains(retryableMethods, method) {
+				return fmt.Errorf("wrong retry policy values: requests with method %s to %q cannot be retried, only %s are allowed", method, rule.pathPrefix, strings.Join(retryableMethods, ", "))
+			}
+		}
+		if rule.maxAttempts < 0 || rule.maxAttempts > maxRetries+1 {
+			return fmt.Errorf("wrong retry policy values: maximum number of attempts of requests to %q (%d) cannot be negative or higher than %d", rule.pathPrefix, rule.maxAttempts, maxRetries+1)
+		}
+		if rule.backoff != "" && rule.backoff != backoffExponential && rule.backoff != backoffLinearJitter {
+			return fmt.Errorf("wrong retry policy values: unknown backoff %q of requests to %q", rule.backoff, rule.pathPrefix)
+		}
+	}
 	return nil
 }
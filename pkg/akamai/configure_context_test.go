@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -17,6 +18,8 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v9/internal/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/ratelimit"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/recorder"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/retryablehttp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -104,6 +107,43 @@ func Test_validateRetryConfiguration(t *testing.T) {
 			wantErr: true,
 			errMsg:  "wrong retry values: retry wait time too long, minimum retry wait time (1ns) cannot be higher than 24h0m0s or maximum retry wait time (25h0m0s) cannot be higher than 24h0m0s",
 		},
+		"valid retry policy": {
+			args: contextConfig{
+				retryPolicy: []retryRule{
+					{pathPrefix: "/appsec/", methods: []string{http.MethodPut, http.MethodDelete}, maxAttempts: 5, backoff: backoffLinearJitter},
+					{pathPrefix: "/config-gtm/", statusCodes: []int{http.StatusBadGateway}},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid retry policy - path prefix": {
+			args: contextConfig{
+				retryPolicy: []retryRule{{pathPrefix: "appsec"}},
+			},
+			wantErr: true,
+			errMsg:  `wrong retry policy values: path prefix "appsec" has to start with '/'`,
+		},
+		"invalid retry policy - non-idempotent method": {
+			args: contextConfig{
+				retryPolicy: []retryRule{{pathPrefix: "/appsec/", methods: []string{http.MethodPost}}},
+			},
+			wantErr: true,
+			errMsg:  `wrong retry policy values: requests with method POST to "/appsec/" cannot be retried, only GET, HEAD, OPTIONS, PUT, DELETE are allowed`,
+		},
+		"invalid retry policy - too many attempts": {
+			args: contextConfig{
+				retryPolicy: []retryRule{{pathPrefix: "/appsec/", maxAttempts: 52}},
+			},
+			wantErr: true,
+			errMsg:  `wrong retry policy values: maximum number of attempts of requests to "/appsec/" (52) cannot be negative or higher than 51`,
+		},
+		"invalid retry policy - unknown backoff": {
+			args: contextConfig{
+				retryPolicy: []retryRule{{pathPrefix: "/appsec/", backoff: "constant"}},
+			},
+			wantErr: true,
+			errMsg:  `wrong retry policy values: unknown backoff "constant" of requests to "/appsec/"`,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -127,7 +167,7 @@ func TestOverrideRetryPolicy(t *testing.T) {
 	basePolicy := func(_ context.Context, _ *http.Response, _ error) (bool, error) {
 		return false, errors.New("base policy: dummy, not implemented")
 	}
-	policy := overrideRetryPolicy(basePolicy)
+	policy := overrideRetryPolicy(basePolicy, nil)
 
 	tests := map[string]struct {
 		ctx            context.Context
@@ -269,6 +309,193 @@ func TestOverrideRetryPolicy(t *testing.T) {
 	}
 }
 
+func TestOverrideRetryPolicy_Rules(t *testing.T) {
+	basePolicy := func(_ context.Context, resp *http.Response, _ error) (bool, error) {
+		return resp == nil || resp.StatusCode >= http.StatusInternalServerError, nil
+	}
+	policy := overrideRetryPolicy(basePolicy, []retryRule{
+		{pathPrefix: "/appsec/v1/configs/1/", statusCodes: []int{http.StatusBadRequest}},
+		{pathPrefix: "/appsec/", methods: []string{http.MethodPut, http.MethodDelete}},
+		{pathPrefix: "/papi/"},
+	})
+
+	tests := map[string]struct {
+		resp           *http.Response
+		err            error
+		expectedResult bool
+	}{
+		"should retry for PUT with status 5xx": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodPut, "/appsec/v1/configs"),
+				StatusCode: http.StatusServiceUnavailable,
+			},
+			expectedResult: true,
+		},
+		"should retry for DELETE with status 409": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodDelete, "/appsec/v1/configs"),
+				StatusCode: http.StatusConflict,
+			},
+			expectedResult: true,
+		},
+		"should apply default policy for method not in rule": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodGet, "/appsec/v1/configs"),
+				StatusCode: http.StatusServiceUnavailable,
+			},
+			expectedResult: true,
+		},
+		"should not retry for method not in rule when default policy does not retry": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodPost, "/appsec/v1/configs"),
+				StatusCode: http.StatusServiceUnavailable,
+			},
+			expectedResult: false,
+		},
+		"should retry for GET by default": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodGet, "/papi/v1/sth"),
+				StatusCode: http.StatusBadGateway,
+			},
+			expectedResult: true,
+		},
+		"should apply default policy for POST with status 429": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodPost, "/papi/v1/sth"),
+				StatusCode: http.StatusTooManyRequests,
+			},
+			expectedResult: true,
+		},
+		"should apply the first matching rule": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodGet, "/appsec/v1/configs/1/versions"),
+				StatusCode: http.StatusBadRequest,
+			},
+			expectedResult: true,
+		},
+		"should apply default policy for status code not in rule": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodGet, "/appsec/v1/configs/1/versions"),
+				StatusCode: http.StatusServiceUnavailable,
+			},
+			expectedResult: true,
+		},
+		"should not retry for status code not in rule when default policy does not retry": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodGet, "/appsec/v1/configs/1/versions"),
+				StatusCode: http.StatusNotFound,
+			},
+			expectedResult: false,
+		},
+		"should retry for PUT on connection error": {
+			err:            &url.Error{Op: "Put", URL: "https://host/appsec/v1/configs", Err: errors.New("connection reset")},
+			expectedResult: true,
+		},
+		"should apply default policy when no rule matches": {
+			resp: &http.Response{
+				Request:    newRequest(t, http.MethodPost, "/ccm/v1/sth"),
+				StatusCode: http.StatusTooManyRequests,
+			},
+			expectedResult: true,
+		},
+	}
+	for name, tst := range tests {
+		t.Run(name, func(t *testing.T) {
+			shouldRetry, err := policy(context.Background(), tst.resp, tst.err)
+			assert.NoError(t, err)
+			assert.Equal(t, tst.expectedResult, shouldRetry)
+		})
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var putRequests, getRequests int
+	mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			putRequests++
+		} else {
+			getRequests++
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	sess := mockSession(t, mockServer, retryRule{
+		pathPrefix:  "/appsec/",
+		methods:     []string{http.MethodPut},
+		maxAttempts: 3,
+		backoff:     backoffLinearJitter,
+	}, retryRule{
+		pathPrefix:  "/config-gtm/",
+		statusCodes: []int{http.StatusBadRequest},
+		maxAttempts: 2,
+	})
+
+	req, err := http.NewRequest(http.MethodPut, mockServer.URL+"/appsec/v1/configs/1", nil)
+	require.NoError(t, err)
+	_, err = sess.Client().Do(req)
+	assert.ErrorContains(t, err, "giving up after 3 attempt(s)")
+	assert.Equal(t, 3, putRequests)
+
+	// GET requests are not covered by the rule and follow the provider retry settings
+	req, err = http.NewRequest(http.MethodGet, mockServer.URL+"/appsec/v1/configs/1", nil)
+	require.NoError(t, err)
+	_, err = sess.Client().Do(req)
+	assert.ErrorContains(t, err, "giving up after 11 attempt(s)")
+	assert.Equal(t, 11, getRequests)
+
+	// responses with status codes not covered by the rule follow the provider retry settings as well
+	getRequests = 0
+	req, err = http.NewRequest(http.MethodGet, mockServer.URL+"/config-gtm/v1/domains", nil)
+	require.NoError(t, err)
+	_, err = sess.Client().Do(req)
+	assert.ErrorContains(t, err, "giving up after 11 attempt(s)")
+	assert.Equal(t, 11, getRequests)
+}
+
+func TestRecording(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, (&recorder.Cassette{Interactions: []recorder.Interaction{{
+		Request:  recorder.Request{Method: http.MethodGet, URL: "/papi/v1/groups"},
+		Response: recorder.Response{StatusCode: http.StatusOK, Body: `{"accountId":"act_1"}`},
+	}}}).Save(cassette))
+
+	newMeta := func(retryDisabled bool) (*http.Client, error) {
+		meta, err := configureContext(contextConfig{
+			userAgent:      "terraform-provider-akamai",
+			edgegridConfig: &edgegrid.Config{Host: "akab-host.luna.akamaiapis.net"},
+			ctx:            context.Background(),
+			retryDisabled:  retryDisabled,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return meta.Session().Client(), nil
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		t.Setenv("AKAMAI_HTTP_RECORDING_MODE", "replay")
+		t.Setenv("AKAMAI_HTTP_CASSETTE", cassette)
+
+		for _, retryDisabled := range []bool{false, true} {
+			client, err := newMeta(retryDisabled)
+			require.NoError(t, err)
+			resp, err := client.Get("https://akab-host.luna.akamaiapis.net/papi/v1/groups")
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		}
+	})
+
+	t.Run("wrong mode", func(t *testing.T) {
+		t.Setenv("AKAMAI_HTTP_RECORDING_MODE", "rewind")
+		t.Setenv("AKAMAI_HTTP_CASSETTE", cassette)
+
+		_, err := newMeta(false)
+		assert.EqualError(t, err, `configuring HTTP recording failed: unsupported recording mode "rewind", supported modes are: record, replay`)
+	})
+}
+
 func stat429ResponseWaiting(wait time.Duration, header string) *http.Response {
 	res := http.Response{
 		StatusCode: http.StatusTooManyRequests,
@@ -345,7 +572,7 @@ func Test_overrideBackoff(t *testing.T) {
 	}
 }
 
-func mockSession(t *testing.T, mockServer *httptest.Server) session.Session {
+func mockSession(t *testing.T, mockServer *httptest.Server, retryPolicy ...retryRule) session.Session {
 	serverURL, err := url.Parse(mockServer.URL)
 	require.NoError(t, err)
 	config := edgegrid.Config{Host: serverURL.Host}
@@ -354,13 +581,16 @@ func mockSession(t *testing.T, mockServer *httptest.Server) session.Session {
 		userAgent:      "terraform-provider-akamai",
 		edgegridConfig: &config,
 		ctx:            context.Background(),
+		retryWaitMin:   time.Millisecond,
+		retryWaitMax:   10 * time.Millisecond,
+		retryPolicy:    retryPolicy,
 	})
 	assert.NoError(t, err)
 
 	certPool := x509.NewCertPool()
 	certPool.AddCert(mockServer.Certificate())
 	rt := meta.Session().Client().Transport.(*retryablehttp.RoundTripper)
-	transport := rt.Client.HTTPClient.Transport.(*http.Transport)
+	transport := rt.Client.HTTPClient.Transport.(*ratelimit.Transport).Base.(*http.Transport)
 	transport.TLSClientConfig = &tls.Config{
 		RootCAs: certPool,
 	}
@@ -0,0 +1,115 @@
+package akamai
+
+import (
+	"context"
+	"sort"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &providerDiagnosticsDataSource{}
+
+type (
+	// providerDiagnosticsDataSource reports the state of the provider, which does not require calling any API
+	providerDiagnosticsDataSource struct{}
+
+	providerDiagnosticsDataSourceModel struct {
+		CacheEnabled types.Bool         `tfsdk:"cache_enabled"`
+		CacheBackend types.String       `tfsdk:"cache_backend"`
+		CacheBuckets []cacheBucketModel `tfsdk:"cache_buckets"`
+	}
+
+	cacheBucketModel struct {
+		Name      types.String `tfsdk:"name"`
+		Hits      types.Int64  `tfsdk:"hits"`
+		Misses    types.Int64  `tfsdk:"misses"`
+		Evictions types.Int64  `tfsdk:"evictions"`
+		Bytes     types.Int64  `tfsdk:"bytes"`
+	}
+)
+
+// NewProviderDiagnosticsDataSource returns a new data source reporting provider diagnostics
+func NewProviderDiagnosticsDataSource() datasource.DataSource {
+	return &providerDiagnosticsDataSource{}
+}
+
+func (d *providerDiagnosticsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "akamai_provider_diagnostics"
+}
+
+func (d *providerDiagnosticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports diagnostic information about the provider, such as statistics of cached API responses.",
+		Attributes: map[string]schema.Attribute{
+			"cache_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether API responses are cached.",
+			},
+			"cache_backend": schema.StringAttribute{
+				Computed:    true,
+				Description: "Backend in which API responses are cached, either `memory` or `file`.",
+			},
+			"cache_buckets": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Statistics of the cache buckets used since the provider was started.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the cache bucket.",
+						},
+						"hits": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of API responses served from the cache.",
+						},
+						"misses": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of API responses not found in the cache, including the expired ones.",
+						},
+						"evictions": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of API responses removed from the cache because they have expired.",
+						},
+						"bytes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Total size of API responses served from the cache.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *providerDiagnosticsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Provider Diagnostics DataSource Read")
+
+	stats := cache.Statistics()
+	buckets := make([]string, 0, len(stats))
+	for bucket := range stats {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+
+	data := providerDiagnosticsDataSourceModel{
+		CacheEnabled: types.BoolValue(cache.IsEnabled()),
+		CacheBackend: types.StringValue(cache.Backend()),
+		CacheBuckets: make([]cacheBucketModel, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		s := stats[bucket]
+		data.CacheBuckets = append(data.CacheBuckets, cacheBucketModel{
+			Name:      types.StringValue(bucket),
+			Hits:      types.Int64Value(int64(s.Hits)),
+			Misses:    types.Int64Value(int64(s.Misses)),
+			Evictions: types.Int64Value(int64(s.Evictions)),
+			Bytes:     types.Int64Value(int64(s.Bytes)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
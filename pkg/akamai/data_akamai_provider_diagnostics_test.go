@@ -0,0 +1,53 @@
+package akamai_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataProviderDiagnostics(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, cache.Configure(cache.Config{}))
+		cache.ResetStatistics()
+	})
+
+	bucket := cache.BucketName("diagnostics")
+	require.NoError(t, cache.Configure(cache.Config{Enabled: true}))
+	require.NoError(t, cache.Invalidate(bucket))
+	cache.ResetStatistics()
+
+	var out string
+	require.ErrorIs(t, cache.Get(context.Background(), bucket, "key", &out), cache.ErrEntryNotFound)
+	require.NoError(t, cache.Set(bucket, "key", "value"))
+	require.NoError(t, cache.Get(context.Background(), bucket, "key", &out))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(dummy{}),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "akamai" {
+						cache_enabled = true
+					}
+					data "akamai_provider_diagnostics" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_enabled", "true"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_backend", "memory"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_buckets.#", "1"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_buckets.0.name", "diagnostics"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_buckets.0.hits", "1"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_buckets.0.misses", "1"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_buckets.0.evictions", "0"),
+					resource.TestCheckResourceAttr("data.akamai_provider_diagnostics.test", "cache_buckets.0.bytes", "7"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package akamai
+
+import (
+	"context"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/edgegrid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &edgercSectionEphemeralResource{}
+
+type (
+	edgercSectionEphemeralResource struct{}
+
+	edgercSectionEphemeralModel struct {
+		Edgerc        types.String `tfsdk:"edgerc"`
+		ConfigSection types.String `tfsdk:"config_section"`
+		Host          types.String `tfsdk:"host"`
+		ClientToken   types.String `tfsdk:"client_token"`
+		ClientSecret  types.String `tfsdk:"client_secret"`
+		AccessToken   types.String `tfsdk:"access_token"`
+		AccountKey    types.String `tfsdk:"account_key"`
+		MaxBody       types.Int64  `tfsdk:"max_body"`
+	}
+)
+
+// NewEdgercSectionEphemeralResource returns a new edgerc section ephemeral resource
+func NewEdgercSectionEphemeralResource() ephemeral.EphemeralResource {
+	return &edgercSectionEphemeralResource{}
+}
+
+// Metadata configures ephemeral resource's meta information
+func (e *edgercSectionEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "akamai_edgerc_section"
+}
+
+// Schema is used to define ephemeral resource's terraform schema
+func (e *edgercSectionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads EdgeGrid credentials from a section of the edgerc file without persisting them in the state.",
+		Attributes: map[string]schema.Attribute{
+			"edgerc": schema.StringAttribute{
+				Optional:    true,
+				Description: "The location of the edgerc file. Defaults to '~/.edgerc'.",
+			},
+			"config_section": schema.StringAttribute{
+				Optional:    true,
+				Description: "The section of the edgerc file to read. Defaults to 'default'.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The EdgeGrid API hostname.",
+			},
+			"client_token": schema.StringAttribute{
+				Computed:    true,
+				Description: "The client token of the credential.",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client secret of the credential.",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token of the credential.",
+			},
+			"account_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The account switch key, if set in the section.",
+			},
+			"max_body": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The maximum size of the request body used for signing.",
+			},
+		},
+	}
+}
+
+// Open is called when the provider must generate a new ephemeral resource
+func (e *edgercSectionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Debug(ctx, "Opening Edgerc Section Ephemeral Resource")
+
+	var data edgercSectionEphemeralModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	path, section := data.Edgerc.ValueString(), data.ConfigSection.ValueString()
+	if path == "" {
+		path = edgegrid.DefaultConfigFile
+	}
+	if section == "" {
+		section = edgegrid.DefaultSection
+	}
+
+	config := &edgegrid.Config{}
+	if err := config.FromFile(path, section); err != nil {
+		resp.Diagnostics.AddError("Reading edgerc file failed", err.Error())
+		return
+	}
+	if err := config.Validate(); err != nil {
+		resp.Diagnostics.AddError("Invalid edgerc section", err.Error())
+		return
+	}
+	if config.MaxBody == 0 {
+		config.MaxBody = edgegrid.MaxBodySize
+	}
+
+	data.Host = types.StringValue(config.Host)
+	data.ClientToken = types.StringValue(config.ClientToken)
+	data.ClientSecret = types.StringValue(config.ClientSecret)
+	data.AccessToken = types.StringValue(config.AccessToken)
+	data.AccountKey = types.StringValue(config.AccountKey)
+	data.MaxBody = types.Int64Value(int64(config.MaxBody))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package akamai_test
+
+import (
+	"regexp"
+	"testing"
+
+	tst "github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEphemeralEdgercSection(t *testing.T) {
+	tests := map[string]struct {
+		steps []resource.TestStep
+	}{
+		"happy path - default section": {
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestEphemeralEdgercSection/default.tf"),
+					Check: tst.NewStateChecker("echo.test").
+						CheckEqual("data.host", "akaa-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx.luna-dev.akamaiapis.net").
+						CheckEqual("data.client_token", "akaa-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx").
+						CheckEqual("data.client_secret", "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX=").
+						CheckEqual("data.access_token", "akaa-xxxxxxxxxxxxxxxx-xxxxxxxxxxxxxxxx").
+						CheckEqual("data.account_key", "").
+						CheckEqual("data.max_body", "131072").
+						Build(),
+				},
+			},
+		},
+		"missing section": {
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestEphemeralEdgercSection/missing_section.tf"),
+					ExpectError: regexp.MustCompile(`section "missing" does not exist`),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			providerFactories := testutils.NewProtoV6ProviderFactory(dummy{})
+			providerFactories["echo"] = echoprovider.NewProviderServer()
+
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_10_0),
+				},
+				ProtoV6ProviderFactories: providerFactories,
+				Steps:                    tc.steps,
+			})
+		})
+	}
+}
@@ -6,19 +6,35 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf/validators"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/ratelimit"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
 	"github.com/akamai/terraform-provider-akamai/v9/version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
-var _ provider.Provider = &Provider{}
+var (
+	_ provider.Provider                       = &Provider{}
+	_ provider.ProviderWithFunctions          = &Provider{}
+	_ provider.ProviderWithEphemeralResources = &Provider{}
+	_ provider.ProviderWithListResources      = &Provider{}
+	_ provider.ProviderWithActions            = &Provider{}
+)
 
 // Provider is the implementation of akamai terraform provider which uses terraform-plugin-framework
 type Provider struct {
@@ -27,15 +43,21 @@ type Provider struct {
 
 // ProviderModel represents the model of Provider configuration
 type ProviderModel struct {
-	EdgercPath    types.String `tfsdk:"edgerc"`
-	EdgercSection types.String `tfsdk:"config_section"`
-	EdgercConfig  types.Set    `tfsdk:"config"`
-	CacheEnabled  types.Bool   `tfsdk:"cache_enabled"`
-	RequestLimit  types.Int64  `tfsdk:"request_limit"`
-	RetryMax      types.Int64  `tfsdk:"retry_max"`
-	RetryWaitMin  types.Int64  `tfsdk:"retry_wait_min"`
-	RetryWaitMax  types.Int64  `tfsdk:"retry_wait_max"`
-	RetryDisabled types.Bool   `tfsdk:"retry_disabled"`
+	EdgercPath      types.String `tfsdk:"edgerc"`
+	EdgercSection   types.String `tfsdk:"config_section"`
+	EdgercConfig    types.Set    `tfsdk:"config"`
+	CacheEnabled    types.Bool   `tfsdk:"cache_enabled"`
+	CacheBackend    types.String `tfsdk:"cache_backend"`
+	CacheDir        types.String `tfsdk:"cache_dir"`
+	CacheTTL        types.Int64  `tfsdk:"cache_ttl"`
+	CacheBucketTTLs types.Map    `tfsdk:"cache_bucket_ttls"`
+	RequestLimit    types.Int64  `tfsdk:"request_limit"`
+	RateLimits      types.Set    `tfsdk:"rate_limit"`
+	RetryMax        types.Int64  `tfsdk:"retry_max"`
+	RetryWaitMin    types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax    types.Int64  `tfsdk:"retry_wait_max"`
+	RetryDisabled   types.Bool   `tfsdk:"retry_disabled"`
+	RetryPolicy     types.List   `tfsdk:"retry_policy"`
 }
 
 // ConfigModel represents the model of edgegrid configuration block
@@ -48,6 +70,22 @@ type ConfigModel struct {
 	AccountKey   types.String `tfsdk:"account_key"`
 }
 
+// RateLimitModel represents the model of rate limit configuration block
+type RateLimitModel struct {
+	API               types.String `tfsdk:"api"`
+	RequestsPerSecond types.Int64  `tfsdk:"requests_per_second"`
+	Burst             types.Int64  `tfsdk:"burst"`
+}
+
+// RetryRuleModel represents the model of retry policy configuration block
+type RetryRuleModel struct {
+	PathPrefix  types.String `tfsdk:"path_prefix"`
+	Methods     types.Set    `tfsdk:"methods"`
+	StatusCodes types.Set    `tfsdk:"status_codes"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
+	Backoff     types.String `tfsdk:"backoff"`
+}
+
 // NewFrameworkProvider returns a function returning Provider as provider.Provider
 func NewFrameworkProvider(subproviders ...subprovider.Subprovider) func() provider.Provider {
 	return func() provider.Provider {
@@ -57,6 +95,15 @@ func NewFrameworkProvider(subproviders ...subprovider.Subprovider) func() provid
 	}
 }
 
+// NewProtoV6FrameworkProvider returns a function returning the protocol server of the framework provider,
+// which logs cache statistics of resource and data source operations
+func NewProtoV6FrameworkProvider(subproviders ...subprovider.Subprovider) func() tfprotov6.ProviderServer {
+	server := providerserver.NewProtocol6(NewFrameworkProvider(subproviders...)())
+	return func() tfprotov6.ProviderServer {
+		return withFrameworkCacheStatistics(server())
+	}
+}
+
 // Metadata configures provider's metadata
 func (p *Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "akamai"
@@ -77,6 +124,23 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			"cache_enabled": schema.BoolAttribute{
 				Optional: true,
 			},
+			"cache_backend": schema.StringAttribute{
+				Description: "The backend of the cache, either 'memory' or 'file' to keep cached contracts, groups, products and rule formats between runs, default 'memory'",
+				Optional:    true,
+			},
+			"cache_dir": schema.StringAttribute{
+				Description: "The directory used by the 'file' cache backend, default is the terraform-provider-akamai directory in the user cache directory",
+				Optional:    true,
+			},
+			"cache_ttl": schema.Int64Attribute{
+				Description: "The time in seconds after which cached API responses expire, default is 600 sec",
+				Optional:    true,
+			},
+			"cache_bucket_ttls": schema.MapAttribute{
+				Description: "The time in seconds after which cached API responses expire, by cache bucket. 0 turns off caching in the bucket",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
 			"request_limit": schema.Int64Attribute{
 				Description: "The maximum number of API requests to be made per second (0 for no limit)",
 				Optional:    true,
@@ -99,6 +163,57 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"retry_policy": schema.ListNestedBlock{
+				Description: "The retry policy of API requests to paths starting with the given prefix. The first policy matching the request path applies, other requests are retried according to the default policy",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path_prefix": schema.StringAttribute{
+							Description: "The prefix of paths of API requests to which the policy applies, for example '/appsec/'",
+							Required:    true,
+						},
+						"methods": schema.SetAttribute{
+							Description: "The HTTP methods of requests to be retried, one of 'GET', 'HEAD', 'OPTIONS', 'PUT' or 'DELETE', default is 'GET'",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"status_codes": schema.SetAttribute{
+							Description: "The HTTP status codes of responses to be retried, default are 409, 429 and 5xx status codes except 501",
+							ElementType: types.Int64Type,
+							Optional:    true,
+						},
+						"max_attempts": schema.Int64Attribute{
+							Description: "The maximum number of attempts of a request, including the first one, default is retry_max + 1",
+							Optional:    true,
+						},
+						"backoff": schema.StringAttribute{
+							Description: "The policy of waiting between attempts, either 'exponential' or 'linear_jitter', default 'exponential'",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"rate_limit": schema.SetNestedBlock{
+				Description: "The rate limit of requests to an API. Requests also follow the rate limit reported by the API and are paused when no more requests are allowed",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api": schema.StringAttribute{
+							Description: "The API whose requests are limited, one of 'appsec', 'dns', 'gtm', 'iam' or 'papi'",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(ratelimit.APIs()...)},
+						},
+						"requests_per_second": schema.Int64Attribute{
+							Description: "The maximum number of requests to the API to be made per second",
+							Required:    true,
+							Validators:  []validator.Int64{int64validator.AtLeast(1)},
+						},
+						"burst": schema.Int64Attribute{
+							Description: "The maximum number of requests to the API to be made at once, default is requests_per_second",
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.AtLeast(1)},
+						},
+					},
+				},
+			},
 			"config": schema.SetNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -220,16 +335,88 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		return
 	}
 
+	cacheTTL, err := getFrameworkConfigInt(data.CacheTTL, "AKAMAI_CACHE_TTL")
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("configuring context failed", err.Error()))
+		return
+	}
+
+	var bucketTTLs map[string]int64
+	resp.Diagnostics.Append(data.CacheBucketTTLs.ElementsAs(ctx, &bucketTTLs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cacheBucketTTLsConfig := make(map[string]int, len(bucketTTLs))
+	for bucket, ttl := range bucketTTLs {
+		cacheBucketTTLsConfig[bucket] = int(ttl)
+	}
+	cacheBucketTTLs, err := cacheBucketTTLs(cacheBucketTTLsConfig)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("configuring context failed", err.Error()))
+		return
+	}
+
+	var rateLimitModels []RateLimitModel
+	resp.Diagnostics.Append(data.RateLimits.ElementsAs(ctx, &rateLimitModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rateLimitConfigs := make([]rateLimitConfig, 0, len(rateLimitModels))
+	for _, m := range rateLimitModels {
+		rateLimitConfigs = append(rateLimitConfigs, rateLimitConfig{
+			api:               m.API.ValueString(),
+			requestsPerSecond: int(m.RequestsPerSecond.ValueInt64()),
+			burst:             int(m.Burst.ValueInt64()),
+		})
+	}
+	rateLimits, err := rateLimits(rateLimitConfigs)
+	if err != nil {
+		resp.Diagnostics.Append(diag.NewErrorDiagnostic("configuring context failed", err.Error()))
+		return
+	}
+
+	var retryRuleModels []RetryRuleModel
+	resp.Diagnostics.Append(data.RetryPolicy.ElementsAs(ctx, &retryRuleModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	retryPolicy := make([]retryRule, 0, len(retryRuleModels))
+	for _, m := range retryRuleModels {
+		rule := retryRule{
+			pathPrefix:  m.PathPrefix.ValueString(),
+			maxAttempts: int(m.MaxAttempts.ValueInt64()),
+			backoff:     m.Backoff.ValueString(),
+		}
+		resp.Diagnostics.Append(m.Methods.ElementsAs(ctx, &rule.methods, false)...)
+		var statusCodes []int64
+		resp.Diagnostics.Append(m.StatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, statusCode := range statusCodes {
+			rule.statusCodes = append(rule.statusCodes, int(statusCode))
+		}
+		retryPolicy = append(retryPolicy, rule)
+	}
+
 	meta, err := configureContext(contextConfig{
 		edgegridConfig: edgegridConfig,
 		userAgent:      userAgent(req.TerraformVersion),
 		ctx:            ctx,
 		requestLimit:   requestLimit,
-		enableCache:    data.CacheEnabled.ValueBool(),
-		retryMax:       retryMax,
-		retryWaitMin:   time.Duration(retryWaitMin) * time.Second,
-		retryWaitMax:   time.Duration(retryWaitMax) * time.Second,
-		retryDisabled:  retryDisabled,
+		rateLimits:     rateLimits,
+		cache: cache.Config{
+			Enabled:    data.CacheEnabled.ValueBool(),
+			Backend:    getFrameworkConfigString(data.CacheBackend, "AKAMAI_CACHE_BACKEND"),
+			Dir:        getFrameworkConfigString(data.CacheDir, "AKAMAI_CACHE_DIR"),
+			DefaultTTL: time.Duration(cacheTTL) * time.Second,
+			BucketTTLs: cacheBucketTTLs,
+		},
+		retryMax:      retryMax,
+		retryWaitMin:  time.Duration(retryWaitMin) * time.Second,
+		retryWaitMax:  time.Duration(retryWaitMax) * time.Second,
+		retryDisabled: retryDisabled,
+		retryPolicy:   retryPolicy,
 	})
 	if err != nil {
 		resp.Diagnostics.Append(diag.NewErrorDiagnostic("configuring context failed", err.Error()))
@@ -238,6 +425,9 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+	resp.ListResourceData = meta
+	resp.ActionData = meta
 }
 
 // Resources returns slice of functions used to instantiate resource implementations
@@ -253,7 +443,9 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 
 // DataSources returns slice of functions used to instantiate data source implementations
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
-	dataSources := make([]func() datasource.DataSource, 0)
+	dataSources := []func() datasource.DataSource{
+		NewProviderDiagnosticsDataSource,
+	}
 
 	for _, subprovider := range p.subproviders {
 		dataSources = append(dataSources, subprovider.FrameworkDataSources()...)
@@ -262,6 +454,52 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 	return dataSources
 }
 
+// Functions returns slice of functions used to instantiate provider-defined function implementations
+func (p *Provider) Functions(_ context.Context) []func() function.Function {
+	functions := make([]func() function.Function, 0)
+
+	for _, subprovider := range p.subproviders {
+		functions = append(functions, subprovider.FrameworkFunctions()...)
+	}
+
+	return functions
+}
+
+// EphemeralResources returns slice of functions used to instantiate ephemeral resource implementations
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	ephemeralResources := []func() ephemeral.EphemeralResource{
+		NewEdgercSectionEphemeralResource,
+	}
+
+	for _, subprovider := range p.subproviders {
+		ephemeralResources = append(ephemeralResources, subprovider.FrameworkEphemeralResources()...)
+	}
+
+	return ephemeralResources
+}
+
+// ListResources returns slice of functions used to instantiate list resource implementations
+func (p *Provider) ListResources(_ context.Context) []func() list.ListResource {
+	listResources := make([]func() list.ListResource, 0)
+
+	for _, subprovider := range p.subproviders {
+		listResources = append(listResources, subprovider.FrameworkListResources()...)
+	}
+
+	return listResources
+}
+
+// Actions returns slice of functions used to instantiate action implementations
+func (p *Provider) Actions(_ context.Context) []func() action.Action {
+	actions := make([]func() action.Action, 0)
+
+	for _, subprovider := range p.subproviders {
+		actions = append(actions, subprovider.FrameworkActions()...)
+	}
+
+	return actions
+}
+
 func getFrameworkConfigInt(tfValue types.Int64, envKey string) (int, error) {
 	ret := int(tfValue.ValueInt64())
 	if tfValue.IsNull() {
@@ -276,6 +514,13 @@ func getFrameworkConfigInt(tfValue types.Int64, envKey string) (int, error) {
 	return ret, nil
 }
 
+func getFrameworkConfigString(tfValue types.String, envKey string) string {
+	if tfValue.IsNull() {
+		return os.Getenv(envKey)
+	}
+	return tfValue.ValueString()
+}
+
 func getFrameworkConfigBool(tfValue types.Bool, envKey string) (bool, error) {
 	ret := tfValue.ValueBool()
 	if tfValue.IsNull() {
@@ -3,6 +3,8 @@ package akamai_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFrameworkProvider(t *testing.T) {
@@ -64,6 +67,190 @@ func TestFramework_ConfigureCache_EnabledInContext(t *testing.T) {
 	}
 }
 
+func TestFramework_ConfigureCache_Backend(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, cache.Configure(cache.Config{})) })
+
+	t.Run("file backend", func(t *testing.T) {
+		dir := t.TempDir()
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(dummy{}),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+						provider "akamai" {
+							cache_enabled     = true
+							cache_backend     = "file"
+							cache_dir         = "%s"
+							cache_ttl         = 3600
+							cache_bucket_ttls = {
+								groups = 0
+							}
+						}
+						data "akamai_dummy" "test" {}
+					`, dir),
+				},
+			},
+		})
+
+		assert.Equal(t, cache.BackendFile, cache.Backend())
+		require.NoError(t, cache.Set(cache.BucketContracts, "key", "value"))
+		namespaces, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, namespaces, 1)
+		contracts, err := os.ReadDir(filepath.Join(dir, namespaces[0].Name(), string(cache.BucketContracts)))
+		require.NoError(t, err)
+		assert.Len(t, contracts, 1)
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(dummy{}),
+			Steps: []resource.TestStep{
+				{
+					ExpectError: regexp.MustCompile(`unknown cache backend: "redis"`),
+					Config: `
+						provider "akamai" {
+							cache_backend = "redis"
+						}
+						data "akamai_dummy" "test" {}
+					`,
+				},
+			},
+		})
+	})
+
+	t.Run("negative bucket TTL", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			IsUnitTest:               true,
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(dummy{}),
+			Steps: []resource.TestStep{
+				{
+					ExpectError: regexp.MustCompile(`TTL of bucket "groups" \(-1\) cannot be negative`),
+					Config: `
+						provider "akamai" {
+							cache_bucket_ttls = {
+								groups = -1
+							}
+						}
+						data "akamai_dummy" "test" {}
+					`,
+				},
+			},
+		})
+	})
+}
+
+func TestFramework_ConfigureRateLimit(t *testing.T) {
+	tests := map[string]struct {
+		config        string
+		expectedError *regexp.Regexp
+	}{
+		"rate limits of APIs": {
+			config: `
+				rate_limit {
+					api                 = "papi"
+					requests_per_second = 10
+					burst               = 20
+				}
+				rate_limit {
+					api                 = "dns"
+					requests_per_second = 5
+				}`,
+		},
+		"API configured more than once": {
+			config: `
+				rate_limit {
+					api                 = "papi"
+					requests_per_second = 10
+				}
+				rate_limit {
+					api                 = "papi"
+					requests_per_second = 5
+				}`,
+			expectedError: regexp.MustCompile(`rate limit of API "papi" is configured more than once`),
+		},
+		"unsupported API": {
+			config: `
+				rate_limit {
+					api                 = "ccu"
+					requests_per_second = 10
+				}`,
+			expectedError: regexp.MustCompile(`expected api to be one of`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(dummy{}),
+				Steps: []resource.TestStep{
+					{
+						ExpectError: test.expectedError,
+						Config: fmt.Sprintf(`
+							provider "akamai" {
+								%s
+							}
+							data "akamai_dummy" "test" {}
+						`, test.config),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestFramework_ConfigureRetryPolicy(t *testing.T) {
+	tests := map[string]struct {
+		config        string
+		expectedError *regexp.Regexp
+	}{
+		"retry policy": {
+			config: `
+				retry_policy {
+					path_prefix  = "/appsec/"
+					methods      = ["PUT", "DELETE"]
+					status_codes = [409, 503]
+					max_attempts = 5
+					backoff      = "linear_jitter"
+				}
+				retry_policy {
+					path_prefix = "/config-gtm/"
+				}`,
+		},
+		"unknown backoff": {
+			config: `
+				retry_policy {
+					path_prefix = "/appsec/"
+					backoff     = "constant"
+				}`,
+			expectedError: regexp.MustCompile(`unknown backoff "constant" of requests to "/appsec/"`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(dummy{}),
+				Steps: []resource.TestStep{
+					{
+						ExpectError: test.expectedError,
+						Config: fmt.Sprintf(`
+							provider "akamai" {
+								%s
+							}
+							data "akamai_dummy" "test" {}
+						`, test.config),
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestFramework_ConfigureEdgercInContext(t *testing.T) {
 	tests := map[string]struct {
 		edgerc        string
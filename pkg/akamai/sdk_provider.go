@@ -11,12 +11,15 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/collections"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/ratelimit"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/spf13/cast"
 )
 
 // NewSDKProvider returns the provider function to terraform
@@ -70,11 +73,59 @@ func NewSDKProvider(subprovs ...subprovider.Subprovider) plugin.ProviderFunc {
 				Optional: true,
 				Type:     schema.TypeBool,
 			},
+			"cache_backend": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "The backend of the cache, either 'memory' or 'file' to keep cached contracts, groups, products and rule formats between runs, default 'memory'",
+			},
+			"cache_dir": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "The directory used by the 'file' cache backend, default is the terraform-provider-akamai directory in the user cache directory",
+			},
+			"cache_ttl": {
+				Optional:    true,
+				Type:        schema.TypeInt,
+				Description: "The time in seconds after which cached API responses expire, default is 600 sec",
+			},
+			"cache_bucket_ttls": {
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The time in seconds after which cached API responses expire, by cache bucket. 0 turns off caching in the bucket",
+			},
 			"request_limit": {
 				Optional:    true,
 				Type:        schema.TypeInt,
 				Description: "The maximum number of API requests to be made per second (0 for no limit)",
 			},
+			"rate_limit": {
+				Optional:    true,
+				Type:        schema.TypeSet,
+				Description: "The rate limit of requests to an API. Requests also follow the rate limit reported by the API and are paused when no more requests are allowed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "The API whose requests are limited, one of 'appsec', 'dns', 'gtm', 'iam' or 'papi'",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(ratelimit.APIs(), false)),
+						},
+						"requests_per_second": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							Description:      "The maximum number of requests to the API to be made per second",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+						"burst": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Description:      "The maximum number of requests to the API to be made at once, default is requests_per_second",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+					},
+				},
+			},
 			"retry_max": {
 				Optional:    true,
 				Type:        schema.TypeInt,
@@ -95,6 +146,42 @@ func NewSDKProvider(subprovs ...subprovider.Subprovider) plugin.ProviderFunc {
 				Type:        schema.TypeBool,
 				Description: "Should the retries of API requests be disabled, default false",
 			},
+			"retry_policy": {
+				Optional:    true,
+				Type:        schema.TypeList,
+				Description: "The retry policy of API requests to paths starting with the given prefix. The first policy matching the request path applies, other requests are retried according to the default policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path_prefix": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The prefix of paths of API requests to which the policy applies, for example '/appsec/'",
+						},
+						"methods": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The HTTP methods of requests to be retried, one of 'GET', 'HEAD', 'OPTIONS', 'PUT' or 'DELETE', default is 'GET'",
+						},
+						"status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The HTTP status codes of responses to be retried, default are 409, 429 and 5xx status codes except 501",
+						},
+						"max_attempts": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of attempts of a request, including the first one, default is retry_max + 1",
+						},
+						"backoff": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The policy of waiting between attempts, either 'exponential' or 'linear_jitter', default 'exponential'",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap:   make(map[string]*schema.Resource),
 		DataSourcesMap: make(map[string]*schema.Resource),
@@ -109,6 +196,8 @@ func NewSDKProvider(subprovs ...subprovider.Subprovider) plugin.ProviderFunc {
 			panic(err)
 		}
 	}
+	withCacheStatistics(prov.ResourcesMap)
+	withCacheStatistics(prov.DataSourcesMap)
 
 	prov.ConfigureContextFunc = configureProviderContext(prov)
 
@@ -194,16 +283,97 @@ func configureProviderContext(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, diag.FromErr(err)
 		}
 
+		cacheBackend, err := getPluginConfigString(d, "cache_backend", "AKAMAI_CACHE_BACKEND")
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		cacheDir, err := getPluginConfigString(d, "cache_dir", "AKAMAI_CACHE_DIR")
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		cacheTTL, err := getPluginConfigInt(d, "cache_ttl", "AKAMAI_CACHE_TTL")
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		bucketTTLs, err := tf.GetMapValue("cache_bucket_ttls", d)
+		if err != nil && !errors.Is(err, tf.ErrNotFound) {
+			return nil, diag.FromErr(err)
+		}
+		cacheBucketTTLsConfig := make(map[string]int, len(bucketTTLs))
+		for bucket, ttl := range bucketTTLs {
+			cacheBucketTTLsConfig[bucket] = cast.ToInt(ttl)
+		}
+		cacheBucketTTLs, err := cacheBucketTTLs(cacheBucketTTLsConfig)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		rateLimitSet, err := tf.GetSetValue("rate_limit", d)
+		if err != nil && !errors.Is(err, tf.ErrNotFound) {
+			return nil, diag.FromErr(err)
+		}
+		rateLimitConfigs := make([]rateLimitConfig, 0, rateLimitSet.Len())
+		for _, item := range rateLimitSet.List() {
+			rateLimitMap, ok := item.(map[string]any)
+			if !ok {
+				return nil, diag.FromErr(fmt.Errorf("%w: %s, %q", tf.ErrInvalidType, "rate_limit", "map[string]any"))
+			}
+			rateLimitConfigs = append(rateLimitConfigs, rateLimitConfig{
+				api:               rateLimitMap["api"].(string),
+				requestsPerSecond: rateLimitMap["requests_per_second"].(int),
+				burst:             rateLimitMap["burst"].(int),
+			})
+		}
+		rateLimits, err := rateLimits(rateLimitConfigs)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		retryPolicyList, err := tf.GetListValue("retry_policy", d)
+		if err != nil && !errors.Is(err, tf.ErrNotFound) {
+			return nil, diag.FromErr(err)
+		}
+		retryPolicy := make([]retryRule, 0, len(retryPolicyList))
+		for _, item := range retryPolicyList {
+			ruleMap, ok := item.(map[string]any)
+			if !ok {
+				return nil, diag.FromErr(fmt.Errorf("%w: %s, %q", tf.ErrInvalidType, "retry_policy", "map[string]any"))
+			}
+			rule := retryRule{
+				pathPrefix:  ruleMap["path_prefix"].(string),
+				maxAttempts: ruleMap["max_attempts"].(int),
+				backoff:     ruleMap["backoff"].(string),
+			}
+			for _, method := range ruleMap["methods"].(*schema.Set).List() {
+				rule.methods = append(rule.methods, method.(string))
+			}
+			for _, statusCode := range ruleMap["status_codes"].(*schema.Set).List() {
+				rule.statusCodes = append(rule.statusCodes, statusCode.(int))
+			}
+			retryPolicy = append(retryPolicy, rule)
+		}
+
 		meta, err := configureContext(contextConfig{
 			edgegridConfig: edgegridConfig,
 			userAgent:      userAgent(p.TerraformVersion),
 			ctx:            ctx,
 			requestLimit:   requestLimit,
-			enableCache:    cacheEnabled,
-			retryMax:       retryMax,
-			retryWaitMin:   time.Duration(retryWaitMin) * time.Second,
-			retryWaitMax:   time.Duration(retryWaitMax) * time.Second,
-			retryDisabled:  retryDisabled,
+			rateLimits:     rateLimits,
+			cache: cache.Config{
+				Enabled:    cacheEnabled,
+				Backend:    cacheBackend,
+				Dir:        cacheDir,
+				DefaultTTL: time.Duration(cacheTTL) * time.Second,
+				BucketTTLs: cacheBucketTTLs,
+			},
+			retryMax:      retryMax,
+			retryWaitMin:  time.Duration(retryWaitMin) * time.Second,
+			retryWaitMax:  time.Duration(retryWaitMax) * time.Second,
+			retryDisabled: retryDisabled,
+			retryPolicy:   retryPolicy,
 		})
 		if err != nil {
 			return nil, diag.FromErr(err)
@@ -240,6 +410,17 @@ func getPluginConfigInt(d *schema.ResourceData, key string, envKey string) (int,
 	return value, nil
 }
 
+func getPluginConfigString(d *schema.ResourceData, key string, envKey string) (string, error) {
+	value, err := tf.GetStringValue(key, d)
+	if err != nil {
+		if !errors.Is(err, tf.ErrNotFound) {
+			return "", err
+		}
+		value = os.Getenv(envKey)
+	}
+	return value, nil
+}
+
 func getPluginConfigBool(d *schema.ResourceData, key string, envKey string) (bool, error) {
 	value, err := tf.GetBoolValue(key, d)
 	if err != nil {
@@ -3,6 +3,7 @@ package akamai_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/edgegrid"
@@ -52,6 +53,101 @@ func TestConfigureCache_EnabledInContext(t *testing.T) {
 	}
 }
 
+func TestConfigureCache_Backend(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, cache.Configure(cache.Config{})) })
+	ctx := context.Background()
+	prov := akamai.NewSDKProvider()
+
+	t.Run("file backend", func(t *testing.T) {
+		dir := t.TempDir()
+		resourceData := schema.TestResourceDataRaw(t, prov().Schema, map[string]interface{}{
+			"cache_enabled":     true,
+			"cache_backend":     "file",
+			"cache_dir":         dir,
+			"cache_ttl":         3600,
+			"cache_bucket_ttls": map[string]interface{}{"groups": 0},
+		})
+		_, diagnostics := prov().ConfigureContextFunc(ctx, resourceData)
+		require.False(t, diagnostics.HasError(), fmt.Sprintf("unexpected error in diagnostics: %v", diagnostics))
+
+		assert.Equal(t, cache.BackendFile, cache.Backend())
+		require.NoError(t, cache.Set(cache.BucketContracts, "key", "value"))
+		namespaces, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, namespaces, 1)
+	})
+
+	t.Run("negative bucket TTL", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, prov().Schema, map[string]interface{}{
+			"cache_bucket_ttls": map[string]interface{}{"groups": -1},
+		})
+		_, diagnostics := prov().ConfigureContextFunc(ctx, resourceData)
+		require.True(t, diagnostics.HasError())
+		assert.Contains(t, diagnostics[0].Summary, `TTL of bucket "groups" (-1) cannot be negative`)
+	})
+}
+
+func TestConfigureRateLimit(t *testing.T) {
+	ctx := context.Background()
+	prov := akamai.NewSDKProvider()
+
+	t.Run("rate limits of APIs", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, prov().Schema, map[string]interface{}{
+			"rate_limit": []interface{}{
+				map[string]interface{}{"api": "papi", "requests_per_second": 10, "burst": 20},
+				map[string]interface{}{"api": "appsec", "requests_per_second": 5},
+			},
+		})
+		_, diagnostics := prov().ConfigureContextFunc(ctx, resourceData)
+		require.False(t, diagnostics.HasError(), fmt.Sprintf("unexpected error in diagnostics: %v", diagnostics))
+	})
+
+	t.Run("API configured more than once", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, prov().Schema, map[string]interface{}{
+			"rate_limit": []interface{}{
+				map[string]interface{}{"api": "papi", "requests_per_second": 10},
+				map[string]interface{}{"api": "papi", "requests_per_second": 5},
+			},
+		})
+		_, diagnostics := prov().ConfigureContextFunc(ctx, resourceData)
+		require.True(t, diagnostics.HasError())
+		assert.Contains(t, diagnostics[0].Summary, `rate limit of API "papi" is configured more than once`)
+	})
+}
+
+func TestConfigureRetryPolicy(t *testing.T) {
+	ctx := context.Background()
+	prov := akamai.NewSDKProvider()
+
+	t.Run("retry policy", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, prov().Schema, map[string]interface{}{
+			"retry_policy": []interface{}{
+				map[string]interface{}{
+					"path_prefix":  "/appsec/",
+					"methods":      []interface{}{"PUT", "DELETE"},
+					"status_codes": []interface{}{409, 503},
+					"max_attempts": 5,
+					"backoff":      "linear_jitter",
+				},
+				map[string]interface{}{"path_prefix": "/config-gtm/"},
+			},
+		})
+		_, diagnostics := prov().ConfigureContextFunc(ctx, resourceData)
+		require.False(t, diagnostics.HasError(), fmt.Sprintf("unexpected error in diagnostics: %v", diagnostics))
+	})
+
+	t.Run("non-idempotent method", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, prov().Schema, map[string]interface{}{
+			"retry_policy": []interface{}{
+				map[string]interface{}{"path_prefix": "/appsec/", "methods": []interface{}{"POST"}},
+			},
+		})
+		_, diagnostics := prov().ConfigureContextFunc(ctx, resourceData)
+		require.True(t, diagnostics.HasError())
+		assert.Contains(t, diagnostics[0].Summary, `requests with method POST to "/appsec/" cannot be retried`)
+	})
+}
+
 func TestConfigureEdgercInContext(t *testing.T) {
 	tests := map[string]struct {
 		resourceLocalData   *schema.ResourceData
@@ -0,0 +1,13 @@
+provider "akamai" {
+  edgerc = "../common/testutils/edgerc"
+}
+
+ephemeral "akamai_edgerc_section" "test" {
+  edgerc = "../common/testutils/edgerc"
+}
+
+provider "echo" {
+  data = ephemeral.akamai_edgerc_section.test
+}
+
+resource "echo" "test" {}
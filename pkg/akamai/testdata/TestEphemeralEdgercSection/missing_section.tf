@@ -0,0 +1,14 @@
+provider "akamai" {
+  edgerc = "../common/testutils/edgerc"
+}
+
+ephemeral "akamai_edgerc_section" "test" {
+  edgerc         = "../common/testutils/edgerc"
+  config_section = "missing"
+}
+
+provider "echo" {
+  data = ephemeral.akamai_edgerc_section.test
+}
+
+resource "echo" "test" {}
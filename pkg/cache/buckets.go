@@ -0,0 +1,24 @@
+package cache
+
+// Buckets holding API responses which are used by more than one sub-provider.
+// Their names can be used to configure a TTL for the bucket in the provider configuration.
+const (
+	// BucketContracts holds contracts available to the API client
+	BucketContracts BucketName = "contracts"
+	// BucketGroups holds groups available to the API client
+	BucketGroups BucketName = "groups"
+	// BucketProducts holds products available in contracts
+	BucketProducts BucketName = "products"
+	// BucketRuleFormats holds available rule formats
+	BucketRuleFormats BucketName = "rule_formats"
+)
+
+// persistentBuckets hold reference data, which rarely changes and is not modified by the provider.
+// Only these buckets are kept on disk by BackendFile; objects of other buckets may be changed
+// by later terraform runs, so they are kept in memory for the current run only.
+var persistentBuckets = map[string]struct{}{
+	BucketContracts.Name():   {},
+	BucketGroups.Name():      {},
+	BucketProducts.Name():    {},
+	BucketRuleFormats.Name(): {},
+}
@@ -2,13 +2,15 @@
 package cache
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
-	"github.com/allegro/bigcache/v2"
 )
 
 var (
@@ -16,13 +18,72 @@ var (
 	ErrDisabled = errors.New("cache disabled")
 	// ErrEntryNotFound is returned when object under the given key does not exist
 	ErrEntryNotFound = errors.New("cache entry not found")
+	// ErrUnknownBackend is returned when the configured cache backend is not supported
+	ErrUnknownBackend = errors.New("unknown cache backend")
+
+	// errEntryExpired is returned by stores for entries that have expired and were removed
+	errEntryExpired = fmt.Errorf("%w: entry expired", ErrEntryNotFound)
+)
+
+const (
+	// BackendMemory keeps cached objects in memory, so they live as long as the provider process
+	BackendMemory = "memory"
+	// BackendFile keeps cached reference objects in files, so they are shared by subsequent terraform runs until they expire.
+	// Objects of other buckets are kept in memory, as with BackendMemory.
+	BackendFile = "file"
+
+	// DefaultTTL is the time after which cached objects expire, unless configured otherwise for their bucket
+	DefaultTTL = 10 * time.Minute
 )
 
-var defaultCache = newCache(10 * time.Minute)
+var defaultCache = func() *cache {
+	memory := newMemoryStore()
+	return &cache{
+		store:      memory,
+		memory:     memory,
+		backend:    BackendMemory,
+		defaultTTL: DefaultTTL,
+	}
+}()
 
 type cache struct {
-	cache   *bigcache.BigCache
-	enabled bool
+	mu         sync.RWMutex
+	store      Store
+	backend    string
+	dir        string
+	enabled    bool
+	defaultTTL time.Duration
+	bucketTTLs map[string]time.Duration
+	// memory keeps objects of buckets which are not persisted by the backend
+	memory Store
+}
+
+// Store is the backend in which cached objects are kept
+type Store interface {
+	// Get returns data stored under the key in the bucket or ErrEntryNotFound if it does not exist or has expired
+	Get(bucket, key string) ([]byte, error)
+	// Set stores data under the key in the bucket for the given time
+	Set(bucket, key string, data []byte, ttl time.Duration) error
+	// Delete removes data stored under the key in the bucket
+	Delete(bucket, key string) error
+	// Clear removes all data stored in the bucket
+	Clear(bucket string) error
+}
+
+// Config contains the cache settings coming from the provider configuration
+type Config struct {
+	// Enabled tells whether objects are cached at all
+	Enabled bool
+	// Backend is either BackendMemory (the default) or BackendFile
+	Backend string
+	// Dir is the directory used by BackendFile
+	Dir string
+	// Namespace separates objects cached on disk for different credentials, so they are never shared between accounts
+	Namespace string
+	// DefaultTTL is used for buckets without a TTL of their own; DefaultTTL is used when it is zero
+	DefaultTTL time.Duration
+	// BucketTTLs overrides the TTL for the buckets of given names. A zero TTL turns off caching in the bucket
+	BucketTTLs map[string]time.Duration
 }
 
 // BucketName can be used as a bucket argument to Set and Get functions
@@ -38,67 +99,179 @@ type Bucket interface {
 	Name() string
 }
 
-func newCache(eviction time.Duration) *cache {
-	c, err := bigcache.NewBigCache(bigcache.DefaultConfig(eviction))
-	if err != nil {
-		panic(err)
+// Configure sets up the cache according to the given configuration.
+// The backend is kept, along with the objects cached in it, when its settings have not changed.
+func Configure(cfg Config) error {
+	backend := cfg.Backend
+	if backend == "" {
+		backend = BackendMemory
+	}
+	var dir string
+	if backend == BackendFile {
+		dir = filepath.Join(cfg.Dir, cfg.Namespace)
+	}
+	defaultTTL := cfg.DefaultTTL
+	if defaultTTL == 0 {
+		defaultTTL = DefaultTTL
 	}
 
-	return &cache{cache: c}
+	defaultCache.mu.Lock()
+	defer defaultCache.mu.Unlock()
+
+	if backend != defaultCache.backend || dir != defaultCache.dir {
+		memory := newMemoryStore()
+		var store Store
+		switch backend {
+		case BackendMemory:
+			store = memory
+		case BackendFile:
+			fs, err := newFileStore(dir)
+			if err != nil {
+				return err
+			}
+			store = fs
+		default:
+			return fmt.Errorf("%w: %q", ErrUnknownBackend, backend)
+		}
+		defaultCache.store = store
+		defaultCache.memory = memory
+		defaultCache.backend = backend
+		defaultCache.dir = dir
+	}
+	defaultCache.enabled = cfg.Enabled
+	defaultCache.defaultTTL = defaultTTL
+	defaultCache.bucketTTLs = cfg.BucketTTLs
+	return nil
 }
 
 // Enable is used to enable or disable cache
 func Enable(enabled bool) {
+	defaultCache.mu.Lock()
+	defer defaultCache.mu.Unlock()
 	defaultCache.enabled = enabled
 }
 
 // IsEnabled returns whether cache is enabled
 func IsEnabled() bool {
+	defaultCache.mu.RLock()
+	defer defaultCache.mu.RUnlock()
 	return defaultCache.enabled
 }
 
+// Backend returns the name of the backend in use
+func Backend() string {
+	defaultCache.mu.RLock()
+	defer defaultCache.mu.RUnlock()
+	return defaultCache.backend
+}
+
 // Set sets the given value under the key in cache
 func Set(bucket Bucket, key string, val any) error {
 	log := log.Get("cache", "CacheSet")
 
-	if !defaultCache.enabled {
+	store, ttl, err := storeFor(bucket)
+	if err != nil {
 		log.Debug("cache disabled")
-		return ErrDisabled
+		return err
+	}
+	if ttl == 0 {
+		log.Debugf("cache disabled for bucket %s", bucket.Name())
+		return nil
 	}
-
-	key = fmt.Sprintf("%s:%s", key, bucket.Name())
 
 	data, err := json.Marshal(val)
 	if err != nil {
 		return fmt.Errorf("failed to marshal object to cache: %w", err)
 	}
 
-	log.Debugf("cache set for for key %s [%d bytes]", key, len(data))
+	log.Debugf("cache set for for key %s:%s [%d bytes]", bucket.Name(), key, len(data))
 
-	return defaultCache.cache.Set(key, data)
+	return store.Set(bucket.Name(), key, data, ttl)
 }
 
-// Get returns value stored under the key from cache and writes it into out
-func Get(bucket Bucket, key string, out any) error {
+// Get returns value stored under the key from cache and writes it into out.
+// Hits and misses are also counted for the operation, if the context carries its statistics.
+func Get(ctx context.Context, bucket Bucket, key string, out any) error {
 	log := log.Get("cache", "CacheGet")
 
-	if !defaultCache.enabled {
+	store, ttl, err := storeFor(bucket)
+	if err != nil {
 		log.Debug("cache disabled")
-		return ErrDisabled
+		return err
+	}
+	if ttl == 0 {
+		// entries stored on disk before caching in the bucket was turned off are not served
+		log.Debugf("cache disabled for bucket %s", bucket.Name())
+		return ErrEntryNotFound
 	}
 
-	key = fmt.Sprintf("%s:%s", key, bucket.Name())
-
-	data, err := defaultCache.cache.Get(key)
+	data, err := store.Get(bucket.Name(), key)
 	if err != nil {
-		if errors.Is(err, bigcache.ErrEntryNotFound) {
-			log.Debugf("cache miss for key %s", key)
+		if errors.Is(err, ErrEntryNotFound) {
+			log.Debugf("cache miss for key %s:%s", bucket.Name(), key)
+			recordStats(ctx, bucket.Name(), func(s *Stats) {
+				s.Misses++
+				if errors.Is(err, errEntryExpired) {
+					s.Evictions++
+				}
+			})
 			return ErrEntryNotFound
 		}
 		return err
 	}
 
-	log.Debugf("cache get for for key %s: [%d bytes]", key, len(data))
+	log.Debugf("cache get for for key %s:%s: [%d bytes]", bucket.Name(), key, len(data))
+	recordStats(ctx, bucket.Name(), func(s *Stats) {
+		s.Hits++
+		s.Bytes += uint64(len(data))
+	})
 
 	return json.Unmarshal(data, out)
 }
+
+// Delete removes the value stored under the key from cache
+func Delete(bucket Bucket, key string) error {
+	store, _, err := storeFor(bucket)
+	if err != nil {
+		return err
+	}
+	return store.Delete(bucket.Name(), key)
+}
+
+// Invalidate removes all values stored in the bucket. It should be called after changes
+// which make the objects cached in the bucket outdated. Unlike other functions, it also
+// works when the cache is disabled, so that no outdated objects remain if it gets enabled again.
+func Invalidate(bucket Bucket) error {
+	log := log.Get("cache", "CacheInvalidate")
+
+	defaultCache.mu.RLock()
+	store, memory := defaultCache.store, defaultCache.memory
+	defaultCache.mu.RUnlock()
+
+	log.Debugf("cache invalidated for bucket %s", bucket.Name())
+
+	if err := memory.Clear(bucket.Name()); err != nil {
+		return err
+	}
+	if store == memory {
+		return nil
+	}
+	return store.Clear(bucket.Name())
+}
+
+func storeFor(bucket Bucket) (Store, time.Duration, error) {
+	defaultCache.mu.RLock()
+	defer defaultCache.mu.RUnlock()
+
+	if !defaultCache.enabled {
+		return nil, 0, ErrDisabled
+	}
+	ttl, ok := defaultCache.bucketTTLs[bucket.Name()]
+	if !ok {
+		ttl = defaultCache.defaultTTL
+	}
+	if _, ok := persistentBuckets[bucket.Name()]; !ok {
+		return defaultCache.memory, ttl, nil
+	}
+	return defaultCache.store, ttl, nil
+}
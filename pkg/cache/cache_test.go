@@ -1,7 +1,11 @@
 package cache
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,7 +23,7 @@ func TestCache(t *testing.T) {
 	err := Set(bucket, key, object)
 	assert.ErrorIs(t, err, ErrDisabled)
 
-	err = Get(bucket, key, nil)
+	err = Get(context.Background(), bucket, key, nil)
 	assert.ErrorIs(t, err, ErrDisabled)
 
 	Enable(true)
@@ -28,11 +32,11 @@ func TestCache(t *testing.T) {
 	require.NoError(t, err)
 
 	var out TestObject
-	err = Get(bucket, key, &out)
+	err = Get(context.Background(), bucket, key, &out)
 	require.NoError(t, err)
 	assert.Equal(t, object, out)
 
-	err = Get(bucket, key+"5", &out)
+	err = Get(context.Background(), bucket, key+"5", &out)
 	assert.ErrorIs(t, err, ErrEntryNotFound)
 
 	Enable(false)
@@ -40,6 +44,204 @@ func TestCache(t *testing.T) {
 	err = Set(bucket, key, object)
 	assert.ErrorIs(t, err, ErrDisabled)
 
-	err = Get(bucket, key, nil)
+	err = Get(context.Background(), bucket, key, nil)
 	assert.ErrorIs(t, err, ErrDisabled)
 }
+
+func TestCacheBackends(t *testing.T) {
+	tests := map[string]func(t *testing.T) Config{
+		"memory": func(_ *testing.T) Config {
+			return Config{Enabled: true, Backend: BackendMemory}
+		},
+		"file": func(t *testing.T) Config {
+			return Config{Enabled: true, Backend: BackendFile, Dir: t.TempDir(), Namespace: "account"}
+		},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(func() { require.NoError(t, Configure(Config{})) })
+
+			cfg := config(t)
+			cfg.BucketTTLs = map[string]time.Duration{
+				"shortBucket":    time.Millisecond,
+				"disabledBucket": 0,
+			}
+			require.NoError(t, Configure(cfg))
+			assert.Equal(t, name, Backend())
+
+			bucket, otherBucket := BucketName("bucket"), BucketName("otherBucket")
+			require.NoError(t, Set(bucket, "first", TestObject{"1"}))
+			require.NoError(t, Set(bucket, "second", TestObject{"2"}))
+			require.NoError(t, Set(otherBucket, "first", TestObject{"3"}))
+
+			var out TestObject
+			require.NoError(t, Get(context.Background(), bucket, "first", &out))
+			assert.Equal(t, TestObject{"1"}, out)
+			require.NoError(t, Get(context.Background(), otherBucket, "first", &out))
+			assert.Equal(t, TestObject{"3"}, out)
+
+			// entries of other buckets are kept on invalidation
+			require.NoError(t, Invalidate(bucket))
+			assert.ErrorIs(t, Get(context.Background(), bucket, "first", &out), ErrEntryNotFound)
+			assert.ErrorIs(t, Get(context.Background(), bucket, "second", &out), ErrEntryNotFound)
+			require.NoError(t, Get(context.Background(), otherBucket, "first", &out))
+
+			require.NoError(t, Delete(otherBucket, "first"))
+			assert.ErrorIs(t, Get(context.Background(), otherBucket, "first", &out), ErrEntryNotFound)
+			require.NoError(t, Delete(otherBucket, "first"))
+
+			// entries expire after the TTL of their bucket
+			require.NoError(t, Set(BucketName("shortBucket"), "key", TestObject{"4"}))
+			time.Sleep(5 * time.Millisecond)
+			assert.ErrorIs(t, Get(context.Background(), BucketName("shortBucket"), "key", &out), ErrEntryNotFound)
+
+			// nothing is cached in buckets with zero TTL
+			require.NoError(t, Set(BucketName("disabledBucket"), "key", TestObject{"5"}))
+			assert.ErrorIs(t, Get(context.Background(), BucketName("disabledBucket"), "key", &out), ErrEntryNotFound)
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, Configure(Config{})) })
+
+	t.Run("file entries are kept between configurations", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := Config{Enabled: true, Backend: BackendFile, Dir: dir, Namespace: "account"}
+		require.NoError(t, Configure(cfg))
+		require.NoError(t, Set(BucketContracts, "key", TestObject{"1"}))
+
+		// switching backends drops the store, as a new terraform run would
+		require.NoError(t, Configure(Config{Enabled: true}))
+		require.NoError(t, Configure(cfg))
+
+		var out TestObject
+		require.NoError(t, Get(context.Background(), BucketContracts, "key", &out))
+		assert.Equal(t, TestObject{"1"}, out)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "account", BucketContracts.Name()))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		require.NoError(t, Invalidate(BucketContracts))
+		assert.ErrorIs(t, Get(context.Background(), BucketContracts, "key", &out), ErrEntryNotFound)
+	})
+
+	t.Run("only reference buckets are kept on disk", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := Config{Enabled: true, Backend: BackendFile, Dir: dir, Namespace: "account"}
+		require.NoError(t, Configure(cfg))
+		require.NoError(t, Set(BucketName("bucket"), "key", TestObject{"1"}))
+
+		var out TestObject
+		require.NoError(t, Get(context.Background(), BucketName("bucket"), "key", &out))
+		assert.Equal(t, TestObject{"1"}, out)
+		_, err := os.Stat(filepath.Join(dir, "account", "bucket"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+
+		require.NoError(t, Configure(Config{Enabled: true}))
+		require.NoError(t, Configure(cfg))
+		assert.ErrorIs(t, Get(context.Background(), BucketName("bucket"), "key", &out), ErrEntryNotFound)
+	})
+
+	t.Run("file entries are not served when the bucket TTL is zero", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := Config{Enabled: true, Backend: BackendFile, Dir: dir, Namespace: "account"}
+		require.NoError(t, Configure(cfg))
+		require.NoError(t, Set(BucketGroups, "key", TestObject{"1"}))
+
+		cfg.BucketTTLs = map[string]time.Duration{BucketGroups.Name(): 0}
+		require.NoError(t, Configure(cfg))
+		var out TestObject
+		assert.ErrorIs(t, Get(context.Background(), BucketGroups, "key", &out), ErrEntryNotFound)
+	})
+
+	t.Run("file entries are not shared between namespaces", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, Configure(Config{Enabled: true, Backend: BackendFile, Dir: dir, Namespace: "first"}))
+		require.NoError(t, Set(BucketContracts, "key", TestObject{"1"}))
+
+		require.NoError(t, Configure(Config{Enabled: true, Backend: BackendFile, Dir: dir, Namespace: "second"}))
+		var out TestObject
+		assert.ErrorIs(t, Get(context.Background(), BucketContracts, "key", &out), ErrEntryNotFound)
+	})
+
+	t.Run("memory entries are kept when configured again", func(t *testing.T) {
+		require.NoError(t, Configure(Config{Enabled: true}))
+		require.NoError(t, Set(BucketName("bucket"), "key", TestObject{"1"}))
+		require.NoError(t, Configure(Config{Enabled: true, Backend: BackendMemory}))
+
+		var out TestObject
+		require.NoError(t, Get(context.Background(), BucketName("bucket"), "key", &out))
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		assert.ErrorIs(t, Configure(Config{Enabled: true, Backend: "redis"}), ErrUnknownBackend)
+	})
+
+	t.Run("missing directory for file backend", func(t *testing.T) {
+		assert.Error(t, Configure(Config{Enabled: true, Backend: BackendFile}))
+	})
+}
+
+func TestStatistics(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, Configure(Config{}))
+		ResetStatistics()
+	})
+	require.NoError(t, Configure(Config{
+		Enabled:    true,
+		BucketTTLs: map[string]time.Duration{"shortBucket": time.Millisecond},
+	}))
+	bucket, shortBucket := BucketName("bucket"), BucketName("shortBucket")
+	require.NoError(t, Invalidate(bucket))
+	ResetStatistics()
+
+	var out TestObject
+	assert.ErrorIs(t, Get(context.Background(), bucket, "key", &out), ErrEntryNotFound)
+	require.NoError(t, Set(bucket, "key", TestObject{"1"}))
+	require.NoError(t, Get(context.Background(), bucket, "key", &out))
+	require.NoError(t, Get(context.Background(), bucket, "key", &out))
+
+	require.NoError(t, Set(shortBucket, "key", TestObject{"2"}))
+	time.Sleep(5 * time.Millisecond)
+	assert.ErrorIs(t, Get(context.Background(), shortBucket, "key", &out), ErrEntryNotFound)
+
+	before := Statistics()
+	assert.Equal(t, map[string]Stats{
+		"bucket":      {Hits: 2, Misses: 1, Bytes: 20},
+		"shortBucket": {Misses: 1, Evictions: 1},
+	}, before)
+
+	require.NoError(t, Get(context.Background(), bucket, "key", &out))
+	assert.Equal(t, Stats{Hits: 1, Bytes: 10}, Statistics()["bucket"].Sub(before["bucket"]))
+	assert.True(t, Statistics()["shortBucket"].Sub(before["shortBucket"]).IsZero())
+
+	// nothing is counted when the cache is disabled
+	Enable(false)
+	assert.ErrorIs(t, Get(context.Background(), bucket, "key", &out), ErrDisabled)
+	assert.Equal(t, before["bucket"].Hits+1, Statistics()["bucket"].Hits)
+}
+
+func TestOperationStatistics(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, Configure(Config{}))
+		ResetStatistics()
+	})
+	require.NoError(t, Configure(Config{Enabled: true}))
+	bucket := BucketName("operationBucket")
+	require.NoError(t, Invalidate(bucket))
+	require.NoError(t, Set(bucket, "key", TestObject{"1"}))
+
+	first := ContextWithOperationStatistics(context.Background())
+	second := ContextWithOperationStatistics(context.Background())
+	var out TestObject
+	require.NoError(t, Get(first, bucket, "key", &out))
+	assert.ErrorIs(t, Get(second, bucket, "other", &out), ErrEntryNotFound)
+	assert.ErrorIs(t, Get(second, bucket, "other", &out), ErrEntryNotFound)
+
+	assert.Equal(t, map[string]Stats{"operationBucket": {Hits: 1, Bytes: 10}}, OperationStatistics(first))
+	assert.Equal(t, map[string]Stats{"operationBucket": {Misses: 2}}, OperationStatistics(second))
+	assert.Nil(t, OperationStatistics(context.Background()))
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// fileStore keeps each entry in a separate file, grouped into a directory per bucket
+type fileStore struct {
+	dir string
+}
+
+type fileEntry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func newFileStore(dir string) (*fileStore, error) {
+	if dir == "" {
+		return nil, errors.New("cache directory has to be provided for the file cache backend")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) Get(bucket, key string) ([]byte, error) {
+	path := s.path(bucket, key)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrEntryNotFound
+		}
+		return nil, err
+	}
+
+	var entry fileEntry
+	if err := json.Unmarshal(content, &entry); err != nil || entry.Key != key {
+		// a corrupted or clashing entry is treated as missing and is overwritten by the next Set
+		return nil, ErrEntryNotFound
+	}
+	if time.Now().After(entry.Expires) {
+		if err := s.Delete(bucket, key); err != nil {
+			return nil, err
+		}
+		return nil, errEntryExpired
+	}
+	return entry.Data, nil
+}
+
+func (s *fileStore) Set(bucket, key string, data []byte, ttl time.Duration) error {
+	content, err := json.Marshal(fileEntry{
+		Key:     key,
+		Expires: time.Now().Add(ttl),
+		Data:    data,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := s.path(bucket, key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// write to a temporary file first, so that concurrent readers never see a partially written entry
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *fileStore) Delete(bucket, key string) error {
+	if err := os.Remove(s.path(bucket, key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *fileStore) Clear(bucket string) error {
+	return os.RemoveAll(filepath.Join(s.dir, url.PathEscape(bucket)))
+}
+
+func (s *fileStore) path(bucket, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, url.PathEscape(bucket), hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/allegro/bigcache/v2"
+)
+
+// memoryLifeWindow is the time after which bigcache evicts entries regardless of their TTL
+const memoryLifeWindow = 24 * time.Hour
+
+// memoryStore keeps entries in bigcache, prefixed with their expiration time.
+// A bucket is cleared by moving it to the next generation, so that its previous entries
+// are no longer reachable and get evicted by bigcache.
+type memoryStore struct {
+	cache       *bigcache.BigCache
+	mu          sync.RWMutex
+	generations map[string]uint64
+}
+
+func newMemoryStore() *memoryStore {
+	c, err := bigcache.NewBigCache(bigcache.DefaultConfig(memoryLifeWindow))
+	if err != nil {
+		panic(err)
+	}
+
+	return &memoryStore{cache: c, generations: make(map[string]uint64)}
+}
+
+func (s *memoryStore) Get(bucket, key string) ([]byte, error) {
+	entry, err := s.cache.Get(s.memoryKey(bucket, key))
+	if err != nil {
+		if errors.Is(err, bigcache.ErrEntryNotFound) {
+			return nil, ErrEntryNotFound
+		}
+		return nil, err
+	}
+
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(entry[:8])))
+	if time.Now().After(expires) {
+		if err := s.Delete(bucket, key); err != nil {
+			return nil, err
+		}
+		return nil, errEntryExpired
+	}
+	return entry[8:], nil
+}
+
+func (s *memoryStore) Set(bucket, key string, data []byte, ttl time.Duration) error {
+	entry := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint64(entry, uint64(time.Now().Add(ttl).UnixNano()))
+	return s.cache.Set(s.memoryKey(bucket, key), append(entry, data...))
+}
+
+func (s *memoryStore) Delete(bucket, key string) error {
+	if err := s.cache.Delete(s.memoryKey(bucket, key)); err != nil && !errors.Is(err, bigcache.ErrEntryNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *memoryStore) Clear(bucket string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generations[bucket]++
+	return nil
+}
+
+// memoryKey returns the key of the entry in the current generation of the bucket
+func (s *memoryStore) memoryKey(bucket, key string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fmt.Sprintf("%s:%d:%s", bucket, s.generations[bucket], key)
+}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"context"
+	"sync"
+)
+
+// Stats contains counters of cache operations in a bucket
+type Stats struct {
+	// Hits is the number of objects found in the cache
+	Hits uint64
+	// Misses is the number of objects not found in the cache, including the expired ones
+	Misses uint64
+	// Evictions is the number of objects removed from the cache because they have expired
+	Evictions uint64
+	// Bytes is the total size of objects served from the cache
+	Bytes uint64
+}
+
+var (
+	statsMu sync.Mutex
+	stats   = make(map[string]*Stats)
+)
+
+// Sub returns the difference between s and the earlier statistics
+func (s Stats) Sub(earlier Stats) Stats {
+	return Stats{
+		Hits:      s.Hits - earlier.Hits,
+		Misses:    s.Misses - earlier.Misses,
+		Evictions: s.Evictions - earlier.Evictions,
+		Bytes:     s.Bytes - earlier.Bytes,
+	}
+}
+
+// IsZero tells whether no cache operations were counted
+func (s Stats) IsZero() bool {
+	return s == Stats{}
+}
+
+// Statistics returns counters of cache operations for every bucket used since the provider was started
+func Statistics() map[string]Stats {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	return snapshot(stats)
+}
+
+// ResetStatistics clears counters of cache operations for all buckets
+func ResetStatistics() {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	stats = make(map[string]*Stats)
+}
+
+type operationStatsKey struct{}
+
+// operationStats contains counters of cache operations made within a single provider operation
+type operationStats struct {
+	mu    sync.Mutex
+	stats map[string]*Stats
+}
+
+// ContextWithOperationStatistics returns a context in which cache operations are counted separately for
+// the operation using it, so that concurrent operations do not affect each other's statistics
+func ContextWithOperationStatistics(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operationStatsKey{}, &operationStats{stats: make(map[string]*Stats)})
+}
+
+// OperationStatistics returns counters of cache operations made with the context for every bucket used.
+// It returns nil if the context was not created with ContextWithOperationStatistics.
+func OperationStatistics(ctx context.Context) map[string]Stats {
+	opStats, ok := ctx.Value(operationStatsKey{}).(*operationStats)
+	if !ok {
+		return nil
+	}
+	opStats.mu.Lock()
+	defer opStats.mu.Unlock()
+	return snapshot(opStats.stats)
+}
+
+func snapshot(stats map[string]*Stats) map[string]Stats {
+	result := make(map[string]Stats, len(stats))
+	for bucket, s := range stats {
+		result[bucket] = *s
+	}
+	return result
+}
+
+func recordStats(ctx context.Context, bucket string, record func(*Stats)) {
+	statsMu.Lock()
+	recordBucketStats(stats, bucket, record)
+	statsMu.Unlock()
+
+	if opStats, ok := ctx.Value(operationStatsKey{}).(*operationStats); ok {
+		opStats.mu.Lock()
+		recordBucketStats(opStats.stats, bucket, record)
+		opStats.mu.Unlock()
+	}
+}
+
+func recordBucketStats(stats map[string]*Stats, bucket string, record func(*Stats)) {
+	s, ok := stats[bucket]
+	if !ok {
+		s = &Stats{}
+		stats[bucket] = s
+	}
+	record(s)
+}
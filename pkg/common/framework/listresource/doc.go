@@ -0,0 +1,3 @@
+// Package listresource contains functions to be used with list resources written using Terraform Provider Framework
+// for managed resources written using Terraform Plugin SDK
+package listresource
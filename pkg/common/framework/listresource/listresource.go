@@ -0,0 +1,131 @@
+package listresource
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Item describes a single instance of the managed resource found by the list resource
+type Item struct {
+	// DisplayName is a human-readable description of the instance
+	DisplayName string
+	// Identity contains the values of the identity attributes of the instance
+	Identity map[string]any
+	// Resource contains the values of the resource attributes, which are known without reading the instance
+	Resource map[string]any
+}
+
+// RawV6Schemas sets the ProtoV6 representations of the schema and the identity schema of the managed resource
+// written using Terraform Plugin SDK
+func RawV6Schemas(ctx context.Context, res *schema.Resource, resp *list.RawV6SchemaResponse) {
+	resp.ProtoV6Schema = schemaToV6(res.ProtoSchema(ctx)())
+	resp.ProtoV6IdentitySchema = identitySchemaToV6(res.ProtoIdentitySchema(ctx)())
+}
+
+// Stream sets the results of the list resource to the given items, stopping once the limit of the request is reached
+func Stream(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, items []Item) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			for name, value := range item.Identity {
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(name), value)...)
+			}
+			if req.IncludeResource {
+				for name, value := range item.Resource {
+					result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// ErrorResults returns the results of the list resource consisting of a single error diagnostic
+func ErrorResults(summary string, err error) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, err.Error())
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
+func schemaToV6(in *tfprotov5.Schema) *tfprotov6.Schema {
+	if in == nil {
+		return nil
+	}
+	return &tfprotov6.Schema{
+		Version: in.Version,
+		Block:   schemaBlockToV6(in.Block),
+	}
+}
+
+func schemaBlockToV6(in *tfprotov5.SchemaBlock) *tfprotov6.SchemaBlock {
+	if in == nil {
+		return nil
+	}
+	out := &tfprotov6.SchemaBlock{
+		Version:         in.Version,
+		Attributes:      make([]*tfprotov6.SchemaAttribute, 0, len(in.Attributes)),
+		BlockTypes:      make([]*tfprotov6.SchemaNestedBlock, 0, len(in.BlockTypes)),
+		Description:     in.Description,
+		DescriptionKind: tfprotov6.StringKind(in.DescriptionKind),
+		Deprecated:      in.Deprecated,
+	}
+	for _, attr := range in.Attributes {
+		out.Attributes = append(out.Attributes, &tfprotov6.SchemaAttribute{
+			Name:            attr.Name,
+			Type:            attr.Type,
+			Description:     attr.Description,
+			Required:        attr.Required,
+			Optional:        attr.Optional,
+			Computed:        attr.Computed,
+			Sensitive:       attr.Sensitive,
+			DescriptionKind: tfprotov6.StringKind(attr.DescriptionKind),
+			Deprecated:      attr.Deprecated,
+			WriteOnly:       attr.WriteOnly,
+		})
+	}
+	for _, block := range in.BlockTypes {
+		out.BlockTypes = append(out.BlockTypes, &tfprotov6.SchemaNestedBlock{
+			TypeName: block.TypeName,
+			Block:    schemaBlockToV6(block.Block),
+			Nesting:  tfprotov6.SchemaNestedBlockNestingMode(block.Nesting),
+			MinItems: block.MinItems,
+			MaxItems: block.MaxItems,
+		})
+	}
+	return out
+}
+
+func identitySchemaToV6(in *tfprotov5.ResourceIdentitySchema) *tfprotov6.ResourceIdentitySchema {
+	if in == nil {
+		return nil
+	}
+	out := &tfprotov6.ResourceIdentitySchema{
+		Version:            in.Version,
+		IdentityAttributes: make([]*tfprotov6.ResourceIdentitySchemaAttribute, 0, len(in.IdentityAttributes)),
+	}
+	for _, attr := range in.IdentityAttributes {
+		out.IdentityAttributes = append(out.IdentityAttributes, &tfprotov6.ResourceIdentitySchemaAttribute{
+			Name:              attr.Name,
+			Type:              attr.Type,
+			RequiredForImport: attr.RequiredForImport,
+			OptionalForImport: attr.OptionalForImport,
+			Description:       attr.Description,
+		})
+	}
+	return out
+}
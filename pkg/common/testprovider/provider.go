@@ -3,7 +3,11 @@ package testprovider
 
 import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -38,3 +42,23 @@ func (p *mockSubprovider) FrameworkResources() []func() resource.Resource {
 func (p *mockSubprovider) FrameworkDataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{}
 }
+
+// FrameworkFunctions returns the test functions implemented using terraform-plugin-framework
+func (p *mockSubprovider) FrameworkFunctions() []func() function.Function {
+	return []func() function.Function{}
+}
+
+// FrameworkEphemeralResources returns the test ephemeral resources implemented using terraform-plugin-framework
+func (p *mockSubprovider) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+// FrameworkListResources returns the test list resources implemented using terraform-plugin-framework
+func (p *mockSubprovider) FrameworkListResources() []func() list.ListResource {
+	return []func() list.ListResource{}
+}
+
+// FrameworkActions returns the test actions implemented using terraform-plugin-framework
+func (p *mockSubprovider) FrameworkActions() []func() action.Action {
+	return []func() action.Action{}
+}
@@ -0,0 +1,54 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// InvokeAction validates the configuration of the action and invokes it through the provider server built from
+// the given subprovider, the same way as `terraform apply -invoke` does. It returns the progress messages sent by
+// the action together with all reported diagnostics. The action is not invoked if the validation fails.
+func InvokeAction(t *testing.T, sub subprovider.Subprovider, actionType string, config map[string]tftypes.Value) ([]string, []*tfprotov6.Diagnostic) {
+	ctx := context.Background()
+
+	server, schemas := newConfiguredProviderServer(t, sub)
+	actionServer, ok := server.(tfprotov6.ProviderServerWithActions)
+	require.True(t, ok, "provider server does not support actions")
+
+	actionSchema, ok := schemas.ActionSchemas[actionType]
+	require.True(t, ok, "action %q is not registered", actionType)
+	actionConfig := newDynamicValue(t, actionSchema.Schema.ValueType(), config)
+
+	validateResp, err := actionServer.ValidateActionConfig(ctx, &tfprotov6.ValidateActionConfigRequest{
+		ActionType: actionType,
+		Config:     actionConfig,
+	})
+	require.NoError(t, err)
+	if len(validateResp.Diagnostics) > 0 {
+		return nil, validateResp.Diagnostics
+	}
+
+	stream, err := actionServer.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: actionType,
+		Config:     actionConfig,
+	})
+	require.NoError(t, err)
+
+	var messages []string
+	var diags []*tfprotov6.Diagnostic
+	for event := range stream.Events {
+		switch e := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			messages = append(messages, e.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			diags = append(diags, e.Diagnostics...)
+		}
+	}
+
+	return messages, diags
+}
@@ -0,0 +1,103 @@
+package testutils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// ListResourceRequest describes a query of a list resource performed by ListResource
+	ListResourceRequest struct {
+		// TypeName is the name of the list resource
+		TypeName string
+		// Config contains the values of the list resource configuration; other attributes are null
+		Config map[string]tftypes.Value
+		// IncludeResource requests the resource objects to be returned along with identities
+		IncludeResource bool
+		// Limit is the maximum number of results expected
+		Limit int64
+	}
+
+	// ListResourceResult is a single result of the list resource with identity and resource decoded into values
+	ListResourceResult struct {
+		DisplayName string
+		Identity    map[string]tftypes.Value
+		Resource    map[string]tftypes.Value
+	}
+)
+
+// ListResource queries the list resource through the provider server built from the given subprovider,
+// the same way as `terraform query` does, and returns the decoded results together with all reported diagnostics.
+func ListResource(t *testing.T, sub subprovider.Subprovider, req ListResourceRequest) ([]ListResourceResult, []*tfprotov6.Diagnostic) {
+	ctx := context.Background()
+
+	server, schemas := newConfiguredProviderServer(t, sub)
+	listServer, ok := server.(tfprotov6.ProviderServerWithListResource)
+	require.True(t, ok, "provider server does not support list resources")
+
+	identitySchemas, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	require.Empty(t, identitySchemas.Diagnostics)
+
+	listSchema, ok := schemas.ListResourceSchemas[req.TypeName]
+	require.True(t, ok, "list resource %q is not registered", req.TypeName)
+	resourceSchema, ok := schemas.ResourceSchemas[req.TypeName]
+	require.True(t, ok, "resource %q is not registered", req.TypeName)
+	identitySchema, ok := identitySchemas.IdentitySchemas[req.TypeName]
+	require.True(t, ok, "resource %q has no identity", req.TypeName)
+
+	stream, err := listServer.ListResource(ctx, &tfprotov6.ListResourceRequest{
+		TypeName:        req.TypeName,
+		Config:          newDynamicValue(t, listSchema.ValueType(), req.Config),
+		IncludeResource: req.IncludeResource,
+		Limit:           req.Limit,
+	})
+	require.NoError(t, err)
+
+	var results []ListResourceResult
+	var diags []*tfprotov6.Diagnostic
+	for event := range stream.Results {
+		diags = append(diags, event.Diagnostics...)
+		if event.Identity == nil {
+			continue
+		}
+		result := ListResourceResult{
+			DisplayName: event.DisplayName,
+			Identity:    decodeDynamicValue(t, identitySchema.ValueType(), event.Identity.IdentityData),
+		}
+		if event.Resource != nil {
+			result.Resource = decodeDynamicValue(t, resourceSchema.ValueType(), event.Resource)
+		}
+		results = append(results, result)
+	}
+
+	return results, diags
+}
+
+// AssertListResourceResults verifies that the results of ListResource match the expected ones.
+// Only the resource attributes present in expected results are compared.
+func AssertListResourceResults(t *testing.T, expected, actual []ListResourceResult) {
+	require.Len(t, actual, len(expected))
+	for i, exp := range expected {
+		assert.Equal(t, exp.DisplayName, actual[i].DisplayName)
+		assert.Len(t, actual[i].Identity, len(exp.Identity))
+		assertValues(t, exp.Identity, actual[i].Identity)
+		if exp.Resource == nil {
+			assert.Nil(t, actual[i].Resource)
+			continue
+		}
+		assertValues(t, exp.Resource, actual[i].Resource)
+	}
+}
+
+func assertValues(t *testing.T, expected, actual map[string]tftypes.Value) {
+	for name, value := range expected {
+		assert.True(t, value.Equal(actual[name]), "attribute %s: expected %s, got %s", name, value, actual[name])
+	}
+}
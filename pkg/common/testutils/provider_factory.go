@@ -2,12 +2,14 @@ package testutils
 
 import (
 	"context"
+	"testing"
 
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/stretchr/testify/require"
 )
 
 // NewProtoV6ProviderFactory uses provided subprovider to create provider factory for test purposes
@@ -23,9 +25,7 @@ func NewProtoV6ProviderFactory(subproviders ...subprovider.Subprovider) map[stri
 
 			providers := []func() tfprotov6.ProviderServer{
 				sdkProviderV6,
-				providerserver.NewProtocol6(
-					akamai.NewFrameworkProvider(subproviders...)(),
-				),
+				akamai.NewProtoV6FrameworkProvider(subproviders...),
 			}
 
 			muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
@@ -37,3 +37,52 @@ func NewProtoV6ProviderFactory(subproviders ...subprovider.Subprovider) map[stri
 		},
 	}
 }
+
+// newConfiguredProviderServer returns the provider server built from the given subprovider, configured with the test
+// edgerc file, together with its schemas. It allows calling RPCs which are not supported by terraform-plugin-testing.
+func newConfiguredProviderServer(t *testing.T, sub subprovider.Subprovider) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	ctx := context.Background()
+
+	server, err := NewProtoV6ProviderFactory(sub)["akamai"]()
+	require.NoError(t, err)
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemas.Diagnostics)
+
+	providerConfig := newDynamicValue(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+		"edgerc": tftypes.NewValue(tftypes.String, "../../common/testutils/edgerc"),
+	})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: providerConfig})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+
+	return server, schemas
+}
+
+func newDynamicValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	objectType, ok := typ.(tftypes.Object)
+	require.True(t, ok)
+
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attrs))
+	require.NoError(t, err)
+	return &value
+}
+
+func decodeDynamicValue(t *testing.T, typ tftypes.Type, value *tfprotov6.DynamicValue) map[string]tftypes.Value {
+	raw, err := value.Unmarshal(typ)
+	require.NoError(t, err)
+
+	var attrs map[string]tftypes.Value
+	require.NoError(t, raw.As(&attrs))
+	return attrs
+}
@@ -0,0 +1,52 @@
+package tf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SetIdentity sets given attributes in the identity of the resource
+func SetIdentity(d *schema.ResourceData, attrs map[string]any) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	for key, value := range attrs {
+		if err := identity.Set(key, value); err != nil {
+			return fmt.Errorf("%w: %s", ErrValueSet, err.Error())
+		}
+	}
+	return nil
+}
+
+// ImportIDFromIdentity sets the ID of the resource imported by identity to the comma-separated list of values
+// of given identity attributes, so that the importer can handle it the same way as the user-supplied import ID.
+// Optional attributes should be given last, as the list ends at the first attribute which is not set.
+// It does nothing if the resource is imported by ID.
+func ImportIDFromIdentity(d *schema.ResourceData, keys ...string) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, ok := identity.GetOk(key)
+		if !ok {
+			break
+		}
+		parts = append(parts, fmt.Sprint(value))
+	}
+	if len(parts) == 0 {
+		return fmt.Errorf("expected identity to contain %q attribute", keys[0])
+	}
+
+	d.SetId(strings.Join(parts, ","))
+	return nil
+}
@@ -24,7 +24,7 @@ func getProtectedOperations(ctx context.Context, request apr.GetProtectedOperati
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "aprGetProtectedOperations", request.ConfigID, request.Version, request.SecurityPolicyID)
 	protectedOperations := &apr.ListProtectedOperationsResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, protectedOperations)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, protectedOperations)
 	if err == nil {
 		return filterProtectedOperation(protectedOperations, request, logger)
 	}
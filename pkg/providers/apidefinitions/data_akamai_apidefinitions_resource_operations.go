@@ -185,7 +185,7 @@ func searchResourceAndOperations(ctx context.Context, data resourceOperationsMod
 
 	apiResponse := &apidefinitions.SearchResourceOperationsResponse{}
 
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, apiResponse)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, apiResponse)
 
 	if err == nil {
 		// Successfully retrieved from cache
@@ -198,7 +198,7 @@ func searchResourceAndOperations(ctx context.Context, data resourceOperationsMod
 		resourceOperationsDataSourceMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, apiResponse)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, apiResponse)
 	if err == nil {
 		return apiResponse, nil
 	}
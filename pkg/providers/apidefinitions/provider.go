@@ -7,7 +7,11 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/apidefinitions"
 	v0 "github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/apidefinitions/v0"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -67,3 +71,23 @@ func (p *SubProvider) FrameworkDataSources() []func() datasource.DataSource {
 		NewAPIDataSource,
 	}
 }
+
+// FrameworkFunctions returns the apidefinitions functions implemented using terraform-plugin-framework
+func (p *SubProvider) FrameworkFunctions() []func() function.Function {
+	return []func() function.Function{}
+}
+
+// FrameworkEphemeralResources returns the apidefinitions ephemeral resources implemented using terraform-plugin-framework
+func (p *SubProvider) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+// FrameworkListResources returns the apidefinitions list resources implemented using terraform-plugin-framework
+func (p *SubProvider) FrameworkListResources() []func() list.ListResource {
+	return []func() list.ListResource{}
+}
+
+// FrameworkActions returns the apidefinitions actions implemented using terraform-plugin-framework
+func (p *SubProvider) FrameworkActions() []func() action.Action {
+	return []func() action.Action{}
+}
@@ -0,0 +1,200 @@
+package appsec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &rollbackAction{}
+	_ action.ActionWithConfigure = &rollbackAction{}
+)
+
+type (
+	rollbackAction struct {
+		meta meta.Meta
+	}
+
+	rollbackActionModel struct {
+		ConfigID           types.Int64  `tfsdk:"config_id"`
+		Network            types.String `tfsdk:"network"`
+		Version            types.Int64  `tfsdk:"version"`
+		Note               types.String `tfsdk:"note"`
+		NotificationEmails types.Set    `tfsdk:"notification_emails"`
+	}
+)
+
+// NewRollbackAction returns a new action reactivating the previously active security configuration version on a network
+func NewRollbackAction() action.Action {
+	return &rollbackAction{}
+}
+
+func (a *rollbackAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Action Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	a.meta = meta.Must(req.ProviderData)
+}
+
+func (a *rollbackAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "akamai_appsec_rollback"
+}
+
+func (a *rollbackAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reactivates the previously active version of a security configuration on the given network.",
+		Attributes: map[string]schema.Attribute{
+			"config_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "Unique identifier of the security configuration to roll back.",
+			},
+			"network": schema.StringAttribute{
+				Required:    true,
+				Description: "Network on which the configuration is rolled back (STAGING or PRODUCTION).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(appsec.NetworkStaging), string(appsec.NetworkProduction)),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Optional: true,
+				Description: "Version of the configuration to reactivate. " +
+					"Defaults to the version that was active on the network before the current one.",
+			},
+			"note": schema.StringAttribute{
+				Optional:    true,
+				Description: "Note describing the activation. Defaults to an activation request note with the current timestamp.",
+			},
+			"notification_emails": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "List of email addresses to be notified with the results of the activation.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *rollbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Appsec Rollback Invoke")
+	var data rollbackActionModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	configID := int(data.ConfigID.ValueInt64())
+	network := data.Network.ValueString()
+	var notificationEmails []string
+	if resp.Diagnostics.Append(data.NotificationEmails.ElementsAs(ctx, &notificationEmails, false)...); resp.Diagnostics.HasError() {
+		return
+	}
+	note := data.Note.ValueString()
+	if note == "" {
+		var err error
+		if note, err = defaultActivationNote(false); err != nil {
+			resp.Diagnostics.AddError("rollback failed", err.Error())
+			return
+		}
+	}
+
+	client := inst.Client(a.meta)
+	history, err := client.GetActivationHistory(ctx, appsec.GetActivationHistoryRequest{ConfigID: configID})
+	if err != nil {
+		resp.Diagnostics.AddError("fetching activation history failed", err.Error())
+		return
+	}
+	current, previous := findRollbackVersions(history.ActivationHistory, network)
+	if current == nil {
+		resp.Diagnostics.AddError("rollback failed", fmt.Sprintf("configuration %d has no active version on %s", configID, network))
+		return
+	}
+
+	version := int(data.Version.ValueInt64())
+	if data.Version.IsNull() {
+		if previous == nil {
+			resp.Diagnostics.AddError("rollback failed",
+				fmt.Sprintf("configuration %d has no version active on %s before version %d", configID, network, current.Version))
+			return
+		}
+		version = previous.Version
+	}
+	if version == current.Version {
+		resp.Diagnostics.AddError("rollback failed", fmt.Sprintf("version %d is already active on %s", version, network))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rolling back configuration %d on %s from version %d to version %d", configID, network, current.Version, version),
+	})
+	created, err := activate(ctx, client, configID, version, network, note, notificationEmails)
+	if err != nil {
+		resp.Diagnostics.AddError("rollback failed", err.Error())
+		return
+	}
+
+	getActivationsRequest := appsec.GetActivationsRequest{ActivationID: created.ActivationID}
+	activation, err := lookupActivation(ctx, client, getActivationsRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("fetching activation failed", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Activation %d created, waiting for it to complete", created.ActivationID),
+	})
+
+	status, err := pollActivation(ctx, client, activation.Status, getActivationsRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("rollback failed", err.Error())
+		return
+	}
+	if status != appsec.StatusActive {
+		resp.Diagnostics.AddError("rollback failed", fmt.Sprintf("activation %d finished with status %s", created.ActivationID, status))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Version %d of configuration %d is active on %s", version, configID, network),
+	})
+}
+
+// findRollbackVersions returns the activations of the version currently active on the given network and of the version
+// active before it, based on the activation history ordered from the most recent activation.
+func findRollbackVersions(history []appsec.Activation, network string) (current, previous *appsec.Activation) {
+	for i := range history {
+		activation := &history[i]
+		if activation.Network != network {
+			continue
+		}
+		if current == nil {
+			if activation.Status == string(appsec.StatusActive) {
+				current = activation
+			}
+			continue
+		}
+		if activation.Version == current.Version {
+			continue
+		}
+		if activation.Status == string(appsec.StatusActive) || activation.Status == string(appsec.StatusInactive) {
+			return current, activation
+		}
+	}
+	return current, nil
+}
@@ -0,0 +1,154 @@
+package appsec
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollbackAction(t *testing.T) {
+	history := &appsec.GetActivationHistoryResponse{
+		ConfigID: 43253,
+		ActivationHistory: []appsec.Activation{
+			{ActivationID: 4, Version: 5, Network: "STAGING", Status: "ACTIVATED"},
+			{ActivationID: 3, Version: 4, Network: "PRODUCTION", Status: "ACTIVATED"},
+			{ActivationID: 2, Version: 4, Network: "STAGING", Status: "INACTIVE"},
+			{ActivationID: 1, Version: 3, Network: "PRODUCTION", Status: "INACTIVE"},
+		},
+	}
+	config := func(extra map[string]tftypes.Value) map[string]tftypes.Value {
+		cfg := map[string]tftypes.Value{
+			"config_id": tftypes.NewValue(tftypes.Number, big.NewFloat(43253)),
+			"network":   tftypes.NewValue(tftypes.String, "PRODUCTION"),
+			"note":      tftypes.NewValue(tftypes.String, "rollback"),
+			"notification_emails": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "user@example.com"),
+			}),
+		}
+		for k, v := range extra {
+			cfg[k] = v
+		}
+		return cfg
+	}
+	expectActivation := func(m *appsec.Mock, version int, status appsec.StatusValue) {
+		request := appsec.CreateActivationsRequest{
+			Action:             "ACTIVATE",
+			Network:            "PRODUCTION",
+			Note:               "rollback",
+			NotificationEmails: []string{"user@example.com"},
+		}
+		request.ActivationConfigs = append(request.ActivationConfigs, appsec.ActivationConfigs{
+			ConfigID:      43253,
+			ConfigVersion: version,
+		})
+		m.On("CreateActivations", testutils.MockContext, request).
+			Return(&appsec.CreateActivationsResponse{ActivationID: 5, Status: appsec.StatusPending}, nil).Once()
+		m.On("GetActivations", testutils.MockContext, appsec.GetActivationsRequest{ActivationID: 5}).
+			Return(&appsec.GetActivationsResponse{ActivationID: 5, Status: status}, nil).Once()
+	}
+
+	tests := map[string]struct {
+		config           map[string]tftypes.Value
+		init             func(*appsec.Mock)
+		expectedMessages []string
+		expectedError    string
+	}{
+		"rollback to previous version": {
+			config: config(nil),
+			init: func(m *appsec.Mock) {
+				m.On("GetActivationHistory", testutils.MockContext, appsec.GetActivationHistoryRequest{ConfigID: 43253}).
+					Return(history, nil).Once()
+				expectActivation(m, 3, appsec.StatusActive)
+			},
+			expectedMessages: []string{
+				"Rolling back configuration 43253 on PRODUCTION from version 4 to version 3",
+				"Activation 5 created, waiting for it to complete",
+				"Version 3 of configuration 43253 is active on PRODUCTION",
+			},
+		},
+		"rollback to given version": {
+			config: config(map[string]tftypes.Value{
+				"version": tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+			}),
+			init: func(m *appsec.Mock) {
+				m.On("GetActivationHistory", testutils.MockContext, appsec.GetActivationHistoryRequest{ConfigID: 43253}).
+					Return(history, nil).Once()
+				expectActivation(m, 2, appsec.StatusActive)
+			},
+			expectedMessages: []string{
+				"Rolling back configuration 43253 on PRODUCTION from version 4 to version 2",
+				"Activation 5 created, waiting for it to complete",
+				"Version 2 of configuration 43253 is active on PRODUCTION",
+			},
+		},
+		"activation fails": {
+			config: config(nil),
+			init: func(m *appsec.Mock) {
+				m.On("GetActivationHistory", testutils.MockContext, appsec.GetActivationHistoryRequest{ConfigID: 43253}).
+					Return(history, nil).Once()
+				expectActivation(m, 3, appsec.StatusFailed)
+			},
+			expectedError: "rollback failed",
+		},
+		"given version is already active": {
+			config: config(map[string]tftypes.Value{
+				"version": tftypes.NewValue(tftypes.Number, big.NewFloat(4)),
+			}),
+			init: func(m *appsec.Mock) {
+				m.On("GetActivationHistory", testutils.MockContext, appsec.GetActivationHistoryRequest{ConfigID: 43253}).
+					Return(history, nil).Once()
+			},
+			expectedError: "rollback failed",
+		},
+		"no active version": {
+			config: config(nil),
+			init: func(m *appsec.Mock) {
+				m.On("GetActivationHistory", testutils.MockContext, appsec.GetActivationHistoryRequest{ConfigID: 43253}).
+					Return(&appsec.GetActivationHistoryResponse{ConfigID: 43253}, nil).Once()
+			},
+			expectedError: "rollback failed",
+		},
+		"fetching activation history fails": {
+			config: config(nil),
+			init: func(m *appsec.Mock) {
+				m.On("GetActivationHistory", testutils.MockContext, appsec.GetActivationHistoryRequest{ConfigID: 43253}).
+					Return(nil, errors.New("oops")).Once()
+			},
+			expectedError: "fetching activation history failed",
+		},
+		"invalid network": {
+			config: config(map[string]tftypes.Value{
+				"network": tftypes.NewValue(tftypes.String, "QA"),
+			}),
+			expectedError: "Invalid Attribute Value Match",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &appsec.Mock{}
+			if test.init != nil {
+				test.init(client)
+			}
+
+			useClient(client, func() {
+				messages, diags := testutils.InvokeAction(t, NewSubprovider(), "akamai_appsec_rollback", test.config)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				assert.Equal(t, test.expectedMessages, messages)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -46,7 +46,7 @@ func getModifiableConfigVersion(ctx context.Context, configID int, resource stri
 	// If the version info is in the cache, return it immediately.
 	cacheKey := fmt.Sprintf("%s:%d", "getModifiableConfigVersion", configID)
 	configuration := &appsec.GetConfigurationResponse{}
-	if err := cache.Get(cache.BucketName(SubproviderName), cacheKey, configuration); err == nil {
+	if err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, configuration); err == nil {
 		logger.Debugf("Resource %s returning modifiable version %d from cache", resource, configuration.LatestVersion)
 		return configuration.LatestVersion, nil
 	}
@@ -59,7 +59,7 @@ func getModifiableConfigVersion(ctx context.Context, configID int, resource stri
 	}()
 
 	// If the version info is in the cache, return it immediately.
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, configuration)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, configuration)
 	if err == nil {
 		logger.Debugf("Resource %s returning modifiable version %d from cache", resource, configuration.LatestVersion)
 		return configuration.LatestVersion, nil
@@ -129,7 +129,7 @@ func getLatestConfigVersion(ctx context.Context, configID int, m interface{}) (i
 	// Return the cached value if we have one
 	cacheKey := fmt.Sprintf("%s:%d", "getLatestConfigVersion", configID)
 	configuration := &appsec.GetConfigurationResponse{}
-	if err := cache.Get(cache.BucketName(SubproviderName), cacheKey, configuration); err == nil {
+	if err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, configuration); err == nil {
 		logger.Debugf("Found config %d, returning %d as its latest version", configuration.ID, configuration.LatestVersion)
 		return configuration.LatestVersion, nil
 	}
@@ -141,7 +141,7 @@ func getLatestConfigVersion(ctx context.Context, configID int, m interface{}) (i
 		latestVersionMutex.Unlock()
 	}()
 
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, configuration)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, configuration)
 	if err == nil {
 		logger.Debugf("Found config %d, returning %d as its latest version", configuration.ID, configuration.LatestVersion)
 		return configuration.LatestVersion, nil
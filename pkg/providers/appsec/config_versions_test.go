@@ -115,7 +115,7 @@ func TestGetLatestConfigVersion_CacheMiss_APISuccess(t *testing.T) {
 		// Verify value was cached
 		cachedConfig := &appsec.GetConfigurationResponse{}
 		cacheKey := "getLatestConfigVersion:12346"
-		err = cache.Get(cache.BucketName(SubproviderName), cacheKey, cachedConfig)
+		err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, cachedConfig)
 		assert.NoError(t, err)
 		assert.Equal(t, configID, cachedConfig.ID)
 		assert.Equal(t, expectedVersion, cachedConfig.LatestVersion)
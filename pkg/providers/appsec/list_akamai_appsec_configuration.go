@@ -0,0 +1,108 @@
+package appsec
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/appsec"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/framework/listresource"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource                 = &configurationListResource{}
+	_ list.ListResourceWithConfigure    = &configurationListResource{}
+	_ list.ListResourceWithRawV6Schemas = &configurationListResource{}
+)
+
+type (
+	configurationListResource struct {
+		meta meta.Meta
+	}
+
+	configurationListResourceModel struct {
+		Name types.String `tfsdk:"name"`
+	}
+)
+
+// NewConfigurationListResource returns a new list resource discovering security configurations
+func NewConfigurationListResource() list.ListResource {
+	return &configurationListResource{}
+}
+
+func (r *configurationListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected List Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	r.meta = meta.Must(req.ProviderData)
+}
+
+func (r *configurationListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_appsec_configuration"
+}
+
+func (r *configurationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists security configurations available to the current credentials.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the security configuration to list. If not provided, all security configurations are listed.",
+			},
+		},
+	}
+}
+
+func (r *configurationListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	listresource.RawV6Schemas(ctx, resourceConfiguration(), resp)
+}
+
+func (r *configurationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Configuration List")
+	var data configurationListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	configurations, err := inst.Client(r.meta).GetConfigurations(ctx, appsec.GetConfigurationsRequest{})
+	if err != nil {
+		stream.Results = listresource.ErrorResults("fetching security configurations failed", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(configurations.Configurations))
+	for _, config := range configurations.Configurations {
+		if name := data.Name.ValueString(); name != "" && config.Name != name {
+			continue
+		}
+		items = append(items, listresource.Item{
+			DisplayName: config.Name,
+			Identity: map[string]any{
+				"config_id": int64(config.ID),
+			},
+			Resource: map[string]any{
+				"id":          strconv.Itoa(config.ID),
+				"config_id":   int64(config.ID),
+				"name":        config.Name,
+				"description": config.Description,
+			},
+		})
+	}
+	listresource.Stream(ctx, req, stream, items)
+}
@@ -0,0 +1,96 @@
+package appsec
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListConfigurations(t *testing.T) {
+	getConfigurationsResponse := appsec.GetConfigurationsResponse{}
+	err := json.Unmarshal(testutils.LoadFixtureBytes(t, "testdata/TestDSConfiguration/Configuration.json"), &getConfigurationsResponse)
+	require.NoError(t, err)
+
+	configID := func(id int64) map[string]tftypes.Value {
+		return map[string]tftypes.Value{"config_id": tftypes.NewValue(tftypes.Number, big.NewFloat(float64(id)))}
+	}
+
+	tests := map[string]struct {
+		request         testutils.ListResourceRequest
+		err             error
+		expectedResults []testutils.ListResourceResult
+		expectedError   string
+	}{
+		"list all configurations": {
+			expectedResults: []testutils.ListResourceResult{
+				{DisplayName: "Akamai Tools", Identity: configID(43253)},
+				{DisplayName: "Example for EDGE", Identity: configID(39085)},
+				{DisplayName: "WorldTour", Identity: configID(24728)},
+				{DisplayName: "WAF Security File", Identity: configID(3644)},
+			},
+		},
+		"list configuration by name with resource": {
+			request: testutils.ListResourceRequest{
+				Config:          map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "WorldTour")},
+				IncludeResource: true,
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "WorldTour",
+					Identity:    configID(24728),
+					Resource: map[string]tftypes.Value{
+						"id":          tftypes.NewValue(tftypes.String, "24728"),
+						"config_id":   tftypes.NewValue(tftypes.Number, big.NewFloat(24728)),
+						"name":        tftypes.NewValue(tftypes.String, "WorldTour"),
+						"description": tftypes.NewValue(tftypes.String, "Restrictions for WT events"),
+					},
+				},
+			},
+		},
+		"list with limit": {
+			request: testutils.ListResourceRequest{Limit: 2},
+			expectedResults: []testutils.ListResourceResult{
+				{DisplayName: "Akamai Tools", Identity: configID(43253)},
+				{DisplayName: "Example for EDGE", Identity: configID(39085)},
+			},
+		},
+		"fetching configurations fails": {
+			err:           errors.New("oops"),
+			expectedError: "fetching security configurations failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &appsec.Mock{}
+			if test.err != nil {
+				client.On("GetConfigurations", testutils.MockContext, appsec.GetConfigurationsRequest{}).
+					Return(nil, test.err).Once()
+			} else {
+				client.On("GetConfigurations", testutils.MockContext, appsec.GetConfigurationsRequest{}).
+					Return(&getConfigurationsResponse, nil).Once()
+			}
+			test.request.TypeName = "akamai_appsec_configuration"
+
+			useClient(client, func() {
+				results, diags := testutils.ListResource(t, NewSubprovider(), test.request)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				testutils.AssertListResourceResults(t, test.expectedResults, results)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
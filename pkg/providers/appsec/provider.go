@@ -7,7 +7,11 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/appsec"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -184,3 +188,27 @@ func (p *Subprovider) FrameworkDataSources() []func() datasource.DataSource {
 		NewCustomRulesUsageDataSource,
 	}
 }
+
+// FrameworkFunctions returns the appsec functions implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkFunctions() []func() function.Function {
+	return []func() function.Function{}
+}
+
+// FrameworkEphemeralResources returns the appsec ephemeral resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+// FrameworkListResources returns the appsec list resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewConfigurationListResource,
+	}
+}
+
+// FrameworkActions returns the appsec actions implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkActions() []func() action.Action {
+	return []func() action.Action{
+		NewRollbackAction,
+	}
+}
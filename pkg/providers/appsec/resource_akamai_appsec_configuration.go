@@ -24,7 +24,18 @@ func resourceConfiguration() *schema.Resource {
 		UpdateContext: resourceConfigurationUpdate,
 		DeleteContext: resourceConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceConfigurationImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"config_id": {
+						Type:              schema.TypeInt,
+						RequiredForImport: true,
+						Description:       "Unique identifier of the security configuration",
+					},
+				}
+			},
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -181,6 +192,9 @@ func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := tf.SetIdentity(d, map[string]any{"config_id": configID}); err != nil {
+		return diag.FromErr(err)
+	}
 
 	getConfiguration := appsec.GetConfigurationRequest{
 		ConfigID: configID,
@@ -330,3 +344,10 @@ func resourceConfigurationDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 	return nil
 }
+
+func resourceConfigurationImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := tf.ImportIDFromIdentity(d, "config_id"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
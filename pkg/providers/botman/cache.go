@@ -32,7 +32,7 @@ func getBotDetectionAction(ctx context.Context, request botman.GetBotDetectionAc
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "getBotDetectionAction", request.ConfigID, request.Version, request.SecurityPolicyID)
 	botDetectionActions := &botman.GetBotDetectionActionListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, botDetectionActions)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, botDetectionActions)
 	// if cache is disabled use GetBotDetectionAction to fetch one action at a time
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetBotDetectionAction(ctx, request)
@@ -47,7 +47,7 @@ func getBotDetectionAction(ctx context.Context, request botman.GetBotDetectionAc
 		botDetectionActionMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, botDetectionActions)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, botDetectionActions)
 	if err == nil {
 		return filterBotDetectionAction(botDetectionActions, request, logger)
 	}
@@ -94,7 +94,7 @@ func getCustomBotCategoryAction(ctx context.Context, request botman.GetCustomBot
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "getCustomBotCategoryAction", request.ConfigID, request.Version, request.SecurityPolicyID)
 	customBotCategoryActions := &botman.GetCustomBotCategoryActionListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, customBotCategoryActions)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, customBotCategoryActions)
 	// if cache is disabled use GetCustomBotCategoryAction to fetch one action at a time
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetCustomBotCategoryAction(ctx, request)
@@ -109,7 +109,7 @@ func getCustomBotCategoryAction(ctx context.Context, request botman.GetCustomBot
 		customBotCategoryActionMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, customBotCategoryActions)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, customBotCategoryActions)
 	if err == nil {
 		return filterCustomBotCategoryAction(customBotCategoryActions, request, logger)
 	}
@@ -156,7 +156,7 @@ func getAkamaiBotCategoryAction(ctx context.Context, request botman.GetAkamaiBot
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "getAkamaiBotCategoryAction", request.ConfigID, request.Version, request.SecurityPolicyID)
 	akamaiBotCategoryActions := &botman.GetAkamaiBotCategoryActionListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryActions)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryActions)
 	// if cache is disabled use GetAkamaiBotCategoryAction to fetch one action at a time
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetAkamaiBotCategoryAction(ctx, request)
@@ -171,7 +171,7 @@ func getAkamaiBotCategoryAction(ctx context.Context, request botman.GetAkamaiBot
 		akamaiBotCategoryActionMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryActions)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryActions)
 	if err == nil {
 		return filterAkamaiBotCategoryAction(akamaiBotCategoryActions, request, logger)
 	}
@@ -218,7 +218,7 @@ func getTransactionalEndpoint(ctx context.Context, request botman.GetTransaction
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "getTransactionalEndpoint", request.ConfigID, request.Version, request.SecurityPolicyID)
 	transactionalEndpoints := &botman.GetTransactionalEndpointListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, transactionalEndpoints)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, transactionalEndpoints)
 	// if cache is disabled use GetTransactionalEndpoint to fetch one action at a time
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetTransactionalEndpoint(ctx, request)
@@ -233,7 +233,7 @@ func getTransactionalEndpoint(ctx context.Context, request botman.GetTransaction
 		transactionalEndpointMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, transactionalEndpoints)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, transactionalEndpoints)
 	if err == nil {
 		return filterTransactionalEndpoint(transactionalEndpoints, request, logger)
 	}
@@ -279,7 +279,7 @@ func getAkamaiBotCategoryList(ctx context.Context, request botman.GetAkamaiBotCa
 
 	cacheKey := "getAkamaiBotCategory"
 	akamaiBotCategoryList := &botman.GetAkamaiBotCategoryListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryList)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryList)
 	// if cache is disabled make a direct all to GetAkamaiBotCategoryList
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetAkamaiBotCategoryList(ctx, request)
@@ -294,7 +294,7 @@ func getAkamaiBotCategoryList(ctx context.Context, request botman.GetAkamaiBotCa
 		akamaiBotCategoryMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryList)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, akamaiBotCategoryList)
 	if err == nil {
 		return filterAkamaiBotCategoryList(akamaiBotCategoryList, request), nil
 	}
@@ -341,7 +341,7 @@ func getAkamaiDefinedBotList(ctx context.Context, request botman.GetAkamaiDefine
 
 	cacheKey := "getAkamaiDefinedBot"
 	akamaiDefinedBotList := &botman.GetAkamaiDefinedBotListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, akamaiDefinedBotList)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, akamaiDefinedBotList)
 	// if cache is disabled make a direct all to GetAkamaiDefinedBotList
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetAkamaiDefinedBotList(ctx, request)
@@ -356,7 +356,7 @@ func getAkamaiDefinedBotList(ctx context.Context, request botman.GetAkamaiDefine
 		akamaiDefinedBotMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, akamaiDefinedBotList)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, akamaiDefinedBotList)
 	if err == nil {
 		return filterAkamaiDefinedBotList(akamaiDefinedBotList, request), nil
 	}
@@ -402,7 +402,7 @@ func getBotDetectionList(ctx context.Context, request botman.GetBotDetectionList
 
 	cacheKey := "getBotDetection"
 	botDetectionList := &botman.GetBotDetectionListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, botDetectionList)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, botDetectionList)
 	// if cache is disabled make a direct all to GetBotDetectionList
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetBotDetectionList(ctx, request)
@@ -417,7 +417,7 @@ func getBotDetectionList(ctx context.Context, request botman.GetBotDetectionList
 		botDetectionMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, botDetectionList)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, botDetectionList)
 	if err == nil {
 		return filterBotDetectionList(botDetectionList, request), nil
 	}
@@ -465,7 +465,7 @@ func getContentProtectionRule(ctx context.Context, request botman.GetContentProt
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "getContentProtectionRule", request.ConfigID, request.Version, request.SecurityPolicyID)
 	contentProtectionRules := &botman.GetContentProtectionRuleListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, contentProtectionRules)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, contentProtectionRules)
 	// if cache is disabled use GetTransactionalEndpoint to fetch one action at a time
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetContentProtectionRule(ctx, request)
@@ -480,7 +480,7 @@ func getContentProtectionRule(ctx context.Context, request botman.GetContentProt
 		contentProtectionRuleMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, contentProtectionRules)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, contentProtectionRules)
 	if err == nil {
 		return filterContentProtectionRule(contentProtectionRules, request, logger)
 	}
@@ -527,7 +527,7 @@ func getContentProtectionJavaScriptInjectionRule(ctx context.Context, request bo
 
 	cacheKey := fmt.Sprintf("%s:%d:%d:%s", "getContentProtectionJavaScriptInjectionRule", request.ConfigID, request.Version, request.SecurityPolicyID)
 	contentProtectionJavaScriptInjectionRules := &botman.GetContentProtectionJavaScriptInjectionRuleListResponse{}
-	err := cache.Get(cache.BucketName(SubproviderName), cacheKey, contentProtectionJavaScriptInjectionRules)
+	err := cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, contentProtectionJavaScriptInjectionRules)
 	// if cache is disabled use GetTransactionalEndpoint to fetch one action at a time
 	if errors.Is(err, cache.ErrDisabled) {
 		return client.GetContentProtectionJavaScriptInjectionRule(ctx, request)
@@ -542,7 +542,7 @@ func getContentProtectionJavaScriptInjectionRule(ctx context.Context, request bo
 		contentProtectionJavaScriptInjectionRuleMutex.Unlock()
 	}()
 
-	err = cache.Get(cache.BucketName(SubproviderName), cacheKey, contentProtectionJavaScriptInjectionRules)
+	err = cache.Get(ctx, cache.BucketName(SubproviderName), cacheKey, contentProtectionJavaScriptInjectionRules)
 	if err == nil {
 		return filterContentProtectionJavaScriptInjectionRule(contentProtectionJavaScriptInjectionRules, request, logger)
 	}
@@ -15,9 +15,11 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/ptr"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -68,11 +70,13 @@ type KeyResourceModel struct {
 
 // Credentials represent set of attributes for specific access key versions
 type Credentials struct {
-	CloudAccessKeyID     types.String `tfsdk:"cloud_access_key_id"`
-	CloudSecretAccessKey types.String `tfsdk:"cloud_secret_access_key"`
-	PrimaryKey           types.Bool   `tfsdk:"primary_key"`
-	Version              types.Int64  `tfsdk:"version"`
-	VersionGUID          types.String `tfsdk:"version_guid"`
+	CloudAccessKeyID              types.String `tfsdk:"cloud_access_key_id"`
+	CloudSecretAccessKey          types.String `tfsdk:"cloud_secret_access_key"`
+	CloudSecretAccessKeyWO        types.String `tfsdk:"cloud_secret_access_key_wo"`
+	CloudSecretAccessKeyWOVersion types.Int64  `tfsdk:"cloud_secret_access_key_wo_version"`
+	PrimaryKey                    types.Bool   `tfsdk:"primary_key"`
+	Version                       types.Int64  `tfsdk:"version"`
+	VersionGUID                   types.String `tfsdk:"version_guid"`
 }
 
 // NetworkConfig represents set of attributes for network configuration
@@ -190,8 +194,27 @@ func (r *KeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp
 					},
 					"cloud_secret_access_key": schema.StringAttribute{
 						Description: "Cloud Access secret from cloud provider which is used to sign API requests",
-						Required:    true,
+						Optional:    true,
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("cloud_secret_access_key_wo")),
+						},
+					},
+					"cloud_secret_access_key_wo": schema.StringAttribute{
+						Description: "Write-only Cloud Access secret from cloud provider which is used to sign API requests. It is not stored in the state",
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("cloud_secret_access_key_wo_version")),
+						},
+					},
+					"cloud_secret_access_key_wo_version": schema.Int64Attribute{
+						Description: "Version of the write-only Cloud Access secret. Changing the version recreates the access key version with the current value of `cloud_secret_access_key_wo`",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("cloud_secret_access_key_wo")),
+						},
 					},
 					"primary_key": schema.BoolAttribute{
 						Description: "Boolean value which helps to define if credentials should be assigned to property",
@@ -217,8 +240,27 @@ func (r *KeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp
 					},
 					"cloud_secret_access_key": schema.StringAttribute{
 						Description: "Cloud Access secret from cloud provider which is used to sign API requests",
-						Required:    true,
+						Optional:    true,
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("cloud_secret_access_key_wo")),
+						},
+					},
+					"cloud_secret_access_key_wo": schema.StringAttribute{
+						Description: "Write-only Cloud Access secret from cloud provider which is used to sign API requests. It is not stored in the state",
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("cloud_secret_access_key_wo_version")),
+						},
+					},
+					"cloud_secret_access_key_wo_version": schema.Int64Attribute{
+						Description: "Version of the write-only Cloud Access secret. Changing the version recreates the access key version with the current value of `cloud_secret_access_key_wo`",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("cloud_secret_access_key_wo")),
+						},
 					},
 					"primary_key": schema.BoolAttribute{
 						Description: "Boolean value which helps to define if credentials should be assigned to property",
@@ -316,6 +358,13 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	var config *KeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.setWriteOnlySecrets(config)
+
 	createTimeout, diags := plan.Timeouts.Create(ctx, activationTimeout)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -450,6 +499,13 @@ func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	var config *KeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.setWriteOnlySecrets(config)
+
 	timeout, diags := plan.Timeouts.Update(ctx, updateTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -571,12 +627,13 @@ func changedOrderOfCredentials(oldState, plan *KeyResourceModel) bool {
 }
 
 func checkIfSecretChangedAndWasNotEmpty(oldState, plan *KeyResourceModel) bool {
-	if oldState.CredentialsA != nil && plan.CredentialsA != nil &&
+	// secrets provided with write-only attribute are never compared, their change is signaled by the version attribute
+	if oldState.CredentialsA != nil && plan.CredentialsA != nil && !plan.CredentialsA.CloudSecretAccessKey.IsNull() &&
 		oldState.CredentialsA.CloudAccessKeyID.ValueString() == plan.CredentialsA.CloudAccessKeyID.ValueString() &&
 		oldState.CredentialsA.CloudSecretAccessKey.ValueString() != "" && oldState.CredentialsA.CloudSecretAccessKey.ValueString() != plan.CredentialsA.CloudSecretAccessKey.ValueString() {
 		return true
 	}
-	if oldState.CredentialsB != nil && plan.CredentialsB != nil &&
+	if oldState.CredentialsB != nil && plan.CredentialsB != nil && !plan.CredentialsB.CloudSecretAccessKey.IsNull() &&
 		oldState.CredentialsB.CloudAccessKeyID.ValueString() == plan.CredentialsB.CloudAccessKeyID.ValueString() &&
 		oldState.CredentialsB.CloudSecretAccessKey.ValueString() != "" && oldState.CredentialsB.CloudSecretAccessKey.ValueString() != plan.CredentialsB.CloudSecretAccessKey.ValueString() {
 		return true
@@ -619,14 +676,23 @@ func keyVersionRequiresCreation(oldState *KeyResourceModel, data *KeyResourceMod
 
 func keyVersionRequiresDeletion(oldState *KeyResourceModel, data *KeyResourceModel) (bool, bool) {
 	var deleteCredA, deleteCredB bool
-	if oldState.CredentialsA != nil && (data.CredentialsA == nil || (oldState.CredentialsA.CloudAccessKeyID != data.CredentialsA.CloudAccessKeyID)) {
+	if oldState.CredentialsA != nil && (data.CredentialsA == nil || (oldState.CredentialsA.CloudAccessKeyID != data.CredentialsA.CloudAccessKeyID) ||
+		writeOnlySecretVersionChanged(oldState.CredentialsA, data.CredentialsA)) {
 		deleteCredA = true
 	}
-	if oldState.CredentialsB != nil && (data.CredentialsB == nil || (oldState.CredentialsB.CloudAccessKeyID != data.CredentialsB.CloudAccessKeyID)) {
+	if oldState.CredentialsB != nil && (data.CredentialsB == nil || (oldState.CredentialsB.CloudAccessKeyID != data.CredentialsB.CloudAccessKeyID) ||
+		writeOnlySecretVersionChanged(oldState.CredentialsB, data.CredentialsB)) {
 		deleteCredB = true
 	}
 	return deleteCredA, deleteCredB
 }
+
+// writeOnlySecretVersionChanged checks if the version of the write-only secret was changed, which requires rotation of the access key version.
+// Setting the version for the first time, e.g. when switching from `cloud_secret_access_key`, does not trigger the rotation.
+func writeOnlySecretVersionChanged(oldCredentials, credentials *Credentials) bool {
+	return !oldCredentials.CloudSecretAccessKeyWOVersion.IsNull() &&
+		!oldCredentials.CloudSecretAccessKeyWOVersion.Equal(credentials.CloudSecretAccessKeyWOVersion)
+}
 func (r *KeyResource) updateAccessKey(ctx context.Context, plan *KeyResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -1047,27 +1113,48 @@ func (m *KeyResourceModel) buildCreateKeyRequest(useCredA bool) cloudaccess.Crea
 func (m *KeyResourceModel) setCredentialsForAccessKeyCreation(useCredA bool) cloudaccess.Credentials {
 	if useCredA {
 		return cloudaccess.Credentials{
-			CloudSecretAccessKey: m.CredentialsA.CloudSecretAccessKey.ValueString(),
+			CloudSecretAccessKey: m.CredentialsA.secret(),
 			CloudAccessKeyID:     m.CredentialsA.CloudAccessKeyID.ValueString(),
 		}
 	}
 	return cloudaccess.Credentials{
-		CloudSecretAccessKey: m.CredentialsB.CloudSecretAccessKey.ValueString(),
+		CloudSecretAccessKey: m.CredentialsB.secret(),
 		CloudAccessKeyID:     m.CredentialsB.CloudAccessKeyID.ValueString(),
 	}
 }
 
+// setWriteOnlySecrets copies write-only secrets, which are available only in the configuration, into the model
+func (m *KeyResourceModel) setWriteOnlySecrets(config *KeyResourceModel) {
+	if config == nil {
+		return
+	}
+	if m.CredentialsA != nil && config.CredentialsA != nil {
+		m.CredentialsA.CloudSecretAccessKeyWO = config.CredentialsA.CloudSecretAccessKeyWO
+	}
+	if m.CredentialsB != nil && config.CredentialsB != nil {
+		m.CredentialsB.CloudSecretAccessKeyWO = config.CredentialsB.CloudSecretAccessKeyWO
+	}
+}
+
+// secret returns the write-only secret if it is provided and the secret stored in the state otherwise
+func (c *Credentials) secret() string {
+	if c.CloudSecretAccessKeyWO.ValueString() != "" {
+		return c.CloudSecretAccessKeyWO.ValueString()
+	}
+	return c.CloudSecretAccessKey.ValueString()
+}
+
 func (m *KeyResourceModel) buildCreateKeyVersionRequest(useCredA bool) cloudaccess.CreateAccessKeyVersionRequest {
 	var bodyParams cloudaccess.CreateAccessKeyVersionRequestBody
 	if useCredA {
 		bodyParams = cloudaccess.CreateAccessKeyVersionRequestBody{
 			CloudAccessKeyID:     m.CredentialsA.CloudAccessKeyID.ValueString(),
-			CloudSecretAccessKey: m.CredentialsA.CloudSecretAccessKey.ValueString(),
+			CloudSecretAccessKey: m.CredentialsA.secret(),
 		}
 	} else {
 		bodyParams = cloudaccess.CreateAccessKeyVersionRequestBody{
 			CloudAccessKeyID:     m.CredentialsB.CloudAccessKeyID.ValueString(),
-			CloudSecretAccessKey: m.CredentialsB.CloudSecretAccessKey.ValueString(),
+			CloudSecretAccessKey: m.CredentialsB.secret(),
 		}
 	}
 	return cloudaccess.CreateAccessKeyVersionRequest{
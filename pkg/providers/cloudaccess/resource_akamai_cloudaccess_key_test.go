@@ -197,6 +197,107 @@ func TestAccessKeyResource(t *testing.T) {
 				},
 			},
 		},
+		"create access key with write-only secret": {
+			init: func(m *cloudaccess.Mock, resourceData commonDataForResource) {
+				mockCreationAccessKeyWith1Version(m, resourceData)
+				mockReadAccessKeyWith1Version(m, resourceData)
+				mockDeletionAccessKeyWith1Version(m, resourceData)
+			},
+			mockData: resourceMock,
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResAccessKey/create_write_only.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "access_key_uid", "12345"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_access_key_id", "test_key_id"),
+						resource.TestCheckNoResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key"),
+						resource.TestCheckNoResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key_wo"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key_wo_version", "1"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.primary_key", "true"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.version", "1"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.version_guid", "asde-efdr-reded"),
+					),
+				},
+			},
+		},
+		"rotate write-only secret": {
+			init: func(m *cloudaccess.Mock, resourceData commonDataForResource) {
+				mockCreationAccessKeyWith1Version(m, resourceData)
+				mockReadAccessKeyWith1Version(m, resourceData)
+				mockReadAccessKeyWith1Version(m, resourceData)
+				//delete version 1
+				mockLookupsPropertiesNoProperties(m, resourceData.propertyData, firstAccessKeyVersion).Once()
+				mockDeleteAccessKeyVersion(m, resourceData.accessKeyData[0], firstAccessKeyVersion).Once()
+				mockGetAccessKeyVersion(m, resourceData.accessKeyData[0], cloudaccess.PendingDeletion, firstAccessKeyVersion).Once()
+				mockListAccessKeyVersions(m, resourceData.accessKeyData[0], emptyVersionList).Once()
+				//create new version (no.2) with the rotated secret
+				m.On("CreateAccessKeyVersion", testutils.MockContext, cloudaccess.CreateAccessKeyVersionRequest{
+					AccessKeyUID: resourceData.accessKeyData[0].accessKeyUID,
+					Body: cloudaccess.CreateAccessKeyVersionRequestBody{
+						CloudAccessKeyID:     "test_key_id",
+						CloudSecretAccessKey: "test_secret_rotated",
+					}}).Return(&cloudaccess.CreateAccessKeyVersionResponse{RequestID: 321321, RetryAfter: 1000}, nil).Once()
+				mockGetAccessKeyVersionStatus(m, resourceData.accessKeyData[0], 321321, secondAccessKeyVersion).Once()
+				rotatedVersion := cloudaccess.AccessKeyVersion{
+					AccessKeyUID:     resourceData.accessKeyData[0].accessKeyUID,
+					CloudAccessKeyID: ptr.To("test_key_id"),
+					CreatedBy:        "dev-user",
+					CreatedTime:      time.Date(2024, 1, 10, 11, 9, 10, 67708, time.UTC),
+					DeploymentStatus: cloudaccess.Active,
+					Version:          secondAccessKeyVersion,
+					VersionGUID:      "asdd-ads-dasdas",
+				}
+				m.On("GetAccessKeyVersion", testutils.MockContext, cloudaccess.GetAccessKeyVersionRequest{
+					AccessKeyUID: resourceData.accessKeyData[0].accessKeyUID,
+					Version:      secondAccessKeyVersion,
+				}).Return(&cloudaccess.GetAccessKeyVersionResponse{
+					AccessKeyUID:     rotatedVersion.AccessKeyUID,
+					CloudAccessKeyID: rotatedVersion.CloudAccessKeyID,
+					CreatedBy:        rotatedVersion.CreatedBy,
+					CreatedTime:      rotatedVersion.CreatedTime,
+					DeploymentStatus: rotatedVersion.DeploymentStatus,
+					Version:          rotatedVersion.Version,
+					VersionGUID:      rotatedVersion.VersionGUID,
+				}, nil).Once()
+				//read
+				mockGetAccessKey(m, resourceData.accessKeyData[0]).Once()
+				m.On("ListAccessKeyVersions", testutils.MockContext, cloudaccess.ListAccessKeyVersionsRequest{
+					AccessKeyUID: resourceData.accessKeyData[0].accessKeyUID,
+				}).Return(&cloudaccess.ListAccessKeyVersionsResponse{
+					AccessKeyVersions: []cloudaccess.AccessKeyVersion{rotatedVersion},
+				}, nil).Twice()
+				//delete version 2
+				mockLookupsPropertiesNoProperties(m, resourceData.propertyData, secondAccessKeyVersion).Once()
+				mockDeleteAccessKeyVersion(m, resourceData.accessKeyData[0], secondAccessKeyVersion).Once()
+				mockGetAccessKeyVersion(m, resourceData.accessKeyData[0], cloudaccess.PendingDeletion, secondAccessKeyVersion).Once()
+				mockListAccessKeyVersions(m, resourceData.accessKeyData[0], emptyVersionList).Once()
+				mockDeleteAccessKey(m, resourceData.accessKeyData[0]).Once()
+				mockListAccessKeys(m, []commonDataForAccessKey{resourceData.accessKeyData[1]}).Once()
+			},
+			mockData: resourceMock,
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResAccessKey/create_write_only.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckNoResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key_wo"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key_wo_version", "1"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.version", "1"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.version_guid", "asde-efdr-reded"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResAccessKey/rotate_write_only.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_access_key_id", "test_key_id"),
+						resource.TestCheckNoResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key"),
+						resource.TestCheckNoResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key_wo"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.cloud_secret_access_key_wo_version", "2"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.version", "2"),
+						resource.TestCheckResourceAttr("akamai_cloudaccess_key.test", "credentials_a.version_guid", "asdd-ads-dasdas"),
+					),
+				},
+			},
+		},
 		"create access key two versions": {
 			init: func(m *cloudaccess.Mock, resourceData commonDataForResource) {
 				mockCreationAccessKeyWith2Versions(m, resourceData)
@@ -1405,7 +1506,7 @@ func TestAccessKeyResource(t *testing.T) {
 			steps: []resource.TestStep{
 				{
 					Config:      testutils.LoadFixtureString(t, "testdata/TestResAccessKey/missing_cloud_access_secret.tf"),
-					ExpectError: regexp.MustCompile("\\s*Inappropriate value for attribute \"credentials_a\": attribute\\s*\"cloud_secret_access_key\" is required."),
+					ExpectError: regexp.MustCompile(`No attribute specified when one \(and only one\) of`),
 				},
 			},
 		},
@@ -8,7 +8,11 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/cloudwrapper"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -102,6 +106,22 @@ func (ts *TestSubprovider) FrameworkDataSources() []func() datasource.DataSource
 	return ts.datasources
 }
 
+func (ts *TestSubprovider) FrameworkFunctions() []func() function.Function {
+	return nil
+}
+
+func (ts *TestSubprovider) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return nil
+}
+
+func (ts *TestSubprovider) FrameworkListResources() []func() list.ListResource {
+	return nil
+}
+
+func (ts *TestSubprovider) FrameworkActions() []func() action.Action {
+	return nil
+}
+
 func TestMain(m *testing.M) {
 	testutils.TestRunner(m)
 }
@@ -43,29 +43,68 @@ func resourceCPSUploadCertificate() *schema.Resource {
 			"certificate_ecdsa_pem": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				AtLeastOneOf:     []string{"certificate_ecdsa_pem", "certificate_rsa_pem"},
+				AtLeastOneOf:     certificateAttributes,
+				ConflictsWith:    []string{"certificate_ecdsa_pem_wo"},
 				Description:      "ECDSA certificate in pem format to be uploaded",
 				DiffSuppressFunc: trimWhitespaces,
 			},
+			"certificate_ecdsa_pem_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				AtLeastOneOf: certificateAttributes,
+				RequiredWith: []string{"certificates_wo_version"},
+				Description:  "Write-only ECDSA certificate in pem format to be uploaded. It is not stored in the state",
+			},
 			"certificate_rsa_pem": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				AtLeastOneOf:     []string{"certificate_ecdsa_pem", "certificate_rsa_pem"},
+				AtLeastOneOf:     certificateAttributes,
+				ConflictsWith:    []string{"certificate_rsa_pem_wo"},
 				Description:      "RSA certificate in pem format to be uploaded",
 				DiffSuppressFunc: trimWhitespaces,
 			},
+			"certificate_rsa_pem_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				AtLeastOneOf: certificateAttributes,
+				RequiredWith: []string{"certificates_wo_version"},
+				Description:  "Write-only RSA certificate in pem format to be uploaded. It is not stored in the state",
+			},
 			"trust_chain_ecdsa_pem": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				ConflictsWith:    []string{"trust_chain_ecdsa_pem_wo"},
 				Description:      "Trust chain in pem format for provided ECDSA certificate",
 				DiffSuppressFunc: trimWhitespaces,
 			},
+			"trust_chain_ecdsa_pem_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				RequiredWith: []string{"certificates_wo_version"},
+				Description:  "Write-only trust chain in pem format for provided ECDSA certificate. It is not stored in the state",
+			},
 			"trust_chain_rsa_pem": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				ConflictsWith:    []string{"trust_chain_rsa_pem_wo"},
 				Description:      "Trust chain in pem format for provided RSA certificate",
 				DiffSuppressFunc: trimWhitespaces,
 			},
+			"trust_chain_rsa_pem_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				RequiredWith: []string{"certificates_wo_version"},
+				Description:  "Write-only trust chain in pem format for provided RSA certificate. It is not stored in the state",
+			},
+			"certificates_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version of the write-only certificates and trust chains. Changing the version uploads the write-only values again",
+			},
 			"acknowledge_post_verification_warnings": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -123,6 +162,9 @@ type attributes struct {
 var (
 	defaultTimeout = time.Hour * 2
 
+	// certificateAttributes lists attributes, at least one of which has to provide a certificate
+	certificateAttributes = []string{"certificate_ecdsa_pem", "certificate_rsa_pem", "certificate_ecdsa_pem_wo", "certificate_rsa_pem_wo"}
+
 	trimWhitespaces = func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
 		return strings.TrimSpace(oldValue) == strings.TrimSpace(newValue)
 	}
@@ -205,6 +247,10 @@ func resourceCPSUploadCertificateRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	attrs := createAttrsFromChangeHistory(changeHistory)
+	if _, ok := d.GetOk("certificates_wo_version"); ok {
+		// certificates are provided with write-only attributes, so they must not be stored in the state
+		attrs = map[string]interface{}{}
+	}
 
 	if err = tf.SetAttrs(d, attrs); err != nil {
 		diags = append(diags, diag.Errorf("could not set attributes: %s", err)...)
@@ -230,7 +276,7 @@ func resourceCPSUploadCertificateUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("could not get an enrollment: %s", err)
 	}
 
-	if !d.HasChanges("certificate_ecdsa_pem", "trust_chain_ecdsa_pem", "certificate_rsa_pem", "trust_chain_rsa_pem") {
+	if !d.HasChanges("certificate_ecdsa_pem", "trust_chain_ecdsa_pem", "certificate_rsa_pem", "trust_chain_rsa_pem", "certificates_wo_version") {
 		logger.Debug("Certificate does not have to be updated.")
 
 		if len(enrollment.PendingChanges) == 0 {
@@ -584,22 +630,22 @@ func getCPSUploadCertificateAttrs(d *schema.ResourceData) (*attributes, error) {
 		return nil, fmt.Errorf("could not get `enrollment_id` attribute: %s", err)
 	}
 
-	certificateECDSA, err := tf.GetStringValue("certificate_ecdsa_pem", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return nil, fmt.Errorf("could not get `certificate_ecdsa_pem` attribute: %s", err)
+	certificateECDSA, err := getPEMValue("certificate_ecdsa_pem", d)
+	if err != nil {
+		return nil, err
 	}
-	certificateRSA, err := tf.GetStringValue("certificate_rsa_pem", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return nil, fmt.Errorf("could not get `certificate_rsa_pem` attribute: %s", err)
+	certificateRSA, err := getPEMValue("certificate_rsa_pem", d)
+	if err != nil {
+		return nil, err
 	}
 
-	trustChainECDSA, err := tf.GetStringValue("trust_chain_ecdsa_pem", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return nil, fmt.Errorf("could not get `trust_chain_ecdsa_pem` attribute: %s", err)
+	trustChainECDSA, err := getPEMValue("trust_chain_ecdsa_pem", d)
+	if err != nil {
+		return nil, err
 	}
-	trustChainRSA, err := tf.GetStringValue("trust_chain_rsa_pem", d)
-	if err != nil && !errors.Is(err, tf.ErrNotFound) {
-		return nil, fmt.Errorf("could not get `trust_chain_rsa_pem` attribute: %s", err)
+	trustChainRSA, err := getPEMValue("trust_chain_rsa_pem", d)
+	if err != nil {
+		return nil, err
 	}
 
 	ackChangeManagement, err := tf.GetBoolValue("acknowledge_change_management", d)
@@ -623,6 +669,25 @@ func getCPSUploadCertificateAttrs(d *schema.ResourceData) (*attributes, error) {
 	}, nil
 }
 
+// getPEMValue returns the value of the write-only counterpart of the given attribute if it is present in the configuration
+// and the value of the attribute itself otherwise
+func getPEMValue(key string, d *schema.ResourceData) (string, error) {
+	writeOnlyKey := key + "_wo"
+	value, err := tf.GetStringValue(writeOnlyKey, tf.NewRawConfig(d))
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return "", fmt.Errorf("could not get `%s` attribute: %s", writeOnlyKey, err)
+	}
+	if value != "" {
+		return value, nil
+	}
+
+	value, err = tf.GetStringValue(key, d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return "", fmt.Errorf("could not get `%s` attribute: %s", key, err)
+	}
+	return value, nil
+}
+
 func resourceCPSUploadCertificateImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	meta := meta.Must(m)
 	logger := meta.Log("CPS", "resourceCPSUploadCertificateImport")
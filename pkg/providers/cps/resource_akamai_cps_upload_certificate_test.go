@@ -433,6 +433,12 @@ func TestCreateCPSUploadCertificate(t *testing.T) {
 			checkFunc:  nil,
 			error:      regexp.MustCompile("Error: Missing required argument"),
 		},
+		"write-only certificate provided without version": {
+			enrollment: nil,
+			configPath: "testdata/TestResCPSUploadCertificate/certificates/write_only_certificate_without_version.tf",
+			checkFunc:  nil,
+			error:      regexp.MustCompile("all of\\s+`certificate_rsa_pem_wo,certificates_wo_version`\\s+must be\\s+specified"),
+		},
 		"create: auto_approve_warnings not provided and not empty warning list": {
 			init: func(m *cps.Mock, enrollment *cps.GetEnrollmentResponse, enrollmentID, changeID int) {
 				mockGetEnrollment(m, enrollmentID, 1, enrollment)
@@ -878,6 +884,49 @@ func TestUpdateCPSUploadCertificate(t *testing.T) {
 			errorForCreate:      nil,
 			errorForUpdate:      nil,
 		},
+		"update: change in write-only cert with version bump - upsert": {
+			init: func(m *cps.Mock, enrollment *cps.GetEnrollmentResponse, enrollmentUpdated *cps.GetEnrollmentResponse, enrollmentID, changeID, changeIDUpdated int) {
+				mockCreateWithACKPostWarnings(m, enrollmentID, changeID, enrollment)
+				mockGetChangeStatus(m, enrollmentID, changeID, 1, waitAckChangeManagement)
+				mockAcknowledgeChangeManagement(m, enrollmentID, changeID)
+
+				enrollmentAfterCreate := copyEnrollmentWithEmptyPendingChanges(*enrollment)
+				mockReadCompleteForUpdate(m, enrollmentID, enrollmentAfterCreate, certRSAForTests, trustChainRSAForTests, RSA)
+
+				mockGetEnrollment(m, enrollmentID, 1, enrollmentUpdated)
+				mockGetChangeStatus(m, enrollmentID, changeIDUpdated, 1, waitUploadThirdParty)
+				mockGetEnrollment(m, enrollmentID, 1, enrollmentUpdated)
+				mockUploadThirdPartyCertificateAndTrustChain(m, RSA, certRSAUpdatedForTests, "", enrollmentID, changeIDUpdated)
+				mockGetChangeStatus(m, enrollmentID, changeIDUpdated, 1, waitReviewThirdPartyCert)
+				mockGetPostVerificationWarnings(m, threeWarnings, enrollmentID, changeIDUpdated)
+				mockAcknowledgePostVerificationWarnings(m, enrollmentID, changeIDUpdated)
+				mockGetChangeStatus(m, enrollmentID, changeIDUpdated, 1, liveCheckAction)
+
+				enrollmentAfterUpdate := copyEnrollmentWithEmptyPendingChanges(*enrollmentUpdated)
+				mockReadForComplete(m, enrollmentID, enrollmentAfterUpdate, certRSAUpdatedForTests, "", RSA)
+			},
+			enrollment:          createEnrollment(2, 22, true, true),
+			enrollmentUpdated:   createEnrollment(2, 222, false, true),
+			enrollmentID:        2,
+			changeID:            22,
+			changeIDUpdated:     222,
+			configPathForCreate: "testdata/TestResCPSUploadCertificate/certificates/write_only_certificate.tf",
+			configPathForUpdate: "testdata/TestResCPSUploadCertificate/certificates/write_only_certificate_updated.tf",
+			checkFuncForCreate: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("akamai_cps_upload_certificate.test", "certificates_wo_version", "1"),
+				resource.TestCheckNoResourceAttr("akamai_cps_upload_certificate.test", "certificate_rsa_pem"),
+				resource.TestCheckNoResourceAttr("akamai_cps_upload_certificate.test", "trust_chain_rsa_pem"),
+				resource.TestCheckNoResourceAttr("akamai_cps_upload_certificate.test", "certificate_rsa_pem_wo"),
+				resource.TestCheckNoResourceAttr("akamai_cps_upload_certificate.test", "trust_chain_rsa_pem_wo"),
+			),
+			checkFuncForUpdate: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("akamai_cps_upload_certificate.test", "certificates_wo_version", "2"),
+				resource.TestCheckNoResourceAttr("akamai_cps_upload_certificate.test", "certificate_rsa_pem"),
+				resource.TestCheckNoResourceAttr("akamai_cps_upload_certificate.test", "certificate_rsa_pem_wo"),
+			),
+			errorForCreate: nil,
+			errorForUpdate: nil,
+		},
 		"update: change in cert - upsert with both certs and trust chains": {
 			init: func(m *cps.Mock, enrollment *cps.GetEnrollmentResponse, enrollmentUpdated *cps.GetEnrollmentResponse, enrollmentID, changeID, changeIDUpdated int) {
 				mockCreateWithACKPostWarnings(m, enrollmentID, changeID, enrollment)
@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_cps_upload_certificate" "test" {
+  enrollment_id                          = 2
+  certificate_rsa_pem_wo                 = "-----BEGIN CERTIFICATE RSA REQUEST UPDATED-----\n...\n-----END CERTIFICATE RSA REQUEST UPDATED-----"
+  certificates_wo_version                = 2
+  acknowledge_post_verification_warnings = true
+  acknowledge_change_management          = true
+}
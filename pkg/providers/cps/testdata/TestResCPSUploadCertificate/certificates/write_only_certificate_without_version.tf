@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_cps_upload_certificate" "test" {
+  enrollment_id                          = 2
+  certificate_rsa_pem_wo                 = "-----BEGIN CERTIFICATE RSA REQUEST-----\n...\n-----END CERTIFICATE RSA REQUEST-----"
+  acknowledge_post_verification_warnings = true
+  acknowledge_change_management          = true
+}
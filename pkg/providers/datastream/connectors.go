@@ -3,6 +3,7 @@ package datastream
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/datastream"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
@@ -61,8 +62,29 @@ var (
 		"trafficpeak_connector":   GetTrafficPeakConnector,
 		"dynatrace_connector":     GetDynatraceConnector,
 	}
+
+	// connectorRequiredSecrets lists secrets of each connector, which have to be provided either
+	// in the connector itself or in the write-only connector_secrets block
+	connectorRequiredSecrets = map[string][]string{
+		"azure_connector":         {"access_key"},
+		"datadog_connector":       {"auth_token"},
+		"elasticsearch_connector": {"password"},
+		"gcs_connector":           {"private_key"},
+		"loggly_connector":        {"auth_token"},
+		"new_relic_connector":     {"auth_token"},
+		"oracle_connector":        {"access_key", "secret_access_key"},
+		"s3_connector":            {"access_key", "secret_access_key"},
+		"splunk_connector":        {"event_collector_token"},
+		"sumologic_connector":     {"collector_code"},
+		"s3_compatible_connector": {"access_key", "secret_access_key"},
+		"trafficpeak_connector":   {"password"},
+		"dynatrace_connector":     {"api_token"},
+	}
 )
 
+// writeOnlySuffix is the suffix of attributes in the connector_secrets block
+const writeOnlySuffix = "_wo"
+
 // ConnectorToMap converts ConnectorDetails struct to map of properties
 func ConnectorToMap(connector datastream.Destination, d *schema.ResourceData) (string, map[string]interface{}, error) {
 
@@ -118,10 +140,33 @@ func GetConnectors(d *schema.ResourceData, keys []string) (datastream.AbstractCo
 		return nil, fmt.Errorf("cannot find getter function for %s connector", connectorName)
 	}
 
-	connector := connectorResourceGetter(connectorProperties)
+	connector := connectorResourceGetter(withWriteOnlySecrets(d, connectorProperties))
 	return connector, nil
 }
 
+// withWriteOnlySecrets returns a copy of connector properties with secrets overridden by the values
+// from the write-only connector_secrets block, which are available only in the raw config
+func withWriteOnlySecrets(d *schema.ResourceData, props map[string]interface{}) map[string]interface{} {
+	secrets, ok := tf.NewRawConfig(d).GetOk("connector_secrets.0")
+	if !ok || secrets == nil {
+		return props
+	}
+
+	rv := make(map[string]interface{}, len(props))
+	for k, v := range props {
+		rv[k] = v
+	}
+	for k, v := range secrets.(map[string]any) {
+		key := strings.TrimSuffix(k, writeOnlySuffix)
+		if secret, ok := v.(string); ok && secret != "" {
+			if _, ok := rv[key]; ok {
+				rv[key] = secret
+			}
+		}
+	}
+	return rv
+}
+
 // GetS3Connector builds S3Connector structure
 func GetS3Connector(props map[string]interface{}) datastream.AbstractConnector {
 	return &datastream.S3Connector{
@@ -16,6 +16,7 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -91,6 +92,7 @@ func resourceDatastream() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			validateConfig,
+			validateConnectorSecrets,
 			enforceComputedFieldsChange,
 		),
 		Schema: datastreamResourceSchema,
@@ -212,7 +214,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The access key identifier used to authenticate requests to the Amazon S3 account",
 				},
@@ -243,7 +245,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"secret_access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The secret access key identifier used to authenticate requests to the Amazon S3 account",
 				},
@@ -258,7 +260,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "Access keys associated with Azure Storage account",
 				},
@@ -298,7 +300,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"auth_token": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The API key associated with Datadog account",
 				},
@@ -368,7 +370,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"event_collector_token": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The Event Collector token associated with Splunk account",
 				},
@@ -441,7 +443,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"private_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The contents of the JSON private key generated and downloaded in Google Cloud Storage account",
 				},
@@ -561,7 +563,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"collector_code": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The unique HTTP collector code of Sumo Logic endpoint",
 				},
@@ -607,7 +609,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The access key identifier used to authenticate requests to the Oracle Cloud account",
 				},
@@ -643,7 +645,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"secret_access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The secret access key identifier used to authenticate requests to the Oracle Cloud account",
 				},
@@ -668,7 +670,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"auth_token": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The unique HTTP code for your Loggly bulk endpoint.",
 				},
@@ -713,7 +715,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"auth_token": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "Your Log API token for your account in New Relic.",
 				},
@@ -759,7 +761,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"password": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The Elasticsearch basic access authentication password.",
 				},
@@ -828,7 +830,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			Schema: map[string]*schema.Schema{
 				"access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The access key identifier of the S3-compatible object storage bucket.",
 				},
@@ -860,7 +862,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"secret_access_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The secret access key identifier of the S3-compatible object storage bucket.",
 				},
@@ -923,7 +925,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"password": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "Enter the password you set in your TrafficPeak endpoint for authentication.",
 				},
@@ -968,7 +970,7 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 				},
 				"api_token": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					Sensitive:   true,
 					Description: "The Dynatrace Log Ingest access token.",
 				},
@@ -989,6 +991,77 @@ var datastreamResourceSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"connector_secrets": {
+		Type:         schema.TypeList,
+		MaxItems:     1,
+		Optional:     true,
+		RequiredWith: []string{"connector_secrets_wo_version"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only access key used to authenticate requests to the destination",
+				},
+				"api_token_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only API token used to authenticate requests to the Dynatrace destination",
+				},
+				"auth_token_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only authentication token used to authenticate requests to the Datadog, Loggly or New Relic destination",
+				},
+				"client_key_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only private key used by the client for mTLS authentication",
+				},
+				"collector_code_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only code used to authenticate requests to the Sumo Logic destination",
+				},
+				"event_collector_token_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only event collector token used to authenticate requests to the Splunk destination",
+				},
+				"password_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only password used to authenticate requests to the destination",
+				},
+				"private_key_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only private key of the Google Cloud Storage service account",
+				},
+				"secret_access_key_wo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					WriteOnly:   true,
+					Description: "Write-only secret access key used to authenticate requests to the destination",
+				},
+			},
+		},
+		Description: "Write-only secrets of the connector. Values provided here take precedence over the ones in the connector and are not stored in the state",
+	},
+	"connector_secrets_wo_version": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{"connector_secrets"},
+		Description:  "Version of the write-only connector secrets. Changing the version sends the write-only secrets again",
+	},
 }
 
 var configResource = &schema.Resource{
@@ -1737,6 +1810,62 @@ func validateConfig(_ context.Context, d *schema.ResourceDiff, _ interface{}) er
 	return nil
 }
 
+// validateConnectorSecrets checks that every secret of the configured connector is provided either in the connector
+// or in the write-only connector_secrets block. Write-only values are available only in the raw config.
+func validateConnectorSecrets(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	var writeOnlySecrets cty.Value
+	if secrets := rawConfig.GetAttr("connector_secrets"); !secrets.IsKnown() {
+		return nil
+	} else if !secrets.IsNull() && secrets.LengthInt() > 0 {
+		writeOnlySecrets = secrets.Index(cty.NumberIntVal(0))
+	}
+
+	for connectorName, secretNames := range connectorRequiredSecrets {
+		connector := rawConfig.GetAttr(connectorName)
+		if !connector.IsKnown() || connector.IsNull() || connector.LengthInt() == 0 {
+			continue
+		}
+		it := connector.ElementIterator()
+		it.Next()
+		_, connectorProperties := it.Element()
+
+		for _, secretName := range secretNames {
+			if isRawStringProvided(connectorProperties, secretName) ||
+				isRawStringProvided(writeOnlySecrets, secretName+writeOnlySuffix) {
+				continue
+			}
+			return fmt.Errorf("'%s' of %s has to be provided either in the connector or as '%s%s' in connector_secrets",
+				secretName, connectorName, secretName, writeOnlySuffix)
+		}
+	}
+
+	return nil
+}
+
+// isRawStringProvided reports whether the string attribute of given object is set in the raw config.
+// Unknown values are treated as provided, as they are resolved only during apply.
+func isRawStringProvided(obj cty.Value, name string) bool {
+	if obj == cty.NilVal || obj.IsNull() {
+		return false
+	}
+	if !obj.IsKnown() {
+		return true
+	}
+	if !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return false
+	}
+	val := obj.GetAttr(name)
+	if !val.IsKnown() {
+		return true
+	}
+	return !val.IsNull() && val.AsString() != ""
+}
+
 func enforceComputedFieldsChange(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
 	// List of computed fields to reset
 	computedFields := []string{
@@ -1749,10 +1878,11 @@ func enforceComputedFieldsChange(_ context.Context, d *schema.ResourceDiff, _ in
 	// Get all changed keys
 	changedKeys := d.GetChangedKeysPrefix("")
 
-	// Remove "active" from changedKeys
+	// Remove "active" and write-only connector secrets from changedKeys,
+	// write-only values are never stored in the state, so they are always reported as changed
 	filteredKeys := make([]string, 0, len(changedKeys))
 	for _, k := range changedKeys {
-		if k != "active" {
+		if k != "active" && !strings.HasPrefix(k, "connector_secrets.") {
 			filteredKeys = append(filteredKeys, k)
 		}
 	}
@@ -796,7 +796,11 @@ func TestResourceStreamErrors(t *testing.T) {
 		},
 		"missing required parameter in dynatrace connector": {
 			tfFile:    "testdata/TestResourceStream/errors/missing_required_argument/dynatrace_missing_parameter.tf",
-			withError: regexp.MustCompile(`The argument \"api_token\" is required, but no definition was found.`),
+			withError: regexp.MustCompile(`'api_token' of dynatrace_connector has to be provided either in the connector or as\s+'api_token_wo' in connector_secrets`),
+		},
+		"missing secret in splunk connector": {
+			tfFile:    "testdata/TestResourceStream/errors/missing_required_argument/splunk_missing_secret.tf",
+			withError: regexp.MustCompile(`'event_collector_token' of splunk_connector has to be provided either in the\s+connector or as 'event_collector_token_wo' in connector_secrets`),
 		},
 		"missing required parameter in trafficpeak connector": {
 			tfFile:    "testdata/TestResourceStream/errors/missing_required_argument/trafficpeak_missing_parameter.tf",
@@ -1618,6 +1622,120 @@ func TestCustomHeaders(t *testing.T) {
 	}
 }
 
+func TestConnectorSecrets(t *testing.T) {
+	streamConfiguration := datastream.StreamConfiguration{
+		DeliveryConfiguration: datastream.DeliveryConfiguration{
+			Format: datastream.FormatTypeJson,
+			Frequency: datastream.Frequency{
+				IntervalInSeconds: datastream.IntervalInSeconds30,
+			},
+		},
+		ContractID: "test_contract",
+		DatasetFields: []datastream.DatasetFieldID{
+			{
+				DatasetFieldID: 1001,
+			},
+		},
+		GroupID: 1337,
+		Properties: []datastream.PropertyID{
+			{
+				PropertyID: 1,
+			},
+		},
+		StreamName: "test_stream",
+	}
+
+	connectorWithToken := func(token string) datastream.AbstractConnector {
+		return &datastream.SplunkConnector{
+			DisplayName:         "splunk_test_connector_name",
+			EventCollectorToken: token,
+			Endpoint:            "splunk_url",
+			CustomHeaderName:    "custom_header_name",
+			CustomHeaderValue:   "custom_header_value",
+		}
+	}
+
+	getStreamResponse := &datastream.DetailedStreamVersion{
+		StreamStatus:          datastream.StreamStatusInactive,
+		DeliveryConfiguration: streamConfiguration.DeliveryConfiguration,
+		Destination: datastream.Destination{
+			DestinationType:   datastream.DestinationTypeSplunk,
+			DisplayName:       "splunk_test_connector_name",
+			Endpoint:          "splunk_url",
+			CustomHeaderName:  "custom_header_name",
+			CustomHeaderValue: "custom_header_value",
+		},
+		ContractID: streamConfiguration.ContractID,
+		DatasetFields: []datastream.DataSetField{
+			{
+				DatasetFieldID:          1001,
+				DatasetFieldName:        "dataset_field_name_1",
+				DatasetFieldDescription: "dataset_field_desc_1",
+			},
+		},
+		GroupID: streamConfiguration.GroupID,
+		Properties: []datastream.Property{
+			{
+				PropertyID:   1,
+				PropertyName: "property_1",
+			},
+		},
+		StreamID:      streamID,
+		StreamName:    streamConfiguration.StreamName,
+		StreamVersion: 1,
+	}
+
+	client := &datastream.Mock{}
+
+	createStreamConfiguration := streamConfiguration
+	createStreamConfiguration.Destination = connectorWithToken("splunk_event_collector_token")
+	client.On("CreateStream", testutils.MockContext, datastream.CreateStreamRequest{
+		StreamConfiguration: createStreamConfiguration,
+	}).Return(&datastream.DetailedStreamVersion{StreamID: streamID, StreamVersion: 1}, nil).Once()
+
+	updateStreamConfiguration := streamConfiguration
+	updateStreamConfiguration.GroupID = 0
+	updateStreamConfiguration.NotificationEmails = []string{}
+	updateStreamConfiguration.Destination = connectorWithToken("splunk_event_collector_token_rotated")
+	client.On("UpdateStream", testutils.MockContext, datastream.UpdateStreamRequest{
+		StreamID:            streamID,
+		StreamConfiguration: updateStreamConfiguration,
+	}).Return(&datastream.DetailedStreamVersion{StreamID: streamID, StreamVersion: 2}, nil).Once()
+
+	client.On("GetStream", testutils.MockContext, datastream.GetStreamRequest{StreamID: streamID}).
+		Return(getStreamResponse, nil)
+
+	client.On("DeleteStream", testutils.MockContext, datastream.DeleteStreamRequest{
+		StreamID: streamID,
+	}).Return(' ', nil)
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResourceStream/connector_secrets/create.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_datastream.s", "splunk_connector.0.event_collector_token", ""),
+						resource.TestCheckResourceAttr("akamai_datastream.s", "connector_secrets_wo_version", "1"),
+						resource.TestCheckNoResourceAttr("akamai_datastream.s", "connector_secrets.0.event_collector_token_wo"),
+					),
+				},
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResourceStream/connector_secrets/update.tf"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_datastream.s", "splunk_connector.0.event_collector_token", ""),
+						resource.TestCheckResourceAttr("akamai_datastream.s", "connector_secrets_wo_version", "2"),
+						resource.TestCheckNoResourceAttr("akamai_datastream.s", "connector_secrets.0.event_collector_token_wo"),
+					),
+				},
+			},
+		})
+
+		client.AssertExpectations(t)
+	})
+}
+
 func TestMTLS(t *testing.T) {
 	streamID := int64(12321)
 
@@ -0,0 +1,94 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/framework/listresource"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource                 = &zoneListResource{}
+	_ list.ListResourceWithConfigure    = &zoneListResource{}
+	_ list.ListResourceWithRawV6Schemas = &zoneListResource{}
+)
+
+// zoneListPageSize is the number of zones fetched with a single request
+const zoneListPageSize = 100
+
+type zoneListResource struct {
+	meta meta.Meta
+}
+
+// NewZoneListResource returns a new DNS zone list resource
+func NewZoneListResource() list.ListResource {
+	return &zoneListResource{}
+}
+
+func (r *zoneListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected List Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	r.meta = meta.Must(req.ProviderData)
+}
+
+func (r *zoneListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_dns_zone"
+}
+
+func (r *zoneListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists DNS zones available to the current credentials.",
+	}
+}
+
+func (r *zoneListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	listresource.RawV6Schemas(ctx, resourceDNSv2Zone(), resp)
+}
+
+func (r *zoneListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "DNS Zone List")
+	client := inst.Client(r.meta)
+
+	var items []listresource.Item
+	for page := 1; ; page++ {
+		zones, err := client.ListZones(ctx, dns.ListZonesRequest{Page: page, PageSize: zoneListPageSize})
+		if err != nil {
+			stream.Results = listresource.ErrorResults("fetching zones failed", err)
+			return
+		}
+		for _, zone := range zones.Zones {
+			items = append(items, listresource.Item{
+				DisplayName: zone.Zone,
+				Identity: map[string]any{
+					"zone": zone.Zone,
+				},
+				Resource: map[string]any{
+					"zone":     zone.Zone,
+					"type":     zone.Type,
+					"contract": zone.ContractID,
+					"comment":  zone.Comment,
+				},
+			})
+		}
+		if len(zones.Zones) < zoneListPageSize || zones.Metadata == nil || len(items) >= zones.Metadata.TotalElements ||
+			(req.Limit > 0 && int64(len(items)) >= req.Limit) {
+			break
+		}
+	}
+	listresource.Stream(ctx, req, stream, items)
+}
@@ -0,0 +1,120 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/dns"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListZones(t *testing.T) {
+	zones := []dns.ZoneResponse{
+		{Zone: "example.com", Type: "PRIMARY", ContractID: "1-ABC", Comment: "primary zone"},
+		{Zone: "example.net", Type: "SECONDARY", ContractID: "1-ABC"},
+	}
+	zoneIdentity := func(zone string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{"zone": tftypes.NewValue(tftypes.String, zone)}
+	}
+	mockListZones := func(m *dns.Mock, page int, zones []dns.ZoneResponse, total int, err error) {
+		call := m.On("ListZones", testutils.MockContext, dns.ListZonesRequest{Page: page, PageSize: zoneListPageSize}).Once()
+		if err != nil {
+			call.Return(nil, err)
+			return
+		}
+		call.Return(&dns.ZoneListResponse{
+			Metadata: &dns.ListMetadata{Page: page, PageSize: zoneListPageSize, TotalElements: total},
+			Zones:    zones,
+		}, nil)
+	}
+
+	tests := map[string]struct {
+		request         testutils.ListResourceRequest
+		init            func(*dns.Mock)
+		expectedResults []testutils.ListResourceResult
+		expectedError   string
+	}{
+		"list zones": {
+			init: func(m *dns.Mock) {
+				mockListZones(m, 1, zones, 2, nil)
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{DisplayName: "example.com", Identity: zoneIdentity("example.com")},
+				{DisplayName: "example.net", Identity: zoneIdentity("example.net")},
+			},
+		},
+		"list zones from multiple pages": {
+			init: func(m *dns.Mock) {
+				firstPage := make([]dns.ZoneResponse, 0, zoneListPageSize)
+				for i := range zoneListPageSize {
+					firstPage = append(firstPage, dns.ZoneResponse{Zone: fmt.Sprintf("zone%d.example.com", i), Type: "PRIMARY"})
+				}
+				mockListZones(m, 1, firstPage, zoneListPageSize+1, nil)
+				mockListZones(m, 2, zones[:1], zoneListPageSize+1, nil)
+			},
+			request: testutils.ListResourceRequest{Limit: zoneListPageSize + 1},
+			expectedResults: func() []testutils.ListResourceResult {
+				results := make([]testutils.ListResourceResult, 0, zoneListPageSize+1)
+				for i := range zoneListPageSize {
+					zone := fmt.Sprintf("zone%d.example.com", i)
+					results = append(results, testutils.ListResourceResult{DisplayName: zone, Identity: zoneIdentity(zone)})
+				}
+				return append(results, testutils.ListResourceResult{DisplayName: "example.com", Identity: zoneIdentity("example.com")})
+			}(),
+		},
+		"list zones with resource": {
+			request: testutils.ListResourceRequest{IncludeResource: true, Limit: 1},
+			init: func(m *dns.Mock) {
+				mockListZones(m, 1, zones, 2, nil)
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "example.com",
+					Identity:    zoneIdentity("example.com"),
+					Resource: map[string]tftypes.Value{
+						"zone":     tftypes.NewValue(tftypes.String, "example.com"),
+						"type":     tftypes.NewValue(tftypes.String, "PRIMARY"),
+						"contract": tftypes.NewValue(tftypes.String, "1-ABC"),
+						"comment":  tftypes.NewValue(tftypes.String, "primary zone"),
+					},
+				},
+			},
+		},
+		"no zones found": {
+			init: func(m *dns.Mock) {
+				mockListZones(m, 1, nil, 0, nil)
+			},
+		},
+		"error response from api": {
+			init: func(m *dns.Mock) {
+				mockListZones(m, 1, nil, 0, errors.New("oops"))
+			},
+			expectedError: "fetching zones failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &dns.Mock{}
+			test.init(client)
+			test.request.TypeName = "akamai_dns_zone"
+
+			useClient(client, func() {
+				results, diags := testutils.ListResource(t, NewSubprovider(), test.request)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				testutils.AssertListResourceResults(t, test.expectedResults, results)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
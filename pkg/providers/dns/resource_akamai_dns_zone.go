@@ -29,6 +29,17 @@ func resourceDNSv2Zone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDNSv2ZoneImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"zone": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Name of the zone",
+					},
+				}
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"contract": {
 				Type:             schema.TypeString,
@@ -336,6 +347,9 @@ func resourceDNSv2ZoneRead(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 	logger.Info("Zone Read", "zone", hostname)
+	if err := tf.SetIdentity(d, map[string]any{"zone": hostname}); err != nil {
+		return diag.FromErr(err)
+	}
 	masterSet, err := tf.GetSetValue("masters", d)
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
 		return diag.FromErr(err)
@@ -484,6 +498,9 @@ func resourceDNSv2ZoneUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 // Import Zone. Id is the zone
 func resourceDNSv2ZoneImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := tf.ImportIDFromIdentity(d, "zone"); err != nil {
+		return nil, err
+	}
 	hostname := d.Id()
 	meta := meta.Must(m)
 	logger := meta.Log("AkamaiDNS", "resourceDNSZoneImport")
@@ -9,6 +9,8 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/dns"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -649,6 +651,11 @@ func TestResDNSZone(t *testing.T) {
 							resource.TestCheckResourceAttr(dataSourceName, "comment", "This is a test primary zone"),
 							resource.TestCheckResourceAttr(dataSourceName, "group", "grp1"),
 						),
+						ConfigStateChecks: []statecheck.StateCheck{
+							statecheck.ExpectIdentity(dataSourceName, map[string]knownvalue.Check{
+								"zone": knownvalue.StringExact("primaryexampleterraform.io"),
+							}),
+						},
 					},
 					{
 						Config: testutils.LoadFixtureString(t, "testdata/TestResDnsZone/update_primary.tf"),
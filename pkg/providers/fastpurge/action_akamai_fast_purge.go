@@ -0,0 +1,165 @@
+package fastpurge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &fastPurgeAction{}
+	_ action.ActionWithConfigure = &fastPurgeAction{}
+)
+
+type (
+	fastPurgeAction struct {
+		meta meta.Meta
+	}
+
+	fastPurgeActionModel struct {
+		URLs    types.Set    `tfsdk:"urls"`
+		CPCodes types.Set    `tfsdk:"cp_codes"`
+		Tags    types.Set    `tfsdk:"tags"`
+		Network types.String `tfsdk:"network"`
+		Method  types.String `tfsdk:"method"`
+	}
+)
+
+// NewFastPurgeAction returns a new action purging content from the edge servers
+func NewFastPurgeAction() action.Action {
+	return &fastPurgeAction{}
+}
+
+func (a *fastPurgeAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Action Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	a.meta = meta.Must(req.ProviderData)
+}
+
+func (a *fastPurgeAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "akamai_fast_purge"
+}
+
+func (a *fastPurgeAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Purges content from the edge servers using the Fast Purge API.",
+		Attributes: map[string]schema.Attribute{
+			"urls": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "URLs of the content to purge.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ExactlyOneOf(path.MatchRoot("cp_codes"), path.MatchRoot("tags")),
+				},
+			},
+			"cp_codes": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "CP codes of the content to purge.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Cache tags of the content to purge.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"network": schema.StringAttribute{
+				Optional:    true,
+				Description: "Network on which the content is purged, either 'staging' or 'production'. Defaults to 'production'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(NetworkStaging), string(NetworkProduction)),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Description: "Either 'invalidate', which marks the content as stale so that it is revalidated with the origin, " +
+					"or 'delete', which removes the content so that it is fetched again from the origin. Defaults to 'invalidate'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(MethodInvalidate), string(MethodDelete)),
+				},
+			},
+		},
+	}
+}
+
+func (a *fastPurgeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Fast Purge Invoke")
+	var data fastPurgeActionModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := PurgeRequest{
+		Method:  MethodInvalidate,
+		Network: NetworkProduction,
+	}
+	if !data.Method.IsNull() {
+		request.Method = Method(data.Method.ValueString())
+	}
+	if !data.Network.IsNull() {
+		request.Network = Network(data.Network.ValueString())
+	}
+
+	switch {
+	case !data.URLs.IsNull():
+		request.ObjectType = ObjectTypeURL
+		var urls []string
+		resp.Diagnostics.Append(data.URLs.ElementsAs(ctx, &urls, false)...)
+		for _, u := range urls {
+			request.Objects = append(request.Objects, u)
+		}
+	case !data.CPCodes.IsNull():
+		request.ObjectType = ObjectTypeCPCode
+		var cpCodes []int64
+		resp.Diagnostics.Append(data.CPCodes.ElementsAs(ctx, &cpCodes, false)...)
+		for _, cpCode := range cpCodes {
+			request.Objects = append(request.Objects, cpCode)
+		}
+	case !data.Tags.IsNull():
+		request.ObjectType = ObjectTypeTag
+		var tags []string
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		for _, tag := range tags {
+			request.Objects = append(request.Objects, tag)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Requesting %s of %d %s object(s) on %s", request.Method, len(request.Objects), request.ObjectType, request.Network),
+	})
+	purge, err := Client(a.meta).Purge(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("purge failed", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Purge request %s accepted, estimated completion in %d seconds", purge.PurgeID, purge.EstimatedSeconds),
+	})
+}
@@ -0,0 +1,145 @@
+package fastpurge
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFastPurgeAction(t *testing.T) {
+	stringSet := func(values ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(values))
+		for _, v := range values {
+			elems = append(elems, tftypes.NewValue(tftypes.String, v))
+		}
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+	}
+	numberSet := func(values ...int64) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(values))
+		for _, v := range values {
+			elems = append(elems, tftypes.NewValue(tftypes.Number, big.NewFloat(float64(v))))
+		}
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, elems)
+	}
+	accepted := &PurgeResponse{HTTPStatus: 201, EstimatedSeconds: 5, PurgeID: "edcp-1"}
+
+	tests := map[string]struct {
+		config           map[string]tftypes.Value
+		init             func(*mockFastPurge)
+		expectedMessages []string
+		expectedError    string
+	}{
+		"invalidate urls on production by default": {
+			config: map[string]tftypes.Value{
+				"urls": stringSet("https://www.example.com/a", "https://www.example.com/b"),
+			},
+			init: func(m *mockFastPurge) {
+				m.On("Purge", testutils.MockContext, PurgeRequest{
+					Method:     MethodInvalidate,
+					ObjectType: ObjectTypeURL,
+					Network:    NetworkProduction,
+					Objects:    []any{"https://www.example.com/a", "https://www.example.com/b"},
+				}).Return(accepted, nil).Once()
+			},
+			expectedMessages: []string{
+				"Requesting invalidate of 2 url object(s) on production",
+				"Purge request edcp-1 accepted, estimated completion in 5 seconds",
+			},
+		},
+		"delete cp codes on staging": {
+			config: map[string]tftypes.Value{
+				"cp_codes": numberSet(12345),
+				"network":  tftypes.NewValue(tftypes.String, "staging"),
+				"method":   tftypes.NewValue(tftypes.String, "delete"),
+			},
+			init: func(m *mockFastPurge) {
+				m.On("Purge", testutils.MockContext, PurgeRequest{
+					Method:     MethodDelete,
+					ObjectType: ObjectTypeCPCode,
+					Network:    NetworkStaging,
+					Objects:    []any{int64(12345)},
+				}).Return(accepted, nil).Once()
+			},
+			expectedMessages: []string{
+				"Requesting delete of 1 cpcode object(s) on staging",
+				"Purge request edcp-1 accepted, estimated completion in 5 seconds",
+			},
+		},
+		"invalidate tags": {
+			config: map[string]tftypes.Value{
+				"tags": stringSet("images"),
+			},
+			init: func(m *mockFastPurge) {
+				m.On("Purge", testutils.MockContext, PurgeRequest{
+					Method:     MethodInvalidate,
+					ObjectType: ObjectTypeTag,
+					Network:    NetworkProduction,
+					Objects:    []any{"images"},
+				}).Return(accepted, nil).Once()
+			},
+			expectedMessages: []string{
+				"Requesting invalidate of 1 tag object(s) on production",
+				"Purge request edcp-1 accepted, estimated completion in 5 seconds",
+			},
+		},
+		"purge fails": {
+			config: map[string]tftypes.Value{
+				"tags": stringSet("images"),
+			},
+			init: func(m *mockFastPurge) {
+				m.On("Purge", testutils.MockContext, PurgeRequest{
+					Method:     MethodInvalidate,
+					ObjectType: ObjectTypeTag,
+					Network:    NetworkProduction,
+					Objects:    []any{"images"},
+				}).Return(nil, errors.New("oops")).Once()
+			},
+			expectedError: "purge failed",
+		},
+		"more than one type of objects": {
+			config: map[string]tftypes.Value{
+				"urls": stringSet("https://www.example.com/a"),
+				"tags": stringSet("images"),
+			},
+			expectedError: "Invalid Attribute Combination",
+		},
+		"no objects": {
+			config:        map[string]tftypes.Value{},
+			expectedError: "Invalid Attribute Combination",
+		},
+		"invalid network": {
+			config: map[string]tftypes.Value{
+				"tags":    stringSet("images"),
+				"network": tftypes.NewValue(tftypes.String, "qa"),
+			},
+			expectedError: "Invalid Attribute Value Match",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockFastPurge{}
+			if test.init != nil {
+				test.init(client)
+			}
+
+			useClient(client, func() {
+				messages, diags := testutils.InvokeAction(t, NewSubprovider(), "akamai_fast_purge", test.config)
+				if test.expectedError != "" {
+					require.NotEmpty(t, diags)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				assert.Equal(t, test.expectedMessages, messages)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
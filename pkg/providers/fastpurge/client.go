@@ -0,0 +1,185 @@
+package fastpurge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/errs"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+)
+
+type (
+	// FastPurge is the interface for the Fast Purge (CCU v3) API.
+	//
+	// The EdgeGrid library does not provide a client for this API, so a minimal one is implemented here.
+	FastPurge interface {
+		// Purge invalidates or deletes the content identified by URLs, CP codes or cache tags on the given network.
+		//
+		// See: https://techdocs.akamai.com/purge-cache/reference/api
+		Purge(ctx context.Context, params PurgeRequest) (*PurgeResponse, error)
+	}
+
+	fastpurge struct {
+		session.Session
+	}
+
+	// Method is the way the purged content is handled on the edge servers.
+	Method string
+
+	// ObjectType is the type of objects identifying the purged content.
+	ObjectType string
+
+	// Network is the network on which the content is purged.
+	Network string
+
+	// PurgeRequest contains parameters of the purge request.
+	PurgeRequest struct {
+		Method     Method
+		ObjectType ObjectType
+		Network    Network
+		Objects    []any
+	}
+
+	// PurgeResponse contains the response of the accepted purge request.
+	PurgeResponse struct {
+		HTTPStatus       int    `json:"httpStatus"`
+		EstimatedSeconds int    `json:"estimatedSeconds"`
+		PurgeID          string `json:"purgeId"`
+		SupportID        string `json:"supportId"`
+		Detail           string `json:"detail"`
+	}
+
+	// Error is a Fast Purge error.
+	Error struct {
+		Type        string `json:"type"`
+		Title       string `json:"title"`
+		Detail      string `json:"detail"`
+		HTTPStatus  int    `json:"httpStatus"`
+		SupportID   string `json:"supportId"`
+		DescribedBy string `json:"describedBy,omitempty"`
+	}
+
+	purgeBody struct {
+		Objects []any `json:"objects"`
+	}
+)
+
+const (
+	// MethodInvalidate marks the content as stale, so that edge servers revalidate it with the origin.
+	MethodInvalidate Method = "invalidate"
+	// MethodDelete removes the content, so that edge servers fetch it again from the origin.
+	MethodDelete Method = "delete"
+
+	// ObjectTypeURL identifies the content by URLs.
+	ObjectTypeURL ObjectType = "url"
+	// ObjectTypeCPCode identifies the content by CP codes.
+	ObjectTypeCPCode ObjectType = "cpcode"
+	// ObjectTypeTag identifies the content by cache tags.
+	ObjectTypeTag ObjectType = "tag"
+
+	// NetworkStaging is the staging network.
+	NetworkStaging Network = "staging"
+	// NetworkProduction is the production network.
+	NetworkProduction Network = "production"
+)
+
+var (
+	// ErrPurge is returned when the purge request fails.
+	ErrPurge = errors.New("purge")
+	// ErrStructValidation is returned when given struct validation failed.
+	ErrStructValidation = errors.New("struct validation")
+)
+
+// NewClient creates a new Fast Purge client.
+func NewClient(sess session.Session) FastPurge {
+	return &fastpurge{Session: sess}
+}
+
+// Validate validates PurgeRequest.
+func (r PurgeRequest) Validate() error {
+	switch r.Method {
+	case MethodInvalidate, MethodDelete:
+	default:
+		return fmt.Errorf("unsupported method %q", r.Method)
+	}
+	switch r.ObjectType {
+	case ObjectTypeURL, ObjectTypeCPCode, ObjectTypeTag:
+	default:
+		return fmt.Errorf("unsupported object type %q", r.ObjectType)
+	}
+	switch r.Network {
+	case NetworkStaging, NetworkProduction:
+	default:
+		return fmt.Errorf("unsupported network %q", r.Network)
+	}
+	if len(r.Objects) == 0 {
+		return errors.New("at least one object has to be provided")
+	}
+	return nil
+}
+
+func (f *fastpurge) Purge(ctx context.Context, params PurgeRequest) (*PurgeResponse, error) {
+	logger := f.Log(ctx)
+	logger.Debug("Purge")
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w: %s", ErrPurge, ErrStructValidation, err)
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("/ccu/v3/%s/%s/%s", params.Method, params.ObjectType, params.Network))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse url: %s", ErrPurge, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrPurge, err)
+	}
+
+	var result PurgeResponse
+	resp, err := f.Exec(req, &result, purgeBody{Objects: params.Objects})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrPurge, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrPurge, f.Error(resp))
+	}
+
+	return &result, nil
+}
+
+// Error parses an error from the Fast Purge API response.
+func (f *fastpurge) Error(r *http.Response) error {
+	var e Error
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		f.Log(r.Request.Context()).Errorf("reading error response body: %s", err)
+		e.HTTPStatus = r.StatusCode
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		f.Log(r.Request.Context()).Errorf("could not unmarshal API error: %s", err)
+		e.Title = "Failed to unmarshal error body. Fast Purge API failed. Check details for more information."
+		e.Detail = errs.UnescapeContent(string(body))
+	}
+	e.HTTPStatus = r.StatusCode
+	return &e
+}
+
+// Error returns the string representation of the error.
+func (e *Error) Error() string {
+	msg, err := json.MarshalIndent(e, "", "\t")
+	if err != nil {
+		return fmt.Sprintf("error marshaling API error: %s", err)
+	}
+	return fmt.Sprintf("API error: \n%s", msg)
+}
@@ -0,0 +1,169 @@
+package fastpurge
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockAPIClient(t *testing.T, mockServer *httptest.Server) FastPurge {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
+	require.NoError(t, err)
+	return NewClient(s)
+}
+
+func TestPurge(t *testing.T) {
+	tests := map[string]struct {
+		params           PurgeRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedBody     string
+		expectedResponse *PurgeResponse
+		withError        func(*testing.T, error)
+	}{
+		"201 invalidate urls on production": {
+			params: PurgeRequest{
+				Method:     MethodInvalidate,
+				ObjectType: ObjectTypeURL,
+				Network:    NetworkProduction,
+				Objects:    []any{"https://www.example.com/index.html"},
+			},
+			responseStatus: http.StatusCreated,
+			responseBody: `{
+	"httpStatus": 201,
+	"estimatedSeconds": 5,
+	"purgeId": "edcp-6yRJvPKhZKFYXgcZGnDhRF",
+	"supportId": "17PY1321286429616716-211907680",
+	"detail": "Request accepted"
+}`,
+			expectedPath: "/ccu/v3/invalidate/url/production",
+			expectedBody: `{"objects":["https://www.example.com/index.html"]}`,
+			expectedResponse: &PurgeResponse{
+				HTTPStatus:       201,
+				EstimatedSeconds: 5,
+				PurgeID:          "edcp-6yRJvPKhZKFYXgcZGnDhRF",
+				SupportID:        "17PY1321286429616716-211907680",
+				Detail:           "Request accepted",
+			},
+		},
+		"201 delete cp codes on staging": {
+			params: PurgeRequest{
+				Method:     MethodDelete,
+				ObjectType: ObjectTypeCPCode,
+				Network:    NetworkStaging,
+				Objects:    []any{int64(12345), int64(67890)},
+			},
+			responseStatus: http.StatusCreated,
+			responseBody:   `{"httpStatus": 201, "estimatedSeconds": 5, "purgeId": "edcp-1"}`,
+			expectedPath:   "/ccu/v3/delete/cpcode/staging",
+			expectedBody:   `{"objects":[12345,67890]}`,
+			expectedResponse: &PurgeResponse{
+				HTTPStatus:       201,
+				EstimatedSeconds: 5,
+				PurgeID:          "edcp-1",
+			},
+		},
+		"400 bad request": {
+			params: PurgeRequest{
+				Method:     MethodInvalidate,
+				ObjectType: ObjectTypeTag,
+				Network:    NetworkProduction,
+				Objects:    []any{"tag"},
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody: `{
+	"type": "https://problems.purge.akamaiapis.net/-/pep-authn/request-error",
+	"title": "Bad request",
+	"detail": "Invalid tag",
+	"httpStatus": 400,
+	"supportId": "17PY1321286429616716-211907680"
+}`,
+			expectedPath: "/ccu/v3/invalidate/tag/production",
+			withError: func(t *testing.T, err error) {
+				expected := &Error{
+					Type:       "https://problems.purge.akamaiapis.net/-/pep-authn/request-error",
+					Title:      "Bad request",
+					Detail:     "Invalid tag",
+					HTTPStatus: http.StatusBadRequest,
+					SupportID:  "17PY1321286429616716-211907680",
+				}
+				var apiErr *Error
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, expected, apiErr)
+			},
+		},
+		"validation error - no objects": {
+			params: PurgeRequest{
+				Method:     MethodInvalidate,
+				ObjectType: ObjectTypeURL,
+				Network:    NetworkProduction,
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation))
+				assert.Contains(t, err.Error(), "at least one object has to be provided")
+			},
+		},
+		"validation error - unsupported network": {
+			params: PurgeRequest{
+				Method:     MethodInvalidate,
+				ObjectType: ObjectTypeURL,
+				Network:    "qa",
+				Objects:    []any{"https://www.example.com"},
+			},
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrStructValidation))
+				assert.Contains(t, err.Error(), `unsupported network "qa"`)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodPost, r.Method)
+				if test.expectedBody != "" {
+					body, err := io.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.JSONEq(t, test.expectedBody, string(body))
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			client := mockAPIClient(t, mockServer)
+			result, err := client.Purge(context.Background(), test.params)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package fastpurge
+
+import "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/registry"
+
+func init() {
+	registry.RegisterSubprovider(NewSubprovider())
+}
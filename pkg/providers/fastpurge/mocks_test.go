@@ -0,0 +1,21 @@
+package fastpurge
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type mockFastPurge struct {
+	mock.Mock
+}
+
+var _ FastPurge = &mockFastPurge{}
+
+func (m *mockFastPurge) Purge(ctx context.Context, params PurgeRequest) (*PurgeResponse, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PurgeResponse), args.Error(1)
+}
@@ -0,0 +1,79 @@
+// Package fastpurge contains implementation for Akamai Terraform sub-provider responsible for Fast Purge
+package fastpurge
+
+import (
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type (
+	// Subprovider gathers Fast Purge actions
+	Subprovider struct{}
+)
+
+var (
+	_      subprovider.Subprovider = &Subprovider{}
+	client FastPurge
+)
+
+// NewSubprovider returns a new Fast Purge subprovider
+func NewSubprovider() *Subprovider {
+	return &Subprovider{}
+}
+
+// Client returns the Fast Purge interface
+func Client(meta meta.Meta) FastPurge {
+	if client != nil {
+		return client
+	}
+	return NewClient(meta.Session())
+}
+
+// SDKResources returns the Fast Purge resources implemented using terraform-plugin-sdk
+func (p *Subprovider) SDKResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SDKDataSources returns the Fast Purge data sources implemented using terraform-plugin-sdk
+func (p *Subprovider) SDKDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// FrameworkResources returns the Fast Purge resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkResources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+// FrameworkDataSources returns the Fast Purge data sources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkDataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// FrameworkFunctions returns the Fast Purge functions implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkFunctions() []func() function.Function {
+	return []func() function.Function{}
+}
+
+// FrameworkEphemeralResources returns the Fast Purge ephemeral resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+// FrameworkListResources returns the Fast Purge list resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkListResources() []func() list.ListResource {
+	return []func() list.ListResource{}
+}
+
+// FrameworkActions returns the Fast Purge actions implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkActions() []func() action.Action {
+	return []func() action.Action{
+		NewFastPurgeAction,
+	}
+}
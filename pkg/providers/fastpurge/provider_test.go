@@ -0,0 +1,29 @@
+package fastpurge
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+)
+
+func TestMain(m *testing.M) {
+	testutils.TestRunner(m)
+}
+
+// Only allow one test at a time to patch the client via useClient()
+var clientLock sync.Mutex
+
+// useClient swaps out the client on the global instance for the duration of the given func
+func useClient(fastPurgeClient FastPurge, f func()) {
+	clientLock.Lock()
+	orig := client
+	client = fastPurgeClient
+
+	defer func() {
+		client = orig
+		clientLock.Unlock()
+	}()
+
+	f()
+}
@@ -0,0 +1,81 @@
+package gtm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/framework/listresource"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource                 = &domainListResource{}
+	_ list.ListResourceWithConfigure    = &domainListResource{}
+	_ list.ListResourceWithRawV6Schemas = &domainListResource{}
+)
+
+type domainListResource struct {
+	meta meta.Meta
+}
+
+// NewGTMDomainListResource returns a new GTM domain list resource
+func NewGTMDomainListResource() list.ListResource {
+	return &domainListResource{}
+}
+
+func (r *domainListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected List Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	r.meta = meta.Must(req.ProviderData)
+}
+
+func (r *domainListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_gtm_domain"
+}
+
+func (r *domainListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists GTM domains available to the current credentials.",
+	}
+}
+
+func (r *domainListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	listresource.RawV6Schemas(ctx, resourceGTMv1Domain(), resp)
+}
+
+func (r *domainListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "GTM Domain List")
+	domains, err := Client(r.meta).ListDomains(ctx)
+	if err != nil {
+		stream.Results = listresource.ErrorResults("fetching domains failed", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(domains))
+	for _, dom := range domains {
+		items = append(items, listresource.Item{
+			DisplayName: dom.Name,
+			Identity: map[string]any{
+				"name": dom.Name,
+			},
+			Resource: map[string]any{
+				"id":   dom.Name,
+				"name": dom.Name,
+			},
+		})
+	}
+	listresource.Stream(ctx, req, stream, items)
+}
@@ -0,0 +1,90 @@
+package gtm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/gtm"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDomains(t *testing.T) {
+	domains := []gtm.DomainItem{
+		{Name: "test1.terraformtesting.net", AcgID: "TestACGID-1"},
+		{Name: "test2.terraformtesting.net", AcgID: "TestACGID-2"},
+	}
+
+	tests := map[string]struct {
+		request         testutils.ListResourceRequest
+		init            func(*gtm.Mock)
+		expectedResults []testutils.ListResourceResult
+		expectedError   string
+	}{
+		"list domains": {
+			init: func(m *gtm.Mock) {
+				mockListDomains(m, domains, nil, testutils.Once)
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "test1.terraformtesting.net",
+					Identity:    map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "test1.terraformtesting.net")},
+				},
+				{
+					DisplayName: "test2.terraformtesting.net",
+					Identity:    map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "test2.terraformtesting.net")},
+				},
+			},
+		},
+		"list domains with resource": {
+			request: testutils.ListResourceRequest{IncludeResource: true, Limit: 1},
+			init: func(m *gtm.Mock) {
+				mockListDomains(m, domains, nil, testutils.Once)
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "test1.terraformtesting.net",
+					Identity:    map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "test1.terraformtesting.net")},
+					Resource: map[string]tftypes.Value{
+						"id":   tftypes.NewValue(tftypes.String, "test1.terraformtesting.net"),
+						"name": tftypes.NewValue(tftypes.String, "test1.terraformtesting.net"),
+					},
+				},
+			},
+		},
+		"no domains found": {
+			init: func(m *gtm.Mock) {
+				mockListDomains(m, []gtm.DomainItem{}, nil, testutils.Once)
+			},
+		},
+		"error response from api": {
+			init: func(m *gtm.Mock) {
+				mockListDomains(m, nil, errors.New("oops"), testutils.Once)
+			},
+			expectedError: "fetching domains failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &gtm.Mock{}
+			test.init(client)
+			test.request.TypeName = "akamai_gtm_domain"
+
+			useClient(client, func() {
+				results, diags := testutils.ListResource(t, NewSubprovider(), test.request)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				testutils.AssertListResourceResults(t, test.expectedResults, results)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -34,6 +34,17 @@ func resourceGTMv1Domain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGTMv1DomainImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"name": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Name of the domain",
+					},
+				}
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"contract": {
 				Type:             schema.TypeString,
@@ -357,6 +368,9 @@ func resourceGTMv1DomainRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	logger.Debugf("Reading Domain: %s", d.Id())
 	var diags diag.Diagnostics
+	if err := tf.SetIdentity(d, map[string]any{"name": d.Id()}); err != nil {
+		return diag.FromErr(err)
+	}
 	// retrieve the domain
 	dom, err := Client(meta).GetDomain(ctx, gtm.GetDomainRequest{
 		DomainName: d.Id(),
@@ -563,6 +577,10 @@ func resourceGTMv1DomainImport(_ context.Context, d *schema.ResourceData, m inte
 	meta := meta.Must(m)
 	logger := meta.Log("Akamai GTM", "resourceGTMv1DomainImport")
 
+	if err := tf.ImportIDFromIdentity(d, "name"); err != nil {
+		return nil, err
+	}
+
 	// User-supplied import ID is a comma-separated list of domain,[,groupID[,contractID]]
 	// groupID and contractID are optional
 	parts := strings.Split(d.Id(), ",")
@@ -0,0 +1,288 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/date"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &apiClientCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiClientCredentialEphemeralResource{}
+)
+
+const (
+	// rotationTagPrefix starts the tag which is appended to the description of credentials created by the ephemeral resource
+	rotationTagPrefix = "[rotation_trigger: "
+	// maxCredentialsPerClient is the number of credentials an API client can have
+	maxCredentialsPerClient = 2
+)
+
+type (
+	apiClientCredentialEphemeralResource struct {
+		meta meta.Meta
+	}
+
+	apiClientCredentialEphemeralModel struct {
+		ClientID        types.String `tfsdk:"client_id"`
+		RotationTrigger types.String `tfsdk:"rotation_trigger"`
+		Description     types.String `tfsdk:"description"`
+		ExpiresOn       types.String `tfsdk:"expires_on"`
+		CredentialID    types.Int64  `tfsdk:"credential_id"`
+		ClientToken     types.String `tfsdk:"client_token"`
+		ClientSecret    types.String `tfsdk:"client_secret"`
+		CreatedOn       types.String `tfsdk:"created_on"`
+		Status          types.String `tfsdk:"status"`
+		Rotated         types.Bool   `tfsdk:"rotated"`
+	}
+)
+
+// NewAPIClientCredentialEphemeralResource returns a new API client credential ephemeral resource
+func NewAPIClientCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &apiClientCredentialEphemeralResource{}
+}
+
+// Metadata configures ephemeral resource's meta information
+func (e *apiClientCredentialEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "akamai_iam_api_client_credential"
+}
+
+// Configure configures ephemeral resource at the beginning of the lifecycle
+func (e *apiClientCredentialEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// ProviderData is nil when Configure is run first time as part of ValidateEphemeralResourceConfig in framework provider
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Ephemeral Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	e.meta = meta.Must(req.ProviderData)
+}
+
+// Schema is used to define ephemeral resource's terraform schema
+func (e *apiClientCredentialEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a credential of an API client. A new credential is created only when the API client has no " +
+			"credential created for the current `rotation_trigger`, and its client secret, which the API returns only " +
+			"at creation, is never persisted in the state. Otherwise the existing credential is read and `client_secret` " +
+			"is null. Set `rotation_trigger` to a value which is unknown during the plan of a rotation, such as the ID " +
+			"of a `time_rotating` or `terraform_data` resource, so that the credential is created during the apply, and " +
+			"pass `client_secret` to write-only arguments versioned with the same trigger. To stay within the limit of " +
+			"two credentials per API client, the oldest credentials created for previous triggers are deleted before " +
+			"a new one is created. Other credentials are never modified.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "A unique identifier of the API client. If not provided, the credential is rotated for the API client used to run the provider.",
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Required:    true,
+				Description: "An arbitrary value identifying the current credential. Changing it creates a new credential.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "A human-readable description of the credential, used when it is created.",
+			},
+			"expires_on": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ISO 8601 timestamp indicating when the credential expires, used when it is created.",
+			},
+			"credential_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "A unique identifier of the credential.",
+			},
+			"client_token": schema.StringAttribute{
+				Computed:    true,
+				Description: "The part of the client secret that identifies the credential.",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client secret of the credential. Set only when the credential was created, see `rotated`.",
+			},
+			"created_on": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ISO 8601 timestamp indicating when the credential was created.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether the credential is 'ACTIVE', 'INACTIVE', or 'DELETED'.",
+			},
+			"rotated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the credential was created when the ephemeral resource was opened.",
+			},
+		},
+	}
+}
+
+// Open is called when the provider must generate a new ephemeral resource
+func (e *apiClientCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	tflog.Debug(ctx, "Opening API Client Credential Ephemeral Resource")
+
+	var data apiClientCredentialEphemeralModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	var expiresOn time.Time
+	if tf.IsKnown(data.ExpiresOn) {
+		var err error
+		if expiresOn, err = date.ParseFormat(time.RFC3339Nano, data.ExpiresOn.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Parsing expires_on failed", err.Error())
+			return
+		}
+	}
+	clientID, trigger := data.ClientID.ValueString(), data.RotationTrigger.ValueString()
+
+	client := inst.Client(e.meta)
+	credentials, err := client.ListCredentials(ctx, iam.ListCredentialsRequest{ClientID: clientID})
+	if err != nil {
+		resp.Diagnostics.AddError("Reading API Client Credentials failed", err.Error())
+		return
+	}
+
+	var rotatedCredentials []iam.Credential
+	var existing int
+	for _, credential := range credentials {
+		if credential.Status == iam.CredentialDeleted {
+			continue
+		}
+		existing++
+		description, credentialTrigger, ok := parseRotationDescription(credential.Description)
+		if !ok {
+			continue
+		}
+		if credentialTrigger == trigger {
+			tflog.Debug(ctx, fmt.Sprintf("Credential %d was already created for the rotation trigger", credential.CredentialID))
+			data.CredentialID = types.Int64Value(credential.CredentialID)
+			data.ClientToken = types.StringValue(credential.ClientToken)
+			data.ClientSecret = types.StringNull()
+			data.CreatedOn = types.StringValue(credential.CreatedOn.Format(time.RFC3339Nano))
+			data.Description = types.StringValue(description)
+			data.ExpiresOn = types.StringValue(credential.ExpiresOn.Format(time.RFC3339Nano))
+			data.Status = types.StringValue(string(credential.Status))
+			data.Rotated = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+			return
+		}
+		rotatedCredentials = append(rotatedCredentials, credential)
+	}
+
+	// make room for the new credential, starting with the oldest one rotated by Terraform
+	sort.Slice(rotatedCredentials, func(i, j int) bool {
+		return rotatedCredentials[i].CreatedOn.Before(rotatedCredentials[j].CreatedOn)
+	})
+	for _, credential := range rotatedCredentials {
+		if existing < maxCredentialsPerClient {
+			break
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Removing credential %d created for a previous rotation trigger", credential.CredentialID))
+		if err := deleteCredential(ctx, client, clientID, credential.CredentialID); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Removing Credential %d failed", credential.CredentialID), err.Error())
+			return
+		}
+		existing--
+	}
+
+	credential, err := client.CreateCredential(ctx, iam.CreateCredentialRequest{
+		ClientID: clientID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Creating API Client Credential failed", err.Error())
+		return
+	}
+
+	description := credential.Description
+	if tf.IsKnown(data.Description) {
+		description = data.Description.ValueString()
+	}
+	updateCredentialReq := iam.UpdateCredentialRequest{
+		CredentialID: credential.CredentialID,
+		ClientID:     clientID,
+		Body: iam.UpdateCredentialRequestBody{
+			Description: rotationDescription(description, trigger),
+			ExpiresOn:   credential.ExpiresOn,
+			Status:      credential.Status,
+		},
+	}
+	if tf.IsKnown(data.ExpiresOn) {
+		updateCredentialReq.Body.ExpiresOn = expiresOn
+	}
+	// the description tags the credential with the rotation trigger, so it is always updated
+	updated, err := client.UpdateCredential(ctx, updateCredentialReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Updating API Client Credential failed", err.Error())
+		// an untagged credential would never be rotated
+		if err := deleteCredential(ctx, client, clientID, credential.CredentialID); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Removing Credential %d failed", credential.CredentialID), err.Error())
+		}
+		return
+	}
+
+	data.CredentialID = types.Int64Value(credential.CredentialID)
+	data.ClientToken = types.StringValue(credential.ClientToken)
+	data.ClientSecret = types.StringValue(credential.ClientSecret)
+	data.CreatedOn = types.StringValue(credential.CreatedOn.Format(time.RFC3339Nano))
+	data.Description = types.StringValue(description)
+	data.ExpiresOn = types.StringValue(updated.ExpiresOn.Format(time.RFC3339Nano))
+	data.Status = types.StringValue(string(updated.Status))
+	data.Rotated = types.BoolValue(true)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// rotationDescription tags the description of the credential with the rotation trigger it was created for
+func rotationDescription(description, trigger string) string {
+	tag := rotationTagPrefix + trigger + "]"
+	if description == "" {
+		return tag
+	}
+	return description + " " + tag
+}
+
+// parseRotationDescription returns the description of the credential without the tag, and the rotation trigger
+// it was created for, if the credential was created by the ephemeral resource
+func parseRotationDescription(description string) (string, string, bool) {
+	i := strings.LastIndex(description, rotationTagPrefix)
+	if i < 0 || !strings.HasSuffix(description, "]") {
+		return description, "", false
+	}
+	return strings.TrimSpace(description[:i]), description[i+len(rotationTagPrefix) : len(description)-1], true
+}
+
+// deleteCredential deactivates the credential, which is required before it can be deleted, and deletes it
+func deleteCredential(ctx context.Context, client iam.IAM, clientID string, credentialID int64) error {
+	if err := client.DeactivateCredential(ctx, iam.DeactivateCredentialRequest{
+		ClientID:     clientID,
+		CredentialID: credentialID,
+	}); err != nil {
+		return fmt.Errorf("deactivating credential: %w", err)
+	}
+	if err := client.DeleteCredential(ctx, iam.DeleteCredentialRequest{
+		ClientID:     clientID,
+		CredentialID: credentialID,
+	}); err != nil {
+		return fmt.Errorf("deleting credential: %w", err)
+	}
+	return nil
+}
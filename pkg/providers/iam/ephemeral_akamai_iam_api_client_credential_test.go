@@ -0,0 +1,242 @@
+package iam
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/iam"
+	"github.com/akamai/terraform-provider-akamai/v9/internal/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/ptr"
+	tst "github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralAPIClientCredential(t *testing.T) {
+	createResponse := iam.CreateCredentialResponse{
+		ClientSecret: "client_secret",
+		ClientToken:  "client_token",
+		CreatedOn:    test.NewTimeFromStringMust("2025-06-13T14:48:08.000Z"),
+		CredentialID: 123,
+		Description:  "",
+		ExpiresOn:    test.NewTimeFromStringMust("2027-06-13T14:48:08.000Z"),
+		Status:       iam.CredentialActive,
+	}
+	providerCredential := iam.Credential{
+		ClientToken:  "provider_token",
+		CreatedOn:    test.NewTimeFromStringMust("2024-01-01T10:00:00.000Z"),
+		CredentialID: 1,
+		Description:  "used by the provider",
+		ExpiresOn:    test.NewTimeFromStringMust("2026-01-01T10:00:00.000Z"),
+		Status:       iam.CredentialActive,
+	}
+	rotatedCredential := func(description string) iam.Credential {
+		return iam.Credential{
+			ClientToken:  "client_token",
+			CreatedOn:    test.NewTimeFromStringMust("2025-06-13T14:48:08.000Z"),
+			CredentialID: 123,
+			Description:  description,
+			ExpiresOn:    test.NewTimeFromStringMust("2027-06-13T14:48:08.000Z"),
+			Status:       iam.CredentialActive,
+		}
+	}
+
+	tests := map[string]struct {
+		init  func(*iam.Mock)
+		steps []resource.TestStep
+	}{
+		"happy path - credential created for the trigger is read": {
+			init: func(m *iam.Mock) {
+				m.On("ListCredentials", testutils.MockContext, iam.ListCredentialsRequest{}).
+					Return(iam.ListCredentialsResponse{providerCredential, rotatedCredential("[rotation_trigger: 1]")}, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestEphemeralAPIClientCredential/self.tf"),
+					Check: tst.NewStateChecker("echo.test").
+						CheckEqual("data.credential_id", "123").
+						CheckEqual("data.client_token", "client_token").
+						CheckMissing("data.client_secret").
+						CheckEqual("data.created_on", "2025-06-13T14:48:08Z").
+						CheckEqual("data.expires_on", "2027-06-13T14:48:08Z").
+						CheckEqual("data.description", "").
+						CheckEqual("data.status", "ACTIVE").
+						CheckEqual("data.rotated", "false").
+						Build(),
+				},
+			},
+		},
+		"happy path - credential created for a new trigger": {
+			init: func(m *iam.Mock) {
+				m.On("ListCredentials", testutils.MockContext, iam.ListCredentialsRequest{ClientID: "c1ien41d"}).
+					Return(iam.ListCredentialsResponse{providerCredential, {
+						ClientToken:  "old_token",
+						CreatedOn:    test.NewTimeFromStringMust("2024-06-13T14:48:08.000Z"),
+						CredentialID: 50,
+						Description:  "Rotated by Terraform [rotation_trigger: 1]",
+						Status:       iam.CredentialActive,
+					}}, nil).Once()
+				m.On("DeactivateCredential", testutils.MockContext, iam.DeactivateCredentialRequest{
+					CredentialID: 50,
+					ClientID:     "c1ien41d",
+				}).Return(nil).Once()
+				m.On("DeleteCredential", testutils.MockContext, iam.DeleteCredentialRequest{
+					CredentialID: 50,
+					ClientID:     "c1ien41d",
+				}).Return(nil).Once()
+				m.On("CreateCredential", testutils.MockContext, iam.CreateCredentialRequest{ClientID: "c1ien41d"}).
+					Return(&createResponse, nil).Once()
+				m.On("UpdateCredential", testutils.MockContext, iam.UpdateCredentialRequest{
+					CredentialID: 123,
+					ClientID:     "c1ien41d",
+					Body: iam.UpdateCredentialRequestBody{
+						Description: "Rotated by Terraform [rotation_trigger: 2]",
+						ExpiresOn:   test.NewTimeFromStringMust("2027-06-13T14:48:08.000Z"),
+						Status:      iam.CredentialActive,
+					},
+				}).Return(&iam.UpdateCredentialResponse{
+					Status:      iam.CredentialActive,
+					ExpiresOn:   test.NewTimeFromStringMust("2027-06-13T14:48:08.000Z"),
+					Description: ptr.To("Rotated by Terraform [rotation_trigger: 2]"),
+				}, nil).Once()
+				// the credential is not created again once it is tagged with the trigger
+				m.On("ListCredentials", testutils.MockContext, iam.ListCredentialsRequest{ClientID: "c1ien41d"}).
+					Return(iam.ListCredentialsResponse{providerCredential, rotatedCredential("Rotated by Terraform [rotation_trigger: 2]")}, nil)
+			},
+			steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureString(t, "testdata/TestEphemeralAPIClientCredential/rotate.tf"),
+					Check: tst.NewStateChecker("echo.test").
+						CheckEqual("data.credential_id", "123").
+						CheckEqual("data.client_token", "client_token").
+						CheckEqual("data.description", "Rotated by Terraform").
+						CheckEqual("data.expires_on", "2027-06-13T14:48:08Z").
+						CheckEqual("data.status", "ACTIVE").
+						Build(),
+				},
+			},
+		},
+		"update credential error - created credential is removed": {
+			init: func(m *iam.Mock) {
+				m.On("ListCredentials", testutils.MockContext, iam.ListCredentialsRequest{ClientID: "c1ien41d"}).
+					Return(iam.ListCredentialsResponse{providerCredential}, nil).Once()
+				m.On("CreateCredential", testutils.MockContext, iam.CreateCredentialRequest{ClientID: "c1ien41d"}).
+					Return(&createResponse, nil).Once()
+				m.On("UpdateCredential", testutils.MockContext, iam.UpdateCredentialRequest{
+					CredentialID: 123,
+					ClientID:     "c1ien41d",
+					Body: iam.UpdateCredentialRequestBody{
+						Description: "Rotated by Terraform [rotation_trigger: 2]",
+						ExpiresOn:   test.NewTimeFromStringMust("2027-06-13T14:48:08.000Z"),
+						Status:      iam.CredentialActive,
+					},
+				}).Return(nil, errors.New("update error")).Once()
+				m.On("DeactivateCredential", testutils.MockContext, iam.DeactivateCredentialRequest{
+					CredentialID: 123,
+					ClientID:     "c1ien41d",
+				}).Return(nil).Once()
+				m.On("DeleteCredential", testutils.MockContext, iam.DeleteCredentialRequest{
+					CredentialID: 123,
+					ClientID:     "c1ien41d",
+				}).Return(nil).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestEphemeralAPIClientCredential/rotate.tf"),
+					ExpectError: regexp.MustCompile("update error"),
+				},
+			},
+		},
+		"create credential error": {
+			init: func(m *iam.Mock) {
+				m.On("ListCredentials", testutils.MockContext, iam.ListCredentialsRequest{}).
+					Return(iam.ListCredentialsResponse{}, nil).Once()
+				m.On("CreateCredential", testutils.MockContext, iam.CreateCredentialRequest{}).
+					Return(nil, errors.New("test error")).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestEphemeralAPIClientCredential/self.tf"),
+					ExpectError: regexp.MustCompile("test error"),
+				},
+			},
+		},
+		"list credentials error": {
+			init: func(m *iam.Mock) {
+				m.On("ListCredentials", testutils.MockContext, iam.ListCredentialsRequest{}).
+					Return(nil, errors.New("list error")).Once()
+			},
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestEphemeralAPIClientCredential/self.tf"),
+					ExpectError: regexp.MustCompile("list error"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &iam.Mock{}
+			if tc.init != nil {
+				tc.init(client)
+			}
+
+			providerFactories := testutils.NewProtoV6ProviderFactory(NewSubprovider())
+			providerFactories["echo"] = echoprovider.NewProviderServer()
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+						tfversion.SkipBelow(tfversion.Version1_10_0),
+					},
+					ProtoV6ProviderFactories: providerFactories,
+					Steps:                    tc.steps,
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRotationDescription(t *testing.T) {
+	tests := map[string]struct {
+		description         string
+		trigger             string
+		expectedDescription string
+	}{
+		"empty description": {
+			trigger:             "1",
+			expectedDescription: "[rotation_trigger: 1]",
+		},
+		"description": {
+			description:         "Rotated by Terraform",
+			trigger:             "2025-06-13T14:48:08Z",
+			expectedDescription: "Rotated by Terraform [rotation_trigger: 2025-06-13T14:48:08Z]",
+		},
+		"trigger with brackets": {
+			description:         "a [b]",
+			trigger:             "[c]",
+			expectedDescription: "a [b] [rotation_trigger: [c]]",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			description := rotationDescription(tc.description, tc.trigger)
+			assert.Equal(t, tc.expectedDescription, description)
+
+			parsedDescription, parsedTrigger, ok := parseRotationDescription(description)
+			assert.True(t, ok)
+			assert.Equal(t, tc.description, parsedDescription)
+			assert.Equal(t, tc.trigger, parsedTrigger)
+		})
+	}
+
+	_, _, ok := parseRotationDescription("used by the provider")
+	assert.False(t, ok)
+}
@@ -5,7 +5,9 @@ import (
 	"strconv"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/iam"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -65,6 +67,7 @@ func resourceIAMGroupCreate(ctx context.Context, rd *schema.ResourceData, m inte
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	invalidateGroupsCache(logger)
 
 	rd.SetId(strconv.FormatInt(group.GroupID, 10))
 
@@ -129,6 +132,7 @@ func resourceIAMGroupUpdate(ctx context.Context, rd *schema.ResourceData, m inte
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		invalidateGroupsCache(logger)
 	}
 
 	if rd.HasChange("parent_group_id") {
@@ -136,6 +140,7 @@ func resourceIAMGroupUpdate(ctx context.Context, rd *schema.ResourceData, m inte
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		invalidateGroupsCache(logger)
 	}
 
 	return resourceIAMGroupRead(ctx, rd, m)
@@ -155,8 +160,16 @@ func resourceIAMGroupDelete(ctx context.Context, rd *schema.ResourceData, m inte
 	if err := client.RemoveGroup(ctx, iam.RemoveGroupRequest{GroupID: groupID}); err != nil {
 		return diag.FromErr(err)
 	}
+	invalidateGroupsCache(logger)
 
 	rd.SetId("")
 
 	return nil
 }
+
+// invalidateGroupsCache drops cached groups, so that subsequent lookups see the changed group hierarchy
+func invalidateGroupsCache(logger log.Interface) {
+	if err := cache.Invalidate(cache.BucketGroups); err != nil {
+		logger.Warnf("error invalidating cached groups: %s", err)
+	}
+}
@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+ephemeral "akamai_iam_api_client_credential" "test" {
+  client_id        = "c1ien41d"
+  rotation_trigger = "2"
+  description      = "Rotated by Terraform"
+  expires_on       = "2027-06-13T14:48:08Z"
+}
+
+provider "echo" {
+  data = ephemeral.akamai_iam_api_client_credential.test
+}
+
+resource "echo" "test" {}
@@ -0,0 +1,227 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var (
+	_ action.Action              = &propertyRollbackAction{}
+	_ action.ActionWithConfigure = &propertyRollbackAction{}
+)
+
+type (
+	propertyRollbackAction struct {
+		meta meta.Meta
+	}
+
+	propertyRollbackActionModel struct {
+		PropertyID                  types.String `tfsdk:"property_id"`
+		Network                     types.String `tfsdk:"network"`
+		Version                     types.Int64  `tfsdk:"version"`
+		Contact                     types.Set    `tfsdk:"contact"`
+		Note                        types.String `tfsdk:"note"`
+		AutoAcknowledgeRuleWarnings types.Bool   `tfsdk:"auto_acknowledge_rule_warnings"`
+	}
+)
+
+// NewPropertyRollbackAction returns a new action reactivating the previously active property version on a network
+func NewPropertyRollbackAction() action.Action {
+	return &propertyRollbackAction{}
+}
+
+func (a *propertyRollbackAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Action Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	a.meta = meta.Must(req.ProviderData)
+}
+
+func (a *propertyRollbackAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "akamai_property_rollback"
+}
+
+func (a *propertyRollbackAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reactivates the previously active version of a property on the given network.",
+		Attributes: map[string]schema.Attribute{
+			"property_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the property to roll back.",
+			},
+			"network": schema.StringAttribute{
+				Required:    true,
+				Description: "Network on which the property is rolled back, either 'STAGING' or 'PRODUCTION' or one of their aliases.",
+			},
+			"version": schema.Int64Attribute{
+				Optional: true,
+				Description: "Version of the property to reactivate. " +
+					"Defaults to the version that was active on the network before the current one.",
+			},
+			"contact": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Email addresses notified about the activation.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"note": schema.StringAttribute{
+				Optional:    true,
+				Description: "Note attached to the activation.",
+			},
+			"auto_acknowledge_rule_warnings": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Automatically acknowledge all rule warnings for activation to continue. Default is false.",
+			},
+		},
+	}
+}
+
+func (a *propertyRollbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	tflog.Debug(ctx, "Property Rollback Invoke")
+	var data propertyRollbackActionModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	alias, err := NetworkAlias(data.Network.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("network"), "invalid network", err.Error())
+		return
+	}
+	network := papi.ActivationNetwork(alias)
+	propertyID := str.AddPrefix(data.PropertyID.ValueString(), "prp_")
+
+	var contacts []string
+	if resp.Diagnostics.Append(data.Contact.ElementsAs(ctx, &contacts, false)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := Client(a.meta)
+	activations, err := client.GetActivations(ctx, papi.GetActivationsRequest{PropertyID: propertyID})
+	if err != nil {
+		resp.Diagnostics.AddError("fetching activations failed", err.Error())
+		return
+	}
+	current, err := findLatestActive(activations.Activations.Items, network)
+	if err != nil {
+		resp.Diagnostics.AddError("rollback failed", fmt.Sprintf("property %s has no active version on %s", propertyID, network))
+		return
+	}
+
+	version := int(data.Version.ValueInt64())
+	if data.Version.IsNull() {
+		previous, err := findPreviousActive(activations.Activations.Items, current)
+		if err != nil {
+			resp.Diagnostics.AddError("rollback failed",
+				fmt.Sprintf("property %s has no version active on %s before version %d", propertyID, network, current.PropertyVersion))
+			return
+		}
+		version = previous.PropertyVersion
+	}
+	if version == current.PropertyVersion {
+		resp.Diagnostics.AddError("rollback failed", fmt.Sprintf("version %d is already active on %s", version, network))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rolling back property %s on %s from version %d to version %d", propertyID, network, current.PropertyVersion, version),
+	})
+	activationID, diags := createActivation(ctx, client, papi.CreateActivationRequest{
+		PropertyID: propertyID,
+		Activation: papi.Activation{
+			ActivationType:         papi.ActivationTypeActivate,
+			Network:                network,
+			PropertyVersion:        version,
+			NotifyEmails:           contacts,
+			AcknowledgeAllWarnings: data.AutoAcknowledgeRuleWarnings.ValueBool(),
+			Note:                   data.Note.ValueString(),
+		},
+	})
+	if diags.HasError() {
+		appendSDKDiagnostics(&resp.Diagnostics, diags)
+		return
+	}
+
+	act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+		ActivationID: activationID,
+		PropertyID:   propertyID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("fetching activation failed", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Activation %s created, waiting for it to complete", activationID),
+	})
+
+	activation, diags := pollActivation(ctx, client, act.Activation, propertyID)
+	if diags.HasError() {
+		appendSDKDiagnostics(&resp.Diagnostics, diags)
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Version %d of property %s is active on %s", activation.PropertyVersion, propertyID, network),
+	})
+}
+
+// findPreviousActive returns the activation of the version that was active on the network of the given current activation
+// before it.
+func findPreviousActive(activations []*papi.Activation, current *papi.Activation) (*papi.Activation, error) {
+	sorted := slices.Clone(activations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].UpdateDate > sorted[j].UpdateDate
+	})
+
+	var found bool
+	for _, activation := range sorted {
+		if activation == current {
+			found = true
+			continue
+		}
+		if !found || activation.Network != current.Network || activation.ActivationType != papi.ActivationTypeActivate {
+			continue
+		}
+		if activation.PropertyVersion == current.PropertyVersion {
+			continue
+		}
+		if activation.Status == papi.ActivationStatusActive || activation.Status == papi.ActivationStatusInactive {
+			return activation, nil
+		}
+	}
+	return nil, errNoActiveVersionFound
+}
+
+func appendSDKDiagnostics(target *fwdiag.Diagnostics, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			target.AddError(d.Summary, d.Detail)
+		} else {
+			target.AddWarning(d.Summary, d.Detail)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package property
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyRollbackAction(t *testing.T) {
+	activations := &papi.GetActivationsResponse{Activations: papi.ActivationsItems{Items: []*papi.Activation{
+		{ActivationID: "atv_1", ActivationType: papi.ActivationTypeActivate, Network: papi.ActivationNetworkProduction,
+			PropertyVersion: 1, Status: papi.ActivationStatusInactive, UpdateDate: "2025-01-01T10:00:00Z"},
+		{ActivationID: "atv_2", ActivationType: papi.ActivationTypeActivate, Network: papi.ActivationNetworkStaging,
+			PropertyVersion: 3, Status: papi.ActivationStatusActive, UpdateDate: "2025-01-04T10:00:00Z"},
+		{ActivationID: "atv_3", ActivationType: papi.ActivationTypeActivate, Network: papi.ActivationNetworkProduction,
+			PropertyVersion: 2, Status: papi.ActivationStatusActive, UpdateDate: "2025-01-03T10:00:00Z"},
+		{ActivationID: "atv_4", ActivationType: papi.ActivationTypeActivate, Network: papi.ActivationNetworkProduction,
+			PropertyVersion: 3, Status: papi.ActivationStatusFailed, UpdateDate: "2025-01-02T10:00:00Z"},
+	}}}
+	config := func(extra map[string]tftypes.Value) map[string]tftypes.Value {
+		cfg := map[string]tftypes.Value{
+			"property_id": tftypes.NewValue(tftypes.String, "prp_1"),
+			"network":     tftypes.NewValue(tftypes.String, "PROD"),
+			"contact": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "user@example.com"),
+			}),
+		}
+		for k, v := range extra {
+			cfg[k] = v
+		}
+		return cfg
+	}
+	expectActivation := func(m *papi.Mock, version int, note string) {
+		m.On("CreateActivation", testutils.MockContext, papi.CreateActivationRequest{
+			PropertyID: "prp_1",
+			Activation: papi.Activation{
+				ActivationType:  papi.ActivationTypeActivate,
+				Network:         papi.ActivationNetworkProduction,
+				PropertyVersion: version,
+				NotifyEmails:    []string{"user@example.com"},
+				Note:            note,
+			},
+		}).Return(&papi.CreateActivationResponse{ActivationID: "atv_5"}, nil).Once()
+		m.On("GetActivation", testutils.MockContext, papi.GetActivationRequest{PropertyID: "prp_1", ActivationID: "atv_5"}).
+			Return(&papi.GetActivationResponse{Activation: &papi.Activation{
+				ActivationID:    "atv_5",
+				Network:         papi.ActivationNetworkProduction,
+				PropertyVersion: version,
+				Status:          papi.ActivationStatusActive,
+			}}, nil).Once()
+	}
+
+	tests := map[string]struct {
+		config           map[string]tftypes.Value
+		init             func(*papi.Mock)
+		expectedMessages []string
+		expectedError    string
+	}{
+		"rollback to previous version": {
+			config: config(nil),
+			init: func(m *papi.Mock) {
+				m.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+					Return(activations, nil).Once()
+				expectActivation(m, 1, "")
+			},
+			expectedMessages: []string{
+				"Rolling back property prp_1 on PRODUCTION from version 2 to version 1",
+				"Activation atv_5 created, waiting for it to complete",
+				"Version 1 of property prp_1 is active on PRODUCTION",
+			},
+		},
+		"rollback to given version": {
+			config: config(map[string]tftypes.Value{
+				"version": tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+				"note":    tftypes.NewValue(tftypes.String, "emergency"),
+			}),
+			init: func(m *papi.Mock) {
+				m.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+					Return(activations, nil).Once()
+				expectActivation(m, 3, "emergency")
+			},
+			expectedMessages: []string{
+				"Rolling back property prp_1 on PRODUCTION from version 2 to version 3",
+				"Activation atv_5 created, waiting for it to complete",
+				"Version 3 of property prp_1 is active on PRODUCTION",
+			},
+		},
+		"given version is already active": {
+			config: config(map[string]tftypes.Value{
+				"version": tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+			}),
+			init: func(m *papi.Mock) {
+				m.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+					Return(activations, nil).Once()
+			},
+			expectedError: "rollback failed",
+		},
+		"no previous version": {
+			config: config(map[string]tftypes.Value{
+				"network": tftypes.NewValue(tftypes.String, "staging"),
+			}),
+			init: func(m *papi.Mock) {
+				m.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+					Return(activations, nil).Once()
+			},
+			expectedError: "rollback failed",
+		},
+		"fetching activations fails": {
+			config: config(nil),
+			init: func(m *papi.Mock) {
+				m.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+					Return(nil, errors.New("oops")).Once()
+			},
+			expectedError: "fetching activations failed",
+		},
+		"invalid network": {
+			config: config(map[string]tftypes.Value{
+				"network": tftypes.NewValue(tftypes.String, "qa"),
+			}),
+			expectedError: "invalid network",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			if test.init != nil {
+				test.init(client)
+			}
+
+			useClient(client, nil, func() {
+				messages, diags := testutils.InvokeAction(t, NewSubprovider(), "akamai_property_rollback", test.config)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				assert.Equal(t, test.expectedMessages, messages)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+)
+
+// newProbeClient returns an HTTP client for requests checking hostnames served by a property.
+// The client connects to the address returned by dialAddress for the address of the request, which allows
+// sending the requests to the staging network. Redirects are not followed.
+func newProbeClient(timeout time.Duration, dialAddress func(address string) string) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{Timeout: timeout}
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, dialAddress(address))
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// probe sends a GET request to the URL and checks the response.
+func probe(ctx context.Context, client *http.Client, url string, check func(*http.Response) error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	return check(resp)
+}
+
+// retryProbe calls probeFn until it succeeds or the attempts are exhausted, and returns the last error.
+func retryProbe(ctx context.Context, attempts int, interval time.Duration, logger log.Interface, url string, probeFn func() error) error {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err = probeFn(); err == nil {
+			return nil
+		}
+		logger.Debugf("attempt %d of request to %s failed: %s", attempt, url, err)
+	}
+	return err
+}
+
+// checkStatusCode verifies the status code of the response is one of the expected ones, or 2xx or 3xx if none are expected.
+func checkStatusCode(resp *http.Response, expected map[int]struct{}) error {
+	if len(expected) == 0 {
+		if resp.StatusCode >= 200 && resp.StatusCode < 400 {
+			return nil
+		}
+	} else if _, ok := expected[resp.StatusCode]; ok {
+		return nil
+	}
+	return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+}
@@ -0,0 +1,63 @@
+package property
+
+import (
+	"context"
+	"errors"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
+)
+
+// getCachedGroups returns groups from the cache if present, or fetches them and stores them in the cache
+func getCachedGroups(ctx context.Context, client papi.PAPI) (*papi.GetGroupsResponse, error) {
+	return getCached(ctx, cache.BucketGroups, "papi", func() (*papi.GetGroupsResponse, error) {
+		return client.GetGroups(ctx)
+	})
+}
+
+// getCachedContracts returns contracts from the cache if present, or fetches them and stores them in the cache
+func getCachedContracts(ctx context.Context, client papi.PAPI) (*papi.GetContractsResponse, error) {
+	return getCached(ctx, cache.BucketContracts, "papi", func() (*papi.GetContractsResponse, error) {
+		return client.GetContracts(ctx)
+	})
+}
+
+// getCachedProducts returns products of the contract from the cache if present, or fetches them and stores them in the cache
+func getCachedProducts(ctx context.Context, client papi.PAPI, contractID string) (*papi.GetProductsResponse, error) {
+	return getCached(ctx, cache.BucketProducts, contractID, func() (*papi.GetProductsResponse, error) {
+		return client.GetProducts(ctx, papi.GetProductsRequest{ContractID: contractID})
+	})
+}
+
+// getCachedRuleFormats returns rule formats from the cache if present, or fetches them and stores them in the cache
+func getCachedRuleFormats(ctx context.Context, client papi.PAPI) (*papi.GetRuleFormatsResponse, error) {
+	return getCached(ctx, cache.BucketRuleFormats, "papi", func() (*papi.GetRuleFormatsResponse, error) {
+		return client.GetRuleFormats(ctx)
+	})
+}
+
+// getCached reads the response stored under the key in the bucket. On a cache miss, the response is fetched
+// and stored in the cache. Failing to read from or write to the cache is not an error, as the response can always be fetched.
+func getCached[T any](ctx context.Context, bucket cache.Bucket, key string, fetch func() (*T, error)) (*T, error) {
+	logger := log.FromContext(ctx)
+
+	response := new(T)
+	err := cache.Get(ctx, bucket, key, response)
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, cache.ErrDisabled) && !errors.Is(err, cache.ErrEntryNotFound) {
+		logger.Warnf("error reading %s from cache: %s", bucket.Name(), err)
+	}
+
+	response, err = fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cache.Set(bucket, key, response); err != nil && !errors.Is(err, cache.ErrDisabled) {
+		logger.Warnf("error caching %s: %s", bucket.Name(), err)
+	}
+	return response, nil
+}
@@ -0,0 +1,258 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/errs"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+)
+
+type (
+	// CPRG is the interface for the reporting groups of the CP Codes and Reporting Groups API.
+	//
+	// The EdgeGrid library covers only the CP codes part of this API, so a minimal client for reporting groups is implemented here.
+	CPRG interface {
+		// GetReportingGroup returns the reporting group with the given ID.
+		//
+		// See: https://techdocs.akamai.com/cp-codes/reference/get-reporting-group
+		GetReportingGroup(ctx context.Context, reportingGroupID int) (*ReportingGroup, error)
+
+		// CreateReportingGroup creates a new reporting group.
+		//
+		// See: https://techdocs.akamai.com/cp-codes/reference/post-reporting-groups
+		CreateReportingGroup(ctx context.Context, params ReportingGroup) (*ReportingGroup, error)
+
+		// UpdateReportingGroup updates the name and the CP codes of the reporting group.
+		//
+		// See: https://techdocs.akamai.com/cp-codes/reference/put-reporting-group
+		UpdateReportingGroup(ctx context.Context, params ReportingGroup) (*ReportingGroup, error)
+
+		// DeleteReportingGroup deletes the reporting group with the given ID.
+		//
+		// See: https://techdocs.akamai.com/cp-codes/reference/delete-reporting-group
+		DeleteReportingGroup(ctx context.Context, reportingGroupID int) error
+	}
+
+	cprg struct {
+		session.Session
+	}
+
+	// ReportingGroup is a group of CP codes reported together.
+	ReportingGroup struct {
+		ID          int                       `json:"reportingGroupId,omitempty"`
+		Name        string                    `json:"reportingGroupName"`
+		Contracts   []ReportingGroupContract  `json:"contracts"`
+		AccessGroup ReportingGroupAccessGroup `json:"accessGroup"`
+	}
+
+	// ReportingGroupContract lists the CP codes of the reporting group belonging to a contract.
+	ReportingGroupContract struct {
+		ContractID string                 `json:"contractId"`
+		CPCodes    []ReportingGroupCPCode `json:"cpcodes"`
+	}
+
+	// ReportingGroupCPCode is a CP code of the reporting group.
+	ReportingGroupCPCode struct {
+		ID   int    `json:"cpcodeId"`
+		Name string `json:"cpcodeName,omitempty"`
+	}
+
+	// ReportingGroupAccessGroup is the group and contract whose users can access the reporting group.
+	ReportingGroupAccessGroup struct {
+		GroupID    int    `json:"groupId"`
+		ContractID string `json:"contractId"`
+	}
+
+	// CPRGError is a CP Codes and Reporting Groups API error.
+	CPRGError struct {
+		Type     string `json:"type"`
+		Title    string `json:"title"`
+		Detail   string `json:"detail"`
+		Instance string `json:"instance,omitempty"`
+		Status   int    `json:"status"`
+	}
+)
+
+var (
+	// ErrGetReportingGroup is returned when fetching the reporting group fails.
+	ErrGetReportingGroup = errors.New("fetching reporting group")
+	// ErrCreateReportingGroup is returned when creating the reporting group fails.
+	ErrCreateReportingGroup = errors.New("creating reporting group")
+	// ErrUpdateReportingGroup is returned when updating the reporting group fails.
+	ErrUpdateReportingGroup = errors.New("updating reporting group")
+	// ErrDeleteReportingGroup is returned when deleting the reporting group fails.
+	ErrDeleteReportingGroup = errors.New("deleting reporting group")
+	// ErrReportingGroupNotFound is returned when the reporting group does not exist.
+	ErrReportingGroupNotFound = errors.New("reporting group not found")
+)
+
+// NewCPRGClient creates a new client of the reporting groups of the CP Codes and Reporting Groups API.
+func NewCPRGClient(sess session.Session) CPRG {
+	return &cprg{Session: sess}
+}
+
+// Validate validates ReportingGroup.
+func (g ReportingGroup) Validate() error {
+	if g.Name == "" {
+		return errors.New("name is required")
+	}
+	if len(g.Contracts) == 0 {
+		return errors.New("at least one contract has to be provided")
+	}
+	for _, contract := range g.Contracts {
+		if contract.ContractID == "" {
+			return errors.New("contract ID is required")
+		}
+		if len(contract.CPCodes) == 0 {
+			return fmt.Errorf("at least one CP code has to be provided for contract %s", contract.ContractID)
+		}
+	}
+	if g.AccessGroup.GroupID == 0 || g.AccessGroup.ContractID == "" {
+		return errors.New("group ID and contract ID of the access group are required")
+	}
+	return nil
+}
+
+func (c *cprg) GetReportingGroup(ctx context.Context, reportingGroupID int) (*ReportingGroup, error) {
+	logger := c.Log(ctx)
+	logger.Debug("GetReportingGroup")
+
+	uri := fmt.Sprintf("/cprg/v1/reporting-groups/%d", reportingGroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetReportingGroup, err)
+	}
+
+	var result ReportingGroup
+	resp, err := c.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetReportingGroup, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetReportingGroup, c.Error(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *cprg) CreateReportingGroup(ctx context.Context, params ReportingGroup) (*ReportingGroup, error) {
+	logger := c.Log(ctx)
+	logger.Debug("CreateReportingGroup")
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCreateReportingGroup, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/cprg/v1/reporting-groups", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateReportingGroup, err)
+	}
+
+	var result ReportingGroup
+	resp, err := c.Exec(req, &result, params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateReportingGroup, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("%s: %w", ErrCreateReportingGroup, c.Error(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *cprg) UpdateReportingGroup(ctx context.Context, params ReportingGroup) (*ReportingGroup, error) {
+	logger := c.Log(ctx)
+	logger.Debug("UpdateReportingGroup")
+
+	if params.ID == 0 {
+		return nil, fmt.Errorf("%s: reporting group ID is required", ErrUpdateReportingGroup)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateReportingGroup, err)
+	}
+
+	uri := fmt.Sprintf("/cprg/v1/reporting-groups/%d", params.ID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateReportingGroup, err)
+	}
+
+	var result ReportingGroup
+	resp, err := c.Exec(req, &result, params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateReportingGroup, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateReportingGroup, c.Error(resp))
+	}
+
+	return &result, nil
+}
+
+func (c *cprg) DeleteReportingGroup(ctx context.Context, reportingGroupID int) error {
+	logger := c.Log(ctx)
+	logger.Debug("DeleteReportingGroup")
+
+	uri := fmt.Sprintf("/cprg/v1/reporting-groups/%d", reportingGroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %s", ErrDeleteReportingGroup, err)
+	}
+
+	resp, err := c.Exec(req, nil)
+	if err != nil {
+		return fmt.Errorf("%w: request failed: %s", ErrDeleteReportingGroup, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %w", ErrDeleteReportingGroup, c.Error(resp))
+	}
+
+	return nil
+}
+
+// Error parses an error from the CP Codes and Reporting Groups API response.
+func (c *cprg) Error(r *http.Response) error {
+	var e CPRGError
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		c.Log(r.Request.Context()).Errorf("reading error response body: %s", err)
+		e.Status = r.StatusCode
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		c.Log(r.Request.Context()).Errorf("could not unmarshal API error: %s", err)
+		e.Title = "Failed to unmarshal error body. CP Codes and Reporting Groups API failed. Check details for more information."
+		e.Detail = errs.UnescapeContent(string(body))
+	}
+	e.Status = r.StatusCode
+	return &e
+}
+
+// Error returns the string representation of the error.
+func (e *CPRGError) Error() string {
+	msg, err := json.MarshalIndent(e, "", "\t")
+	if err != nil {
+		return fmt.Sprintf("error marshaling API error: %s", err)
+	}
+	return fmt.Sprintf("API error: \n%s", msg)
+}
+
+// Is reports whether the error is ErrReportingGroupNotFound, when the API responded with 404.
+func (e *CPRGError) Is(target error) bool {
+	return target == ErrReportingGroupNotFound && e.Status == http.StatusNotFound
+}
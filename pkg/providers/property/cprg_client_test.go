@@ -0,0 +1,207 @@
+package property
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockCPRGAPIClient(t *testing.T, mockServer *httptest.Server) CPRG {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
+	require.NoError(t, err)
+	return NewCPRGClient(s)
+}
+
+func TestCPRGClient(t *testing.T) {
+	reportingGroup := ReportingGroup{
+		Name: "web",
+		Contracts: []ReportingGroupContract{{
+			ContractID: "1-ABC",
+			CPCodes:    []ReportingGroupCPCode{{ID: 123}, {ID: 456}},
+		}},
+		AccessGroup: ReportingGroupAccessGroup{GroupID: 2, ContractID: "1-ABC"},
+	}
+	responseBody := `{
+	"reportingGroupId": 42,
+	"reportingGroupName": "web",
+	"contracts": [{"contractId": "1-ABC", "cpcodes": [{"cpcodeId": 123, "cpcodeName": "www"}, {"cpcodeId": 456, "cpcodeName": "static"}]}],
+	"accessGroup": {"groupId": 2, "contractId": "1-ABC"}
+}`
+	expectedResponse := &ReportingGroup{
+		ID:   42,
+		Name: "web",
+		Contracts: []ReportingGroupContract{{
+			ContractID: "1-ABC",
+			CPCodes:    []ReportingGroupCPCode{{ID: 123, Name: "www"}, {ID: 456, Name: "static"}},
+		}},
+		AccessGroup: ReportingGroupAccessGroup{GroupID: 2, ContractID: "1-ABC"},
+	}
+	updated := reportingGroup
+	updated.ID = 42
+
+	tests := map[string]struct {
+		call             func(CPRG) (*ReportingGroup, error)
+		responseStatus   int
+		responseBody     string
+		expectedMethod   string
+		expectedPath     string
+		expectedBody     string
+		expectedResponse *ReportingGroup
+		withError        func(*testing.T, error)
+	}{
+		"200 get reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.GetReportingGroup(context.Background(), 42)
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     responseBody,
+			expectedMethod:   http.MethodGet,
+			expectedPath:     "/cprg/v1/reporting-groups/42",
+			expectedResponse: expectedResponse,
+		},
+		"404 get reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.GetReportingGroup(context.Background(), 42)
+			},
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"type": "/cprg/error-types/not-found", "title": "Not Found", "detail": "Reporting group 42 not found", "status": 404}`,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/cprg/v1/reporting-groups/42",
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "fetching reporting group")
+				assert.True(t, errors.Is(err, ErrReportingGroupNotFound))
+				var apiErr *CPRGError
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, "Reporting group 42 not found", apiErr.Detail)
+			},
+		},
+		"201 create reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.CreateReportingGroup(context.Background(), reportingGroup)
+			},
+			responseStatus:   http.StatusCreated,
+			responseBody:     responseBody,
+			expectedMethod:   http.MethodPost,
+			expectedPath:     "/cprg/v1/reporting-groups",
+			expectedBody:     `{"reportingGroupName": "web", "contracts": [{"contractId": "1-ABC", "cpcodes": [{"cpcodeId": 123}, {"cpcodeId": 456}]}], "accessGroup": {"groupId": 2, "contractId": "1-ABC"}}`,
+			expectedResponse: expectedResponse,
+		},
+		"400 create reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.CreateReportingGroup(context.Background(), reportingGroup)
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "/cprg/error-types/bad-request", "title": "Bad Request", "detail": "CP code 456 does not belong to contract 1-ABC", "status": 400}`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/cprg/v1/reporting-groups",
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "creating reporting group")
+				assert.False(t, errors.Is(err, ErrReportingGroupNotFound))
+				assert.Contains(t, err.Error(), "CP code 456 does not belong to contract 1-ABC")
+			},
+		},
+		"validation error - no CP codes": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.CreateReportingGroup(context.Background(), ReportingGroup{
+					Name:        "web",
+					Contracts:   []ReportingGroupContract{{ContractID: "1-ABC"}},
+					AccessGroup: ReportingGroupAccessGroup{GroupID: 2, ContractID: "1-ABC"},
+				})
+			},
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "creating reporting group")
+				assert.Contains(t, err.Error(), "at least one CP code has to be provided for contract 1-ABC")
+			},
+		},
+		"200 update reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.UpdateReportingGroup(context.Background(), updated)
+			},
+			responseStatus:   http.StatusOK,
+			responseBody:     responseBody,
+			expectedMethod:   http.MethodPut,
+			expectedPath:     "/cprg/v1/reporting-groups/42",
+			expectedBody:     `{"reportingGroupId": 42, "reportingGroupName": "web", "contracts": [{"contractId": "1-ABC", "cpcodes": [{"cpcodeId": 123}, {"cpcodeId": 456}]}], "accessGroup": {"groupId": 2, "contractId": "1-ABC"}}`,
+			expectedResponse: expectedResponse,
+		},
+		"validation error - update without ID": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return c.UpdateReportingGroup(context.Background(), reportingGroup)
+			},
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "updating reporting group")
+				assert.Contains(t, err.Error(), "reporting group ID is required")
+			},
+		},
+		"204 delete reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return nil, c.DeleteReportingGroup(context.Background(), 42)
+			},
+			responseStatus: http.StatusNoContent,
+			expectedMethod: http.MethodDelete,
+			expectedPath:   "/cprg/v1/reporting-groups/42",
+		},
+		"404 delete reporting group": {
+			call: func(c CPRG) (*ReportingGroup, error) {
+				return nil, c.DeleteReportingGroup(context.Background(), 42)
+			},
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"title": "Not Found", "status": 404}`,
+			expectedMethod: http.MethodDelete,
+			expectedPath:   "/cprg/v1/reporting-groups/42",
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "deleting reporting group")
+				assert.True(t, errors.Is(err, ErrReportingGroupNotFound))
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, test.expectedMethod, r.Method)
+				if test.expectedBody != "" {
+					body, err := io.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.JSONEq(t, test.expectedBody, string(body))
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			client := mockCPRGAPIClient(t, mockServer)
+			result, err := test.call(client)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package property
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type mockCPRG struct {
+	mock.Mock
+}
+
+var _ CPRG = &mockCPRG{}
+
+func (m *mockCPRG) GetReportingGroup(ctx context.Context, reportingGroupID int) (*ReportingGroup, error) {
+	args := m.Called(ctx, reportingGroupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReportingGroup), args.Error(1)
+}
+
+func (m *mockCPRG) CreateReportingGroup(ctx context.Context, params ReportingGroup) (*ReportingGroup, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReportingGroup), args.Error(1)
+}
+
+func (m *mockCPRG) UpdateReportingGroup(ctx context.Context, params ReportingGroup) (*ReportingGroup, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ReportingGroup), args.Error(1)
+}
+
+func (m *mockCPRG) DeleteReportingGroup(ctx context.Context, reportingGroupID int) error {
+	args := m.Called(ctx, reportingGroupID)
+	return args.Error(0)
+}
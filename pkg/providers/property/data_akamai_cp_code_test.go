@@ -205,6 +205,9 @@ func TestDSCPCode(t *testing.T) {
 		client.On("GetCPCode", testutils.MockContext, papi.GetCPCodeRequest{CPCodeID: "123", ContractID: "ctr_11", GroupID: "grp_22"}).Return(&papi.GetCPCodesResponse{CPCode: papi.CPCode{
 			ID: "cpc_123", Name: "test-ft-cp-code", CreatedDate: "2021-11-11T11:22:33Z", ProductIDs: []string{"prd_3"},
 		}}, nil).Times(3)
+		client.On("GetCPCodeDetail", testutils.MockContext, 123).Return(&papi.CPCodeDetailResponse{
+			ID: 123, Name: "test-ft-cp-code",
+		}, nil).Times(3)
 		useClient(client, nil, func() {
 			resource.UnitTest(t, resource.TestCase{
 				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
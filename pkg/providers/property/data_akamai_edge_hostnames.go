@@ -0,0 +1,201 @@
+package property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEdgeHostnames() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataEdgeHostnamesRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The unique identifier for the contract.",
+				ValidateDiagFunc: tf.IsNotBlank,
+			},
+			"group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The unique identifier for the group.",
+				ValidateDiagFunc: tf.IsNotBlank,
+			},
+			"include_usage": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to search for the property versions using every edge hostname. Every edge hostname is searched for with a separate request.",
+			},
+			"edge_hostnames": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of edge hostnames",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_hostname_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the edge hostname.",
+						},
+						"edge_hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the edge hostname, including its domain suffix.",
+						},
+						"product_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The product the edge hostname was created for.",
+						},
+						"domain_prefix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the edge hostname without its domain suffix.",
+						},
+						"domain_suffix": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain suffix of the edge hostname, e.g. `edgesuite.net` or `edgekey.net`.",
+						},
+						"secure": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the edge hostname serves traffic over HTTPS.",
+						},
+						"ip_behavior": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP version of the edge hostname, either `IPV4`, `IPV6_PERFORMANCE` or `IPV6_COMPLIANCE`.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the edge hostname, set only while it is being created.",
+						},
+						"use_cases": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A JSON encoded list of use cases",
+						},
+						"in_use": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether any property version uses the edge hostname. Always `false` when `include_usage` is disabled.",
+						},
+						"used_by": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The property versions with hostnames pointing to the edge hostname.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_id":       {Type: schema.TypeString, Computed: true},
+									"property_name":     {Type: schema.TypeString, Computed: true},
+									"property_version":  {Type: schema.TypeInt, Computed: true},
+									"hostname":          {Type: schema.TypeString, Computed: true},
+									"production_status": {Type: schema.TypeString, Computed: true},
+									"staging_status":    {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataEdgeHostnamesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	client := Client(meta)
+	logger := meta.Log("PAPI", "dataEdgeHostnamesRead")
+
+	contractID, err := tf.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID = str.AddPrefix(contractID, "ctr_")
+	groupID, err := tf.GetStringValue("group_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groupID = str.AddPrefix(groupID, "grp_")
+	includeUsage, err := tf.GetBoolValue("include_usage", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	logger.Debugf("Listing edge hostnames of contract %s and group %s", contractID, groupID)
+	resp, err := client.GetEdgeHostnames(ctx, papi.GetEdgeHostnamesRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+	})
+	if err != nil {
+		return diag.Errorf("could not list edge hostnames: %s", err)
+	}
+
+	edgeHostnames := make([]map[string]interface{}, 0, len(resp.EdgeHostnames.Items))
+	for _, item := range resp.EdgeHostnames.Items {
+		useCasesJSON, err := useCases2JSON(item.UseCases)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		edgeHostname := map[string]interface{}{
+			"edge_hostname_id": item.ID,
+			"edge_hostname":    item.Domain,
+			"product_id":       item.ProductID,
+			"domain_prefix":    item.DomainPrefix,
+			"domain_suffix":    item.DomainSuffix,
+			"secure":           item.Secure,
+			"ip_behavior":      item.IPVersionBehavior,
+			"status":           item.Status,
+			"use_cases":        string(useCasesJSON),
+		}
+		if includeUsage {
+			usedBy, err := edgeHostnameUsage(ctx, client, item.Domain)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			edgeHostname["in_use"] = len(usedBy) > 0
+			edgeHostname["used_by"] = usedBy
+		}
+		edgeHostnames = append(edgeHostnames, edgeHostname)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", contractID, groupID))
+	if err := d.Set("edge_hostnames", edgeHostnames); err != nil {
+		return diag.Errorf("%s: %s", tf.ErrValueSet, err)
+	}
+	return nil
+}
+
+// edgeHostnameUsage returns the property versions with hostnames pointing to the given edge hostname.
+func edgeHostnameUsage(ctx context.Context, client papi.PAPI, edgeHostname string) ([]map[string]interface{}, error) {
+	search, err := client.SearchProperties(ctx, papi.SearchRequest{
+		Key:   papi.SearchKeyEdgeHostname,
+		Value: edgeHostname,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not search properties using edge hostname %s: %w", edgeHostname, err)
+	}
+
+	usedBy := make([]map[string]interface{}, 0, len(search.Versions.Items))
+	for _, item := range search.Versions.Items {
+		usedBy = append(usedBy, map[string]interface{}{
+			"property_id":       item.PropertyID,
+			"property_name":     item.PropertyName,
+			"property_version":  item.PropertyVersion,
+			"hostname":          item.Hostname,
+			"production_status": item.ProductionStatus,
+			"staging_status":    item.StagingStatus,
+		})
+	}
+	return usedBy, nil
+}
@@ -0,0 +1,149 @@
+package property
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDSEdgeHostnames(t *testing.T) {
+	mockGetEdgeHostnames := func(client *papi.Mock) {
+		client.On("GetEdgeHostnames", testutils.MockContext, papi.GetEdgeHostnamesRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+		}).Return(&papi.GetEdgeHostnamesResponse{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			EdgeHostnames: papi.EdgeHostnameItems{Items: []papi.EdgeHostnameGetItem{
+				{
+					ID:                "ehn_1",
+					Domain:            "www.example.com.edgekey.net",
+					ProductID:         "prd_Fresca",
+					DomainPrefix:      "www.example.com",
+					DomainSuffix:      "edgekey.net",
+					Secure:            true,
+					IPVersionBehavior: "IPV6_COMPLIANCE",
+				},
+				{
+					ID:                "ehn_2",
+					Domain:            "old.example.com.edgesuite.net",
+					ProductID:         "prd_Fresca",
+					DomainPrefix:      "old.example.com",
+					DomainSuffix:      "edgesuite.net",
+					IPVersionBehavior: "IPV4",
+					UseCases:          []papi.UseCase{{Option: "BACKGROUND", Type: "GLOBAL", UseCase: "Download_Mode"}},
+				},
+			}},
+		}, nil)
+	}
+
+	t.Run("list edge hostnames with usage", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockGetEdgeHostnames(client)
+		client.On("SearchProperties", testutils.MockContext, papi.SearchRequest{
+			Key:   papi.SearchKeyEdgeHostname,
+			Value: "www.example.com.edgekey.net",
+		}).Return(&papi.SearchResponse{Versions: papi.SearchItems{Items: []papi.SearchItem{
+			{
+				PropertyID:       "prp_1",
+				PropertyName:     "example",
+				PropertyVersion:  3,
+				Hostname:         "www.example.com",
+				EdgeHostname:     "www.example.com.edgekey.net",
+				ProductionStatus: "ACTIVE",
+				StagingStatus:    "INACTIVE",
+			},
+		}}}, nil)
+		client.On("SearchProperties", testutils.MockContext, papi.SearchRequest{
+			Key:   papi.SearchKeyEdgeHostname,
+			Value: "old.example.com.edgesuite.net",
+		}).Return(&papi.SearchResponse{}, nil)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestDSEdgeHostnames/edge_hostnames.tf"),
+					Check: test.NewStateChecker("data.akamai_edge_hostnames.test").
+						CheckEqual("id", "ctr_1:grp_2").
+						CheckEqual("edge_hostnames.#", "2").
+						CheckEqual("edge_hostnames.0.edge_hostname_id", "ehn_1").
+						CheckEqual("edge_hostnames.0.edge_hostname", "www.example.com.edgekey.net").
+						CheckEqual("edge_hostnames.0.product_id", "prd_Fresca").
+						CheckEqual("edge_hostnames.0.domain_prefix", "www.example.com").
+						CheckEqual("edge_hostnames.0.domain_suffix", "edgekey.net").
+						CheckEqual("edge_hostnames.0.secure", "true").
+						CheckEqual("edge_hostnames.0.ip_behavior", "IPV6_COMPLIANCE").
+						CheckEqual("edge_hostnames.0.use_cases", "").
+						CheckEqual("edge_hostnames.0.in_use", "true").
+						CheckEqual("edge_hostnames.0.used_by.#", "1").
+						CheckEqual("edge_hostnames.0.used_by.0.property_id", "prp_1").
+						CheckEqual("edge_hostnames.0.used_by.0.property_name", "example").
+						CheckEqual("edge_hostnames.0.used_by.0.property_version", "3").
+						CheckEqual("edge_hostnames.0.used_by.0.hostname", "www.example.com").
+						CheckEqual("edge_hostnames.0.used_by.0.production_status", "ACTIVE").
+						CheckEqual("edge_hostnames.0.used_by.0.staging_status", "INACTIVE").
+						CheckEqual("edge_hostnames.1.edge_hostname_id", "ehn_2").
+						CheckEqual("edge_hostnames.1.secure", "false").
+						CheckEqual("edge_hostnames.1.ip_behavior", "IPV4").
+						CheckEqual("edge_hostnames.1.use_cases", "[\n  {\n    \"option\": \"BACKGROUND\",\n    \"type\": \"GLOBAL\",\n    \"useCase\": \"Download_Mode\"\n  }\n]").
+						CheckEqual("edge_hostnames.1.in_use", "false").
+						CheckEqual("edge_hostnames.1.used_by.#", "0").
+						Build(),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("list edge hostnames without usage", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockGetEdgeHostnames(client)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestDSEdgeHostnames/without_usage.tf"),
+					Check: test.NewStateChecker("data.akamai_edge_hostnames.test").
+						CheckEqual("edge_hostnames.#", "2").
+						CheckEqual("edge_hostnames.0.in_use", "false").
+						CheckEqual("edge_hostnames.0.used_by.#", "0").
+						Build(),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockGetEdgeHostnames(client)
+		client.On("SearchProperties", testutils.MockContext, papi.SearchRequest{
+			Key:   papi.SearchKeyEdgeHostname,
+			Value: "www.example.com.edgekey.net",
+		}).Return(nil, errors.New("oops"))
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestDSEdgeHostnames/edge_hostnames.tf"),
+					ExpectError: regexp.MustCompile("could not search properties using edge hostname www.example.com.edgekey.net: oops"),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,395 @@
+package property
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// bulkSearchModeServer searches the rule trees with the bulk search of the Property Manager API
+	bulkSearchModeServer = "SERVER"
+	// bulkSearchModeClient fetches the rule tree of every searched property and searches it in the provider
+	bulkSearchModeClient = "CLIENT"
+)
+
+var (
+	// BulkSearchPollInterval is the interval for polling the status of a bulk search
+	BulkSearchPollInterval = time.Second * 5
+
+	// bulkSearchResultVersions maps the searched versions to whether a version found by the bulk search is the searched one
+	bulkSearchResultVersions = map[string]func(BulkSearchResult) bool{
+		"LATEST":     func(r BulkSearchResult) bool { return r.IsLatest },
+		"STAGING":    func(r BulkSearchResult) bool { return r.StagingStatus == string(papi.VersionStatusActive) },
+		"PRODUCTION": func(r BulkSearchResult) bool { return r.ProductionStatus == string(papi.VersionStatusActive) },
+	}
+)
+
+// bulkSearchVersions maps the searched versions to the version of a property which is searched
+var bulkSearchVersions = map[string]func(papi.Property) *int{
+	"LATEST":     func(p papi.Property) *int { return &p.LatestVersion },
+	"STAGING":    func(p papi.Property) *int { return p.StagingVersion },
+	"PRODUCTION": func(p papi.Property) *int { return p.ProductionVersion },
+}
+
+func dataSourcePropertyBulkSearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyBulkSearchRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "The contract whose properties are searched.",
+			},
+			"group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Groups whose properties are searched. All groups of the contract are searched by default.",
+			},
+			"match": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "JSONPath expression evaluated over the rule tree of every property, e.g. " +
+					"`$..behaviors[?(@.name == 'origin')].options`. Locations are relative to the `{\"rules\": ...}` object.",
+			},
+			"qualifiers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "JSONPath expressions which all have to match the rule tree of a property for its matches to be reported.",
+			},
+			"version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "LATEST",
+				ValidateDiagFunc: tf.ValidateStringInSlice([]string{"LATEST", "STAGING", "PRODUCTION"}),
+				Description: "The version of every property which is searched: 'LATEST', 'STAGING' or 'PRODUCTION'. Default is 'LATEST'. " +
+					"Properties not active on the given network are skipped.",
+			},
+			"search_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          bulkSearchModeServer,
+				ValidateDiagFunc: tf.ValidateStringInSlice([]string{bulkSearchModeServer, bulkSearchModeClient}),
+				Description: "How the rule trees are searched: 'SERVER' submits a bulk search to the Property Manager API, " +
+					"'CLIENT' fetches the rule tree of every searched property and evaluates the expressions in the provider. Default is 'SERVER'.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Properties whose rule tree matches.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id":      {Type: schema.TypeString, Computed: true},
+						"property_name":    {Type: schema.TypeString, Computed: true},
+						"group_id":         {Type: schema.TypeString, Computed: true},
+						"property_version": {Type: schema.TypeInt, Computed: true},
+						"match_locations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "JSON pointers to the matched values, e.g. `/rules/children/0/behaviors/1/options`.",
+						},
+						"match_values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The matched values encoded as JSON, in the order of `match_locations`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataPropertyBulkSearchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "dataPropertyBulkSearchRead")
+	ctx = log.NewContext(ctx, logger)
+
+	contractID, err := tf.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID = str.AddPrefix(contractID, "ctr_")
+	match, err := tf.GetStringValue("match", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var qualifiers []string
+	for _, q := range d.Get("qualifiers").([]interface{}) {
+		qualifiers = append(qualifiers, q.(string))
+	}
+	version := d.Get("version").(string)
+
+	var results []interface{}
+	if d.Get("search_mode").(string) == bulkSearchModeClient {
+		results, err = searchPropertiesInClient(ctx, d, meta, contractID, match, qualifiers, version)
+	} else {
+		results, err = searchPropertiesInServer(ctx, d, meta, contractID, match, qualifiers, version)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", contractID, version, match))
+	if err := d.Set("results", results); err != nil {
+		return diag.Errorf("%s: %s", tf.ErrValueSet, err)
+	}
+	return nil
+}
+
+// searchPropertiesInServer submits the bulk search of the rule trees and waits for its results.
+// The rule trees of the matched property versions are fetched to report the matched values.
+func searchPropertiesInServer(ctx context.Context, d *schema.ResourceData, meta meta.Meta, contractID, match string,
+	qualifiers []string, version string) ([]interface{}, error) {
+	logger := log.FromContext(ctx)
+	client := Client(meta)
+	bulk := BulkClient(meta)
+
+	groupIDs := make(map[string]struct{})
+	for _, groupID := range d.Get("group_ids").(*schema.Set).List() {
+		groupIDs[str.AddPrefix(groupID.(string), "grp_")] = struct{}{}
+	}
+	// the search can be limited to a single group only, others are filtered out of its results
+	var searchedGroupID string
+	if len(groupIDs) == 1 {
+		for groupID := range groupIDs {
+			searchedGroupID = groupID
+		}
+	}
+
+	logger.Debugf("submitting bulk search of properties in contract %s", contractID)
+	created, err := bulk.CreateBulkSearch(ctx, CreateBulkSearchRequest{
+		ContractID: contractID,
+		GroupID:    searchedGroupID,
+		Query: BulkSearchQuery{
+			Syntax:     BulkSearchSyntaxJSONPath,
+			Match:      match,
+			Qualifiers: qualifiers,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	search, err := waitForBulkSearch(ctx, bulk, GetBulkSearchRequest{
+		BulkSearchID: created.BulkSearchID,
+		ContractID:   contractID,
+		GroupID:      searchedGroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	isSearchedVersion := bulkSearchResultVersions[version]
+	var found []BulkSearchResult
+	for _, result := range search.Results {
+		if _, ok := groupIDs[result.GroupID]; len(groupIDs) > 0 && !ok {
+			continue
+		}
+		if !isSearchedVersion(result) || len(result.MatchLocations) == 0 {
+			continue
+		}
+		found = append(found, result)
+	}
+	slices.SortFunc(found, func(a, b BulkSearchResult) int {
+		return cmp.Or(cmp.Compare(a.GroupID, b.GroupID), cmp.Compare(a.PropertyID, b.PropertyID))
+	})
+
+	results := make([]interface{}, 0, len(found))
+	for _, result := range found {
+		property := papi.Property{PropertyID: result.PropertyID, GroupID: result.GroupID, ContractID: contractID}
+		rules, _, err := fetchRulesWithoutValidation(ctx, client, property, result.PropertyVersion)
+		if err != nil {
+			return nil, err
+		}
+		document, err := decodeRuleTree(rules)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode rules of property %s: %w", result.PropertyID, err)
+		}
+		values := make([]string, 0, len(result.MatchLocations))
+		for _, location := range result.MatchLocations {
+			path, err := parseJSONPointer(location)
+			if err != nil {
+				return nil, fmt.Errorf("invalid match location of property %s: %w", result.PropertyID, err)
+			}
+			value, err := getJSONValue(document, path)
+			if err != nil {
+				return nil, fmt.Errorf("could not resolve match location %s of property %s version %d: %w",
+					location, result.PropertyID, result.PropertyVersion, err)
+			}
+			values = append(values, formatJSONValue(value))
+		}
+		results = append(results, map[string]interface{}{
+			"property_id":      result.PropertyID,
+			"property_name":    result.PropertyName,
+			"group_id":         result.GroupID,
+			"property_version": result.PropertyVersion,
+			"match_locations":  result.MatchLocations,
+			"match_values":     values,
+		})
+	}
+	return results, nil
+}
+
+// waitForBulkSearch polls the bulk search until it is complete
+func waitForBulkSearch(ctx context.Context, bulk PAPIBulk, req GetBulkSearchRequest) (*BulkSearch, error) {
+	for {
+		search, err := bulk.GetBulkSearch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		switch search.Status {
+		case BulkSearchStatusComplete:
+			return search, nil
+		case BulkSearchStatusError:
+			return nil, fmt.Errorf("bulk search %d failed", req.BulkSearchID)
+		}
+
+		select {
+		case <-time.After(BulkSearchPollInterval):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("timeout waiting for bulk search %d to complete", req.BulkSearchID)
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// searchPropertiesInClient fetches the rule tree of the searched version of every property in the groups
+// and evaluates the expressions over it
+func searchPropertiesInClient(ctx context.Context, d *schema.ResourceData, meta meta.Meta, contractID, match string,
+	qualifiers []string, version string) ([]interface{}, error) {
+	logger := log.FromContext(ctx)
+	client := Client(meta)
+
+	matchPath, err := parseJSONPath(match)
+	if err != nil {
+		return nil, err
+	}
+	qualifierPaths := make([]jsonPath, 0, len(qualifiers))
+	for _, q := range qualifiers {
+		qualifier, err := parseJSONPath(q)
+		if err != nil {
+			return nil, err
+		}
+		qualifierPaths = append(qualifierPaths, qualifier)
+	}
+	searchedVersion := bulkSearchVersions[version]
+
+	groupIDs, err := bulkSearchGroups(ctx, d, meta, contractID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debugf("searching properties of %d groups in contract %s", len(groupIDs), contractID)
+	var results []interface{}
+	for _, groupID := range groupIDs {
+		properties, err := getProperties(ctx, groupID, contractID, meta)
+		if err != nil {
+			return nil, fmt.Errorf("could not list properties of group %s: %s", groupID, err)
+		}
+		for _, property := range properties.Properties.Items {
+			propertyVersion := searchedVersion(*property)
+			if propertyVersion == nil || *propertyVersion == 0 {
+				continue
+			}
+			rules, _, err := fetchRulesWithoutValidation(ctx, client, *property, *propertyVersion)
+			if err != nil {
+				return nil, err
+			}
+			nodes, err := searchRuleTree(rules, matchPath, qualifierPaths)
+			if err != nil {
+				return nil, fmt.Errorf("could not search rules of property %s: %s", property.PropertyID, err)
+			}
+			if len(nodes) == 0 {
+				continue
+			}
+
+			locations, values := make([]string, 0, len(nodes)), make([]string, 0, len(nodes))
+			for _, node := range nodes {
+				locations = append(locations, node.pointer)
+				values = append(values, formatJSONValue(node.value))
+			}
+			results = append(results, map[string]interface{}{
+				"property_id":      property.PropertyID,
+				"property_name":    property.PropertyName,
+				"group_id":         property.GroupID,
+				"property_version": *propertyVersion,
+				"match_locations":  locations,
+				"match_values":     values,
+			})
+		}
+	}
+	return results, nil
+}
+
+// bulkSearchGroups returns the configured groups or, if none are configured, all groups of the contract
+func bulkSearchGroups(ctx context.Context, d *schema.ResourceData, meta meta.Meta, contractID string) ([]string, error) {
+	var groupIDs []string
+	for _, groupID := range d.Get("group_ids").(*schema.Set).List() {
+		groupIDs = append(groupIDs, str.AddPrefix(groupID.(string), "grp_"))
+	}
+	if len(groupIDs) > 0 {
+		slices.Sort(groupIDs)
+		return groupIDs, nil
+	}
+
+	groups, err := getGroups(ctx, meta)
+	if err != nil {
+		return nil, fmt.Errorf("could not list groups: %w", err)
+	}
+	for _, group := range groups.Groups.Items {
+		if slices.Contains(group.ContractIDs, contractID) {
+			groupIDs = append(groupIDs, group.GroupID)
+		}
+	}
+	if len(groupIDs) == 0 {
+		return nil, fmt.Errorf("no group found in contract %s", contractID)
+	}
+	return groupIDs, nil
+}
+
+// searchRuleTree returns the nodes of the rule tree matched by the match expression,
+// or nothing if any of the qualifiers does not match it
+func searchRuleTree(rules papi.RulesUpdate, match jsonPath, qualifiers []jsonPath) ([]jsonNode, error) {
+	document, err := decodeRuleTree(rules)
+	if err != nil {
+		return nil, err
+	}
+	for _, qualifier := range qualifiers {
+		if len(qualifier.evaluate(document)) == 0 {
+			return nil, nil
+		}
+	}
+	return match.evaluate(document), nil
+}
+
+// decodeRuleTree converts the rule tree into a generic JSON document, which can be searched and patched
+func decodeRuleTree(rules papi.RulesUpdate) (any, error) {
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	var document any
+	if err := json.Unmarshal(encoded, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
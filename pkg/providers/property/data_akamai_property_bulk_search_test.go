@@ -0,0 +1,311 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/ptr"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDSPropertyBulkSearch(t *testing.T) {
+	originRules := func(verificationMode string, children ...papi.Rules) papi.Rules {
+		return papi.Rules{
+			Name: "default",
+			Behaviors: []papi.RuleBehavior{
+				{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "origin.example.com", "verificationMode": verificationMode}},
+				{Name: "caching", Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "ttl": "1d"}},
+			},
+			Children: children,
+		}
+	}
+	mockGetRuleTree := func(client *papi.Mock, propertyID, groupID string, version int, rules papi.Rules) {
+		client.On("GetRuleTree", testutils.MockContext, papi.GetRuleTreeRequest{
+			PropertyID:      propertyID,
+			GroupID:         groupID,
+			ContractID:      "ctr_1",
+			PropertyVersion: version,
+		}).Return(&papi.GetRuleTreeResponse{
+			Response:   papi.Response{ContractID: "ctr_1", GroupID: groupID},
+			RuleFormat: "v2024-01-09",
+			Rules:      rules,
+		}, nil)
+	}
+	mockGetProperties := func(client *papi.Mock, groupID string, properties ...*papi.Property) {
+		client.On("GetProperties", testutils.MockContext, papi.GetPropertiesRequest{
+			ContractID: "ctr_1",
+			GroupID:    groupID,
+		}).Return(&papi.GetPropertiesResponse{Properties: papi.PropertiesItems{Items: properties}}, nil)
+	}
+	property := func(propertyID, groupID string, latestVersion int, productionVersion *int) *papi.Property {
+		return &papi.Property{
+			ContractID:        "ctr_1",
+			GroupID:           groupID,
+			PropertyID:        propertyID,
+			PropertyName:      propertyID + "_name",
+			LatestVersion:     latestVersion,
+			ProductionVersion: productionVersion,
+		}
+	}
+
+	BulkSearchPollInterval = time.Microsecond
+	originMatch := "$..behaviors[?(@.name == 'origin')].options.verificationMode"
+	mockCreateBulkSearch := func(bulk *mockPAPIBulk, groupID, match string, qualifiers ...string) {
+		bulk.On("CreateBulkSearch", testutils.MockContext, CreateBulkSearchRequest{
+			ContractID: "ctr_1",
+			GroupID:    groupID,
+			Query:      BulkSearchQuery{Syntax: BulkSearchSyntaxJSONPath, Match: match, Qualifiers: qualifiers},
+		}).Return(&CreateBulkSearchResponse{
+			BulkSearchID:   7,
+			BulkSearchLink: "/papi/v1/bulk/rules-search-requests/7?contractId=ctr_1",
+		}, nil).Once()
+	}
+	mockGetBulkSearch := func(bulk *mockPAPIBulk, groupID, status string, results ...BulkSearchResult) *mock.Call {
+		return bulk.On("GetBulkSearch", testutils.MockContext, GetBulkSearchRequest{
+			BulkSearchID: 7,
+			ContractID:   "ctr_1",
+			GroupID:      groupID,
+		}).Return(&BulkSearch{BulkSearchID: 7, Status: status, Results: results}, nil).Once()
+	}
+
+	t.Run("search latest versions with the bulk search", func(t *testing.T) {
+		client := &papi.Mock{}
+		bulk := &mockPAPIBulk{}
+		mockCreateBulkSearch(bulk, "", originMatch)
+		mockGetBulkSearch(bulk, "", "PENDING")
+		mockGetBulkSearch(bulk, "", BulkSearchStatusComplete,
+			BulkSearchResult{
+				PropertyID: "prp_3", PropertyName: "prp_3_name", PropertyVersion: 2, GroupID: "grp_2", IsLatest: true,
+				MatchLocations: []string{"/rules/behaviors/0/options/verificationMode"},
+			},
+			BulkSearchResult{
+				// not the latest version, which is not searched
+				PropertyID: "prp_3", PropertyName: "prp_3_name", PropertyVersion: 1, GroupID: "grp_2", ProductionStatus: "ACTIVE",
+				MatchLocations: []string{"/rules/behaviors/0/options/verificationMode"},
+			},
+			BulkSearchResult{
+				PropertyID: "prp_1", PropertyName: "prp_1_name", PropertyVersion: 3, GroupID: "grp_1", IsLatest: true,
+				MatchLocations: []string{
+					"/rules/behaviors/0/options/verificationMode",
+					"/rules/children/0/behaviors/0/options/verificationMode",
+				},
+			},
+		)
+		// only the rule trees of the matched versions are fetched
+		mockGetRuleTree(client, "prp_1", "grp_1", 3, originRules("PLATFORM_SETTINGS", papi.Rules{
+			Name:      "Static content",
+			Behaviors: []papi.RuleBehavior{{Name: "origin", Options: papi.RuleOptionsMap{"verificationMode": "CUSTOM"}}},
+		}))
+		mockGetRuleTree(client, "prp_3", "grp_2", 2, originRules("THIRD_PARTY"))
+
+		useClient(client, nil, func() {
+			useBulkClient(bulk, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					IsUnitTest:               true,
+					Steps: []resource.TestStep{{
+						Config: testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/search.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "id", "ctr_1:LATEST:"+originMatch),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.#", "2"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_id", "prp_1"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_name", "prp_1_name"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.group_id", "grp_1"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_version", "3"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_locations.#", "2"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_values.0", `"PLATFORM_SETTINGS"`),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_values.1", `"CUSTOM"`),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.property_id", "prp_3"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.property_version", "2"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.match_values.0", `"THIRD_PARTY"`),
+						),
+					}},
+				})
+			})
+		})
+
+		client.AssertExpectations(t)
+		bulk.AssertExpectations(t)
+	})
+
+	t.Run("search production versions of a group with the bulk search", func(t *testing.T) {
+		client := &papi.Mock{}
+		bulk := &mockPAPIBulk{}
+		mockCreateBulkSearch(bulk, "grp_2", "$..behaviors[?(@.name == 'origin')].options",
+			"$..behaviors[?(@.name == 'caching' && @.options.ttl == '1d')]")
+		mockGetBulkSearch(bulk, "grp_2", BulkSearchStatusComplete,
+			BulkSearchResult{
+				PropertyID: "prp_3", PropertyName: "prp_3_name", PropertyVersion: 1, GroupID: "grp_2", ProductionStatus: "ACTIVE",
+				MatchLocations: []string{"/rules/behaviors/0/options"},
+			},
+			BulkSearchResult{
+				PropertyID: "prp_4", PropertyName: "prp_4_name", PropertyVersion: 1, GroupID: "grp_2", IsLatest: true,
+				MatchLocations: []string{"/rules/behaviors/0/options"},
+			},
+		)
+		mockGetRuleTree(client, "prp_3", "grp_2", 1, originRules("PLATFORM_SETTINGS"))
+
+		useClient(client, nil, func() {
+			useBulkClient(bulk, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					IsUnitTest:               true,
+					Steps: []resource.TestStep{{
+						Config: testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/search_qualifiers.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.#", "1"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_id", "prp_3"),
+							resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_values.0", `{"hostname":"origin.example.com","verificationMode":"PLATFORM_SETTINGS"}`),
+						),
+					}},
+				})
+			})
+		})
+
+		client.AssertExpectations(t)
+		bulk.AssertExpectations(t)
+	})
+
+	t.Run("failed bulk search", func(t *testing.T) {
+		client := &papi.Mock{}
+		bulk := &mockPAPIBulk{}
+		mockCreateBulkSearch(bulk, "", originMatch)
+		mockGetBulkSearch(bulk, "", BulkSearchStatusError)
+
+		useClient(client, nil, func() {
+			useBulkClient(bulk, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					IsUnitTest:               true,
+					Steps: []resource.TestStep{{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/search.tf"),
+						ExpectError: regexp.MustCompile("bulk search 7 failed"),
+					}},
+				})
+			})
+		})
+
+		client.AssertExpectations(t)
+		bulk.AssertExpectations(t)
+	})
+
+	t.Run("client search of latest versions in all groups of the contract", func(t *testing.T) {
+		client := &papi.Mock{}
+		client.On("GetGroups", testutils.MockContext).Return(&papi.GetGroupsResponse{
+			Groups: papi.GroupItems{Items: []*papi.Group{
+				{GroupID: "grp_1", ContractIDs: []string{"ctr_1"}},
+				{GroupID: "grp_2", ContractIDs: []string{"ctr_2", "ctr_1"}},
+				{GroupID: "grp_3", ContractIDs: []string{"ctr_3"}},
+			}},
+		}, nil)
+		mockGetProperties(client, "grp_1", property("prp_1", "grp_1", 3, nil), property("prp_2", "grp_1", 1, nil))
+		mockGetProperties(client, "grp_2", property("prp_3", "grp_2", 2, nil))
+		mockGetRuleTree(client, "prp_1", "grp_1", 3, originRules("PLATFORM_SETTINGS", papi.Rules{
+			Name:      "Static content",
+			Behaviors: []papi.RuleBehavior{{Name: "origin", Options: papi.RuleOptionsMap{"verificationMode": "CUSTOM"}}},
+		}))
+		mockGetRuleTree(client, "prp_2", "grp_1", 1, papi.Rules{Name: "default"})
+		mockGetRuleTree(client, "prp_3", "grp_2", 2, originRules("THIRD_PARTY"))
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/client_search.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "id", "ctr_1:LATEST:$..behaviors[?(@.name == 'origin')].options.verificationMode"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.#", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_id", "prp_1"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_name", "prp_1_name"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.group_id", "grp_1"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_version", "3"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_locations.#", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_locations.0", "/rules/behaviors/0/options/verificationMode"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_locations.1", "/rules/children/0/behaviors/0/options/verificationMode"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_values.0", `"PLATFORM_SETTINGS"`),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_values.1", `"CUSTOM"`),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.property_id", "prp_3"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.group_id", "grp_2"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.property_version", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.match_locations.#", "1"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.1.match_values.0", `"THIRD_PARTY"`),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("client search of production versions with qualifiers", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockGetProperties(client, "grp_2",
+			property("prp_3", "grp_2", 2, ptr.To(1)),
+			property("prp_4", "grp_2", 1, nil),
+			property("prp_5", "grp_2", 4, ptr.To(4)),
+		)
+		mockGetRuleTree(client, "prp_3", "grp_2", 1, originRules("PLATFORM_SETTINGS"))
+		mockGetRuleTree(client, "prp_5", "grp_2", 4, papi.Rules{
+			Name: "default",
+			Behaviors: []papi.RuleBehavior{
+				{Name: "origin", Options: papi.RuleOptionsMap{"verificationMode": "PLATFORM_SETTINGS"}},
+				{Name: "caching", Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "ttl": "7d"}},
+			},
+		})
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/client_search_qualifiers.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.#", "1"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_id", "prp_3"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.property_version", "1"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_locations.0", "/rules/behaviors/0/options"),
+						resource.TestCheckResourceAttr("data.akamai_property_bulk_search.test", "results.0.match_values.0", `{"hostname":"origin.example.com","verificationMode":"PLATFORM_SETTINGS"}`),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("client search without group in contract", func(t *testing.T) {
+		client := &papi.Mock{}
+		client.On("GetGroups", testutils.MockContext).Return(&papi.GetGroupsResponse{
+			Groups: papi.GroupItems{Items: []*papi.Group{{GroupID: "grp_3", ContractIDs: []string{"ctr_3"}}}},
+		}, nil)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/client_search.tf"),
+					ExpectError: regexp.MustCompile("no group found in contract ctr_1"),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid match expression", func(t *testing.T) {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			IsUnitTest:               true,
+			Steps: []resource.TestStep{{
+				Config:      testutils.LoadFixtureString(t, "testdata/TestDSPropertyBulkSearch/invalid_match.tf"),
+				ExpectError: regexp.MustCompile(`invalid JSONPath expression`),
+			}},
+		})
+	})
+}
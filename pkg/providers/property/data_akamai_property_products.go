@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/hash"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
@@ -54,7 +53,7 @@ func dataPropertyProductsRead(ctx context.Context, d *schema.ResourceData, m int
 
 	logger.Debugf("[Akamai Property Products] Start searching for product records")
 
-	prdResp, err := client.GetProducts(ctx, papi.GetProductsRequest{ContractID: contractID})
+	prdResp, err := getCachedProducts(ctx, client, contractID)
 	if err != nil {
 		return diag.FromErr(err) // fixme kind of error
 	}
@@ -0,0 +1,139 @@
+package property
+
+import (
+	"context"
+	"errors"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/providers/property/ruleformats"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ruleFormatUpgradeDataSource{}
+
+type (
+	// ruleFormatUpgradeDataSource upgrades rules of properties and includes between frozen rule formats
+	ruleFormatUpgradeDataSource struct{}
+
+	ruleFormatUpgradeDataSourceModel struct {
+		Rules            types.String           `tfsdk:"rules"`
+		SourceRuleFormat types.String           `tfsdk:"source_rule_format"`
+		TargetRuleFormat types.String           `tfsdk:"target_rule_format"`
+		UpgradedRules    types.String           `tfsdk:"upgraded_rules"`
+		BreakingChanges  []ruleFormatChangeItem `tfsdk:"breaking_changes"`
+	}
+
+	ruleFormatChangeItem struct {
+		RulePath types.String `tfsdk:"rule_path"`
+		Location types.String `tfsdk:"location"`
+		Message  types.String `tfsdk:"message"`
+		Resolved types.Bool   `tfsdk:"resolved"`
+	}
+)
+
+// NewRuleFormatUpgradeDataSource returns a new data source upgrading property rules to another rule format
+func NewRuleFormatUpgradeDataSource() datasource.DataSource {
+	return &ruleFormatUpgradeDataSource{}
+}
+
+func (d *ruleFormatUpgradeDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "akamai_property_rule_format_upgrade"
+}
+
+func (d *ruleFormatUpgradeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Upgrades rules of a property or an include from one frozen rule format to another without calling PAPI. " +
+			"Renames behaviors, criteria and options, converts option values and removes options which are not available " +
+			"in the target rule format, and reports the changes requiring attention.",
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.StringAttribute{
+				Required:    true,
+				Description: "Property or include rules as JSON, either the PAPI rules envelope or a single rule.",
+			},
+			"source_rule_format": schema.StringAttribute{
+				Required:    true,
+				Description: "Frozen rule format of the rules, e.g. `v2024-10-21`.",
+			},
+			"target_rule_format": schema.StringAttribute{
+				Required:    true,
+				Description: "Frozen rule format to upgrade the rules to, e.g. `v2025-10-16`.",
+			},
+			"upgraded_rules": schema.StringAttribute{
+				Computed:    true,
+				Description: "Rules upgraded to the target rule format as JSON, in the same shape as `rules`.",
+			},
+			"breaking_changes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Differences between the rule formats which affect the rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path of the affected rule, e.g. `default/Performance/Compression`.",
+						},
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "Affected behavior, criterion or option, as named in `rules`.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the change.",
+						},
+						"resolved": schema.BoolAttribute{
+							Computed: true,
+							Description: "Indicates whether `upgraded_rules` already account for the change. " +
+								"Otherwise, the rules need to be fixed manually before they can be used with the target rule format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ruleFormatUpgradeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Property Rule Format Upgrade DataSource Read")
+
+	var data ruleFormatUpgradeDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tree, err := parseRuleTree(data.Rules.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rules"), "invalid rules", err.Error())
+		return
+	}
+
+	changes, err := ruleformats.UpgradeRules(data.SourceRuleFormat.ValueString(), data.TargetRuleFormat.ValueString(), &tree.Rules)
+	if err != nil {
+		if errors.Is(err, ruleformats.ErrUnknownRuleFormat) {
+			resp.Diagnostics.AddError("invalid rule format",
+				"rules can be upgraded only between frozen rule formats known to the provider: "+err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("upgrading rules failed", err.Error())
+		return
+	}
+
+	upgraded, err := tree.JSON()
+	if err != nil {
+		resp.Diagnostics.AddError("encoding upgraded rules failed", err.Error())
+		return
+	}
+	data.UpgradedRules = types.StringValue(upgraded)
+	data.BreakingChanges = make([]ruleFormatChangeItem, 0, len(changes))
+	for _, c := range changes {
+		data.BreakingChanges = append(data.BreakingChanges, ruleFormatChangeItem{
+			RulePath: types.StringValue(c.RulePath),
+			Location: types.StringValue(c.Location),
+			Message:  types.StringValue(c.Message),
+			Resolved: types.BoolValue(c.Resolved),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,81 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDSPropertyRuleFormatUpgrade(t *testing.T) {
+	tests := map[string]struct {
+		givenTF            string
+		expectedRules      string
+		expectedAttributes map[string]string
+		expectError        *regexp.Regexp
+	}{
+		"renamed and removed options": {
+			givenTF:       "option_changes.tf",
+			expectedRules: "option_changes_upgraded.json",
+			expectedAttributes: map[string]string{
+				"breaking_changes.#":           "4",
+				"breaking_changes.0.rule_path": "default",
+				"breaking_changes.0.location":  "behavior adaptiveImageCompression",
+				"breaking_changes.0.message":   "behavior is not available in rule format v2025-10-16",
+				"breaking_changes.0.resolved":  "false",
+				"breaking_changes.1.location":  "behavior mediaOriginFailover.originUnavailableBlacklistOriginIp",
+				"breaking_changes.1.message":   "option renamed to originUnavailableBlocklistOriginIp in rule format v2025-10-16",
+				"breaking_changes.1.resolved":  "true",
+				"breaking_changes.2.location":  "behavior mediaOriginFailover.originUnavailableBlacklistWindow",
+				"breaking_changes.2.message":   "option renamed to originUnavailableBlocklistWindow in rule format v2025-10-16",
+				"breaking_changes.2.resolved":  "true",
+				"breaking_changes.3.location":  "behavior mediaOriginFailover.originUnavailableBlocklistWindow",
+				"breaking_changes.3.message":   `expected originUnavailableBlocklistWindow to be one of ["TEN_S" "THIRTY_S"], got TEN_MINUTES`,
+				"breaking_changes.3.resolved":  "false",
+			},
+		},
+		"converted values": {
+			givenTF:       "type_changes.tf",
+			expectedRules: "type_changes_upgraded.json",
+			expectedAttributes: map[string]string{
+				"breaking_changes.#":           "2",
+				"breaking_changes.0.rule_path": "default",
+				"breaking_changes.0.location":  "behavior datastream.logStreamName",
+				"breaking_changes.0.message":   "value converted from integer to array in rule format v2023-09-20",
+				"breaking_changes.0.resolved":  "true",
+				"breaking_changes.1.rule_path": "default/Variables",
+				"breaking_changes.1.location":  "behavior setVariable.startIndex",
+				"breaking_changes.1.message":   "value converted from string to integer in rule format v2023-09-20",
+				"breaking_changes.1.resolved":  "true",
+			},
+		},
+		"unknown rule format": {
+			givenTF:     "unknown_rule_format.tf",
+			expectError: regexp.MustCompile("rules can be upgraded only between frozen rule formats"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var checks []resource.TestCheckFunc
+			for k, v := range test.expectedAttributes {
+				checks = append(checks, resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.test", k, v))
+			}
+			if test.expectedRules != "" {
+				expectedRules := compactJSON(testutils.LoadFixtureBytes(t, "testdata/TestDSPropertyRuleFormatUpgrade/"+test.expectedRules))
+				checks = append(checks, resource.TestCheckResourceAttr("data.akamai_property_rule_format_upgrade.test", "upgraded_rules", expectedRules))
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config:      testutils.LoadFixtureStringf(t, "testdata/TestDSPropertyRuleFormatUpgrade/%s", test.givenTF),
+					Check:       resource.ComposeAggregateTestCheckFunc(checks...),
+					ExpectError: test.expectError,
+				}},
+			})
+		})
+	}
+}
@@ -30,7 +30,7 @@ func dataPropertyRuleFormatsRead(ctx context.Context, d *schema.ResourceData, m
 	logger.Debugf("read property rule formats")
 
 	// Get property rule formats
-	ruleFormats, err := client.GetRuleFormats(ctx)
+	ruleFormats, err := getCachedRuleFormats(ctx, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -75,7 +75,7 @@ func isValidRuleFormat(ctx context.Context, client papi.PAPI, format string) (bo
 	if format == "" {
 		return true, nil
 	}
-	rfs, err := client.GetRuleFormats(ctx)
+	rfs, err := getCachedRuleFormats(ctx, client)
 	if err != nil {
 		return false, err
 	}
@@ -29,6 +29,68 @@ func dataSourcePropertyRulesBuilder() *schema.Resource {
 		Description: "Frozen rule format in which the rules are represented",
 	}
 
+	rulesSchemas["fragment"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Makes the rule a fragment, which can be used as a child rule by rule trees of many properties. " +
+			"String option values can refer to parameters of the fragment as `{{fragment.<parameter>}}`",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the fragment",
+				},
+				"version": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The version of the fragment, tracked by properties using it",
+				},
+				"parameter": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The parameters of the fragment",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The name of the parameter",
+							},
+							"default": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The value used when the parameter is not given. Without it, the parameter is required",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rulesSchemas["fragment_parameters"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Values of parameters of fragments used as child rules",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the fragment",
+				},
+				"values": {
+					Type:        schema.TypeMap,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Values of the parameters by their names",
+				},
+			},
+		},
+	}
+
 	return &schema.Resource{
 		ReadContext: dataSourcePropertyRulesBuilderRead,
 		Schema:      rulesSchemas,
@@ -40,7 +102,8 @@ func dataSourcePropertyRulesBuilderRead(_ context.Context, d *schema.ResourceDat
 	logger := meta.Log("PAPI", "dataSourcePropertyRulesBuilderRead")
 	logger.Debug("dataSourcePropertyRulesBuilderRead")
 
-	rules, err := ruleformats.NewBuilder(d).Build()
+	builder := ruleformats.NewBuilder(d)
+	rules, err := builder.Build()
 	if err != nil {
 		diags := diag.Errorf("building rules: %s", err)
 		if errors.Is(err, ruleformats.ErrTooManyElements) {
@@ -49,11 +112,22 @@ func dataSourcePropertyRulesBuilderRead(_ context.Context, d *schema.ResourceDat
 		return diags
 	}
 
+	fragment, err := builder.Fragment(rules)
+	if err != nil {
+		return diag.Errorf("building fragment: %s", err)
+	}
+	fragments, err := builder.Fragments()
+	if err != nil {
+		return diag.Errorf("reading fragments of children: %s", err)
+	}
+
 	rulesUpdate := ruleformats.RulesUpdate{
 		RuleFormat: ruleformats.GetUsedRuleFormat(d).SchemaKey(),
 		RulesUpdate: papi.RulesUpdate{
 			Rules: *rules,
 		},
+		Fragment:  fragment,
+		Fragments: fragments,
 	}
 
 	JSON, err := json.MarshalIndent(rulesUpdate, "", "  ")
@@ -117,6 +117,64 @@ func TestDataPropertyRulesBuilder(t *testing.T) {
 			})
 		})
 	})
+	t.Run("valid rule with fragment child and its parameters", func(t *testing.T) {
+		useClient(nil, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestDSPropertyRulesBuilder/fragments/rules_with_fragment.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						testCheckResourceAttrJSON("data.akamai_property_rules_builder.static_content",
+							"json",
+							testutils.LoadFixtureString(t, "testdata/TestDSPropertyRulesBuilder/fragments/static_content.json")),
+						testCheckResourceAttrJSON("data.akamai_property_rules_builder.default",
+							"json",
+							testutils.LoadFixtureString(t, "testdata/TestDSPropertyRulesBuilder/fragments/default.json")),
+					),
+				}},
+			})
+		})
+	})
+	t.Run("fails on fragment with errors in parameters", func(t *testing.T) {
+		tests := map[string]struct {
+			configPath string
+			withError  string
+		}{
+			"undeclared parameter": {
+				configPath: "rules_undeclared_parameter.tf",
+				withError:  `invalid fragment parameter: {{fragment.suffix}} is not a parameter of\s+fragment static-content`,
+			},
+			"missing value of required parameter": {
+				configPath: "rules_missing_parameter.tf",
+				withError:  `value of parameter prefix of fragment static-content is\s+required`,
+			},
+			"value of unknown parameter": {
+				configPath: "rules_unknown_parameter.tf",
+				withError:  `fragment static-content has no parameter path`,
+			},
+			"values of unused fragment": {
+				configPath: "rules_unused_parameters.tf",
+				withError:  `fragment images is not used by children`,
+			},
+			"fragment in default rule": {
+				configPath: "rules_fragment_in_default.tf",
+				withError:  `cannot be used in 'default' rule: fragment`,
+			},
+		}
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				useClient(nil, nil, func() {
+					resource.UnitTest(t, resource.TestCase{
+						ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+						Steps: []resource.TestStep{{
+							Config:      testutils.LoadFixtureString(t, "testdata/TestDSPropertyRulesBuilder/fragments/"+test.configPath),
+							ExpectError: regexp.MustCompile(test.withError),
+						}},
+					})
+				})
+			})
+		}
+	})
 }
 
 func testCheckResourceAttrJSON(name, key, value string) func(s *terraform.State) error {
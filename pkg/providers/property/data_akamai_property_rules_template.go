@@ -81,10 +81,10 @@ func dataSourcePropertyRulesTemplate() *schema.Resource {
 									return diag.Errorf("value is not a string: %v", i)
 								}
 								switch val {
-								case "bool", "number", "string", "jsonBlock":
+								case "bool", "number", "string", "jsonBlock", "object", "list":
 									return nil
 								}
-								return diag.Errorf("'type' has invalid value: should be 'bool', 'number', 'string' or 'jsonBlock'")
+								return diag.Errorf("'type' has invalid value: should be 'bool', 'number', 'string', 'jsonBlock', 'object' or 'list'")
 							},
 						},
 						"value": {
@@ -150,13 +150,48 @@ func (v variablePopulator) replaceMatchWithVar(template string, varMap map[strin
 		return "", fmt.Errorf(matchingErrorMessage, matchingVariable)
 	}
 
-	varName := submatch[1]
-	if varVal, ok := varMap[varName]; ok {
-		return strings.ReplaceAll(template, matchingVariable, v.valueExtractor(varVal)), nil
+	varName, defaultValue, hasDefault, err := parseVariableReference(submatch[1])
+	if err != nil {
+		return "", err
+	}
+	varVal, ok := lookupVariable(varMap, varName)
+	if !ok && hasDefault {
+		varVal, ok = defaultValue, true
+	}
+	if ok {
+		// values are inserted in a single line, so that lines of the template match the lines of its file
+		value := strings.ReplaceAll(v.valueExtractor(varVal), "\n", " ")
+		return strings.ReplaceAll(template, matchingVariable, value), nil
 	}
 	return strings.ReplaceAll(template, matchingVariable, fmt.Sprintf("%s.%s%s", leftDelim, varName, rightDelim)), nil
 }
 
+// parseVariableReference parses the reference to a variable, optionally with its default value, e.g. `name | default "www"`
+func parseVariableReference(reference string) (string, any, bool, error) {
+	name, defaultExpression, hasDefault := strings.Cut(reference, "|")
+	if !hasDefault {
+		return reference, nil, false, nil
+	}
+	name = strings.TrimSpace(name)
+	literal, ok := strings.CutPrefix(strings.TrimSpace(defaultExpression), "default")
+	literal = strings.TrimSpace(literal)
+	if !ok || literal == "" {
+		return "", nil, false, fmt.Errorf("%w: expected 'default' value in reference to variable %q", ErrTemplateDirective, name)
+	}
+	var value any
+	if err := json.Unmarshal([]byte(literal), &value); err != nil {
+		return "", nil, false, fmt.Errorf("%w: invalid default value of %q: %s", ErrTemplateDirective, name, literal)
+	}
+	if value == nil {
+		return name, "null", true, nil
+	}
+	formatted, err := formatValue(value)
+	if err != nil {
+		return "", nil, false, fmt.Errorf("%w: %s", ErrFormatValue, err)
+	}
+	return name, formatted, true, nil
+}
+
 func findSnippetsInSymlinkDir(parentDir, symlinkDir string) (map[string]string, error) {
 	templateFiles := map[string]string{}
 	target, err := filepath.EvalSymlinks(symlinkDir)
@@ -266,8 +301,10 @@ func dataPropertyRulesTemplateRead(_ context.Context, d *schema.ResourceData, m
 	}
 
 	var templateStr string
+	mainSource := "template_data"
 	if templateDataStr == "" {
 		templateStr, err = convertToTemplate(file, varsMap)
+		mainSource = file
 	} else {
 		templateStr, err = stringToTemplate(templateDataStr, varsMap, "main")
 	}
@@ -275,7 +312,7 @@ func dataPropertyRulesTemplateRead(_ context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	tmpl, err := template.New("main").Delims(leftDelim, rightDelim).Option("missingkey=error").Parse(templateStr)
+	tmpl, err := parseTemplate(template.New("main").Funcs(templateFuncs), "main", templateStr, varsMap)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -334,13 +371,13 @@ func dataPropertyRulesTemplateRead(_ context.Context, d *schema.ResourceData, m
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		tmpl, err = tmpl.New(name).Delims(leftDelim, rightDelim).Option("missingkey=error").Parse(templateStr)
+		tmpl, err = parseTemplate(tmpl.New(name), name, templateStr, varsMap)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 	wr := bytes.Buffer{}
-	err = tmpl.ExecuteTemplate(&wr, "main", varsMap)
+	err = tmpl.ExecuteTemplate(&wr, "main", templateData(varsMap))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -355,10 +392,19 @@ func dataPropertyRulesTemplateRead(_ context.Context, d *schema.ResourceData, m
 	d.SetId(shaHash)
 
 	formatted := bytes.Buffer{}
-	result := wr.Bytes()
+	result, positions := stripMarkers(wr.Bytes(), "main")
 	err = json.Indent(&formatted, result, "", "  ")
 	if err != nil {
 		logger.Debugf("Creating rule tree resulted in invalid JSON: %s\nError: %s", result, err)
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			name, line := positions.at(int(syntaxErr.Offset) - 1)
+			source := mainSource
+			if name != "main" {
+				source = templateFiles[name]
+			}
+			return diag.FromErr(fmt.Errorf("invalid JSON result: %s:%d: %w", source, line, err))
+		}
 		return diag.FromErr(fmt.Errorf("invalid JSON result: %w", err))
 	}
 	if err := d.Set("json", formatted.String()); err != nil {
@@ -367,6 +413,15 @@ func dataPropertyRulesTemplateRead(_ context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// parseTemplate parses the template converted by stringToTemplate, with its directives, as the named template
+func parseTemplate(tmpl *template.Template, name, templateStr string, varsMap map[string]any) (*template.Template, error) {
+	templateStr, err := translateDirectives(templateStr, varsMap)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return tmpl.Delims(leftDelim, rightDelim).Option("missingkey=error").Parse(markLines(templateStr, name))
+}
+
 func getVariables(d *schema.ResourceData, logger log.Interface) (map[string]interface{}, error) {
 	varsMap := make(map[string]interface{})
 	vars, err := tf.GetSetValue("variables", d)
@@ -448,7 +503,9 @@ var (
 	// ErrFormatValue is used to specify formatting error.
 	ErrFormatValue = errors.New("formatting value")
 	// ErrUnknownType is used to specify unknown error.
-	ErrUnknownType       = errors.New("unknown 'type' value")
+	ErrUnknownType = errors.New("unknown 'type' value")
+	// ErrTemplateDirective is used to specify invalid directive or variable reference in a template.
+	ErrTemplateDirective = errors.New("invalid template directive")
 	matchingErrorMessage = "there was a problem matching %q"
 )
 
@@ -574,6 +631,18 @@ func convertToTypedMap(vars []interface{}) (map[string]interface{}, error) {
 		switch varTypeStr {
 		case "string":
 			result[varNameStr] = fmt.Sprintf(`"%s"`, valueStr)
+		case "object":
+			var targetMap map[string]interface{}
+			if err := json.Unmarshal([]byte(valueStr), &targetMap); err != nil || targetMap == nil {
+				return nil, fmt.Errorf("%w: 'object' argument is not a valid json object: %s: %s", ErrUnmarshal, varNameStr, valueStr)
+			}
+			result[varNameStr] = valueStr
+		case "list":
+			var targetSlice []interface{}
+			if err := json.Unmarshal([]byte(valueStr), &targetSlice); err != nil || targetSlice == nil {
+				return nil, fmt.Errorf("%w: 'list' argument is not a valid json array: %s: %s", ErrUnmarshal, varNameStr, valueStr)
+			}
+			result[varNameStr] = valueStr
 		case "jsonBlock":
 			var targetMap map[string]interface{}
 			if err := json.Unmarshal([]byte(valueStr), &targetMap); err != nil {
@@ -623,6 +692,9 @@ func getVarsFromFile(definitionsPath, valuesPath string) (map[string]interface{}
 	}
 	vars := make(map[string]interface{})
 	for name, varDef := range definitions.Definitions {
+		if err := checkVariableType(name, varDef.Type, varDef.Default); err != nil {
+			return nil, err
+		}
 		v, err := formatValue(varDef.Default)
 		if err != nil {
 			return nil, fmt.Errorf("%w: %s", ErrFormatValue, err)
@@ -640,6 +712,9 @@ func getVarsFromFile(definitionsPath, valuesPath string) (map[string]interface{}
 		}
 		for name, value := range values {
 			if _, ok := vars[name]; ok && value != nil {
+				if err := checkVariableType(name, definitions.Definitions[name].Type, value); err != nil {
+					return nil, err
+				}
 				v, err := formatValue(value)
 				if err != nil {
 					return nil, fmt.Errorf("%w: %s", ErrFormatValue, err)
@@ -656,6 +731,24 @@ func getVarsFromFile(definitionsPath, valuesPath string) (map[string]interface{}
 	return vars, nil
 }
 
+// checkVariableType checks that the value of a variable defined as 'object' or 'list' is a JSON object or array
+func checkVariableType(name, varType string, value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	switch varType {
+	case "object":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return fmt.Errorf("%w: value of %s is not a json object: %v", tf.ErrInvalidType, name, value)
+		}
+	case "list":
+		if _, ok := value.([]interface{}); !ok {
+			return fmt.Errorf("%w: value of %s is not a json array: %v", tf.ErrInvalidType, name, value)
+		}
+	}
+	return nil
+}
+
 func formatValue(val interface{}) (interface{}, error) {
 	switch v := val.(type) {
 	case string:
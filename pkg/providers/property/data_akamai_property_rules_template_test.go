@@ -245,7 +245,7 @@ func TestDataAkamaiPropertyRulesRead(t *testing.T) {
 				Steps: []resource.TestStep{
 					{
 						Config:      testutils.LoadFixtureString(t, "testdata/TestDSRulesTemplate/template_vars_invalid_type.tf"),
-						ExpectError: regexp.MustCompile(`'type' has invalid value: should be 'bool', 'number', 'string' or 'jsonBlock'`),
+						ExpectError: regexp.MustCompile(`'type' has invalid value: should be 'bool', 'number', 'string', 'jsonBlock', 'object' or 'list'`),
 					},
 				},
 			})
@@ -436,6 +436,19 @@ func TestGetValuesFromMap(t *testing.T) {
 			valuesFile:      "invalid_values.json",
 			withError:       ErrUnmarshal,
 		},
+		"typed definitions and values passed": {
+			definitionsFile: "typed_definitions.json",
+			valuesFile:      "typed_values.json",
+			expected: map[string]interface{}{
+				"origin": `{"hostname":"www.example.com"}`,
+				"paths":  `["/images/*"]`,
+			},
+		},
+		"value does not match definition type": {
+			definitionsFile: "typed_definitions.json",
+			valuesFile:      "typed_values_invalid.json",
+			withError:       tf.ErrInvalidType,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -470,6 +483,8 @@ func TestConvertToTypedMap(t *testing.T) {
 				map[string]interface{}{"name": "testJSONMap", "type": "jsonBlock", "value": `{"abc": "cba", "number":1}`},
 				map[string]interface{}{"name": "testJSONArray", "type": "jsonBlock", "value": `["a", "b", "c"]`},
 				map[string]interface{}{"name": "testBool", "type": "bool", "value": "true"},
+				map[string]interface{}{"name": "testObject", "type": "object", "value": `{"hostname": "origin.example.com"}`},
+				map[string]interface{}{"name": "testList", "type": "list", "value": `[{"name": "images"}]`},
 			},
 			expected: map[string]interface{}{
 				"testString":    `"test"`,
@@ -477,6 +492,8 @@ func TestConvertToTypedMap(t *testing.T) {
 				"testJSONMap":   `{"abc": "cba", "number":1}`,
 				"testJSONArray": `["a", "b", "c"]`,
 				"testBool":      true,
+				"testObject":    `{"hostname": "origin.example.com"}`,
+				"testList":      `[{"name": "images"}]`,
 			},
 		},
 		"invalid values slice": {
@@ -525,6 +542,18 @@ func TestConvertToTypedMap(t *testing.T) {
 			},
 			withError: ErrUnmarshal,
 		},
+		"object is not json object": {
+			givenVars: []interface{}{
+				map[string]interface{}{"name": "testObject", "type": "object", "value": `["a"]`},
+			},
+			withError: ErrUnmarshal,
+		},
+		"list is not json array": {
+			givenVars: []interface{}{
+				map[string]interface{}{"name": "testList", "type": "list", "value": `{"a": 1}`},
+			},
+			withError: ErrUnmarshal,
+		},
 		"number is invalid": {
 			givenVars: []interface{}{
 				map[string]interface{}{"name": "test", "type": "number", "value": "abc"},
@@ -821,3 +850,42 @@ func TestMultipleTemplates(t *testing.T) {
 		})
 	})
 }
+
+func TestTemplateLanguage(t *testing.T) {
+	tests := map[string]struct {
+		configPath   string
+		expectedPath string
+		withError    string
+	}{
+		"conditionals, loops, defaults and object variables": {
+			configPath:   "testdata/TestDSRulesTemplate/template_language.tf",
+			expectedPath: "testdata/TestDSRulesTemplate/output/template_language.json",
+		},
+		"invalid JSON in included snippet": {
+			configPath: "testdata/TestDSRulesTemplate/template_language_invalid_json.tf",
+			withError:  `invalid JSON result:\s+testdata/TestDSRulesTemplate/template-language-invalid/behaviors/broken.json:4:\s+invalid character '"' after object key:value pair`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var check resource.TestCheckFunc
+			if test.expectedPath != "" {
+				check = resource.TestCheckResourceAttr("data.akamai_property_rules_template.test", "json", testutils.LoadFixtureString(t, test.expectedPath))
+			}
+			var expectError *regexp.Regexp
+			if test.withError != "" {
+				expectError = regexp.MustCompile(test.withError)
+			}
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, test.configPath),
+						Check:       check,
+						ExpectError: expectError,
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package property
+
+import (
+	"context"
+	"errors"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/providers/property/ruleformats"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &rulesValidateDataSource{}
+
+type (
+	// rulesValidateDataSource validates rule trees against frozen rule formats, which does not require calling PAPI
+	rulesValidateDataSource struct{}
+
+	rulesValidateDataSourceModel struct {
+		RuleFormat types.String         `tfsdk:"rule_format"`
+		Rules      types.String         `tfsdk:"rules"`
+		Valid      types.Bool           `tfsdk:"valid"`
+		Errors     []ruleValidationItem `tfsdk:"errors"`
+	}
+
+	ruleValidationItem struct {
+		RulePath types.String `tfsdk:"rule_path"`
+		Location types.String `tfsdk:"location"`
+		Message  types.String `tfsdk:"message"`
+	}
+)
+
+// NewRulesValidateDataSource returns a new data source validating property rules offline
+func NewRulesValidateDataSource() datasource.DataSource {
+	return &rulesValidateDataSource{}
+}
+
+func (d *rulesValidateDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "akamai_property_rules_validate"
+}
+
+func (d *rulesValidateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Validates property rules against a frozen rule format without calling PAPI. " +
+			"Reports unknown behaviors, criteria and options, option values of a wrong type and values not allowed by the rule format.",
+		Attributes: map[string]schema.Attribute{
+			"rule_format": schema.StringAttribute{
+				Required:    true,
+				Description: "Frozen rule format to validate the rules against, e.g. `v2025-10-16`.",
+			},
+			"rules": schema.StringAttribute{
+				Required:    true,
+				Description: "Property rules as JSON, either the PAPI rules envelope or a single rule.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether no errors were found in the rules.",
+			},
+			"errors": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Errors found in the rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path of the rule with the error, e.g. `default/Performance/Compression`.",
+						},
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "Behavior, criterion or option with the error, e.g. `behavior gzipResponse.behavior`.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the error.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *rulesValidateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Property Rules Validate DataSource Read")
+
+	var data rulesValidateDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &data)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tree, err := parseRuleTree(data.Rules.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rules"), "invalid rules", err.Error())
+		return
+	}
+
+	ruleErrors, err := ruleformats.ValidateRules(data.RuleFormat.ValueString(), tree.Rules)
+	if err != nil {
+		if errors.Is(err, ruleformats.ErrUnknownRuleFormat) {
+			resp.Diagnostics.AddAttributeError(path.Root("rule_format"), "invalid rule format",
+				"rules can be validated only against frozen rule formats known to the provider: "+err.Error())
+			return
+		}
+		resp.Diagnostics.AddError("validating rules failed", err.Error())
+		return
+	}
+
+	data.Valid = types.BoolValue(len(ruleErrors) == 0)
+	data.Errors = make([]ruleValidationItem, 0, len(ruleErrors))
+	for _, e := range ruleErrors {
+		data.Errors = append(data.Errors, ruleValidationItem{
+			RulePath: types.StringValue(e.RulePath),
+			Location: types.StringValue(e.Location),
+			Message:  types.StringValue(e.Message),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
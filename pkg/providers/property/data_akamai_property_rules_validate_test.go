@@ -0,0 +1,73 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDSPropertyRulesValidate(t *testing.T) {
+	tests := map[string]struct {
+		givenTF            string
+		expectedAttributes map[string]string
+		expectError        *regexp.Regexp
+	}{
+		"valid rules": {
+			givenTF: "valid.tf",
+			expectedAttributes: map[string]string{
+				"valid":    "true",
+				"errors.#": "0",
+			},
+		},
+		"invalid rules": {
+			givenTF: "invalid.tf",
+			expectedAttributes: map[string]string{
+				"valid":              "false",
+				"errors.#":           "6",
+				"errors.0.rule_path": "default",
+				"errors.0.location":  "behavior caching.mustRevalidate",
+				"errors.0.message":   "expected boolean, got string",
+				"errors.1.location":  "behavior caching.unknownOption",
+				"errors.1.message":   "unknown option",
+				"errors.2.rule_path": "default/Compression",
+				"errors.2.location":  "criteriaMustSatisfy",
+				"errors.2.message":   `expected 'all' or 'any', got "some"`,
+				"errors.3.location":  "variables",
+				"errors.3.message":   "cannot be used outside 'default' rule",
+				"errors.4.location":  "behavior gzipResponse.behavior",
+				"errors.4.message":   `expected behavior to be one of ["ORIGIN_RESPONSE" "ALWAYS" "NEVER"], got SOMETIMES`,
+				"errors.5.location":  "behavior notABehavior",
+				"errors.5.message":   "unknown behavior",
+			},
+		},
+		"unknown rule format": {
+			givenTF:     "unknown_rule_format.tf",
+			expectError: regexp.MustCompile("rules can be validated only against frozen rule formats"),
+		},
+		"rules are not valid JSON": {
+			givenTF:     "invalid_json.tf",
+			expectError: regexp.MustCompile("rules are not valid JSON"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var checks []resource.TestCheckFunc
+			for k, v := range test.expectedAttributes {
+				checks = append(checks, resource.TestCheckResourceAttr("data.akamai_property_rules_validate.test", k, v))
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config:      testutils.LoadFixtureStringf(t, "testdata/TestDSPropertyRulesValidate/%s", test.givenTF),
+					Check:       resource.ComposeAggregateTestCheckFunc(checks...),
+					ExpectError: test.expectError,
+				}},
+			})
+		})
+	}
+}
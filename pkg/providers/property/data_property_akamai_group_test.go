@@ -18,7 +18,7 @@ func Test_DSReadGroup(t *testing.T) {
 	}{
 		"read group with group_name and contract_id provided": {
 			init: func(m *papi.Mock, testData testDataForPAPIGroups) {
-				expectGetGroups(m, testData, 3)
+				expectGetGroups(m, testData, 1)
 			},
 			mockData: testDataForPAPIGroups{
 				accountID:   "testAccountID",
@@ -38,7 +38,7 @@ func Test_DSReadGroup(t *testing.T) {
 		},
 		"multiple groups distinguished by contract_id": {
 			init: func(m *papi.Mock, testData testDataForPAPIGroups) {
-				expectGetGroups(m, testData, 3)
+				expectGetGroups(m, testData, 1)
 			},
 			mockData: testDataForPAPIGroups{
 				accountID:   "testAccountID",
@@ -64,7 +64,7 @@ func Test_DSReadGroup(t *testing.T) {
 		},
 		"multiple groups with the same group names and multiple distinguishable contracts": {
 			init: func(m *papi.Mock, testData testDataForPAPIGroups) {
-				expectGetGroups(m, testData, 3)
+				expectGetGroups(m, testData, 1)
 			},
 			mockData: testDataForPAPIGroups{
 				accountID:   "testAccountID",
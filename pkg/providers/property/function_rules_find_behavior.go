@@ -0,0 +1,100 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &rulesFindBehaviorFunction{}
+
+// NewRulesFindBehaviorFunction returns a new function looking up behaviors in a property rule tree
+func NewRulesFindBehaviorFunction() function.Function {
+	return &rulesFindBehaviorFunction{}
+}
+
+// rulesFindBehaviorFunction defines the rules_find_behavior function implementation
+type rulesFindBehaviorFunction struct{}
+
+// behaviorMatch describes a single behavior found in the rule tree
+type behaviorMatch struct {
+	Path    string `tfsdk:"path"`
+	Options string `tfsdk:"options"`
+}
+
+var behaviorMatchAttrTypes = map[string]attr.Type{
+	"path":    types.StringType,
+	"options": types.StringType,
+}
+
+// Metadata configures function's meta information
+func (f *rulesFindBehaviorFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rules_find_behavior"
+}
+
+// Definition is used to define function's parameters and return type
+func (f *rulesFindBehaviorFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Finds behaviors with the given name in a property rule tree",
+		MarkdownDescription: "Returns every occurrence of the behavior with given name in the rule tree, in depth-first order. " +
+			"Each element contains the `path` of the rule holding the behavior (rule names separated with `/`, " +
+			"e.g. `default/Performance/Compression`) and the behavior `options` in JSON format.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rules",
+				MarkdownDescription: "Rule tree in JSON format.",
+			},
+			function.StringParameter{
+				Name:                "behavior",
+				MarkdownDescription: "Name of the behavior to look for, e.g. `caching`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: behaviorMatchAttrTypes},
+		},
+	}
+}
+
+// Run executes the function logic
+func (f *rulesFindBehaviorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rulesJSON, behaviorName string
+	if resp.Error = req.Arguments.Get(ctx, &rulesJSON, &behaviorName); resp.Error != nil {
+		return
+	}
+
+	tree, err := parseRuleTree(rulesJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	matches := make([]behaviorMatch, 0)
+	var encodeErr error
+	walkRules(&tree.Rules, "", func(path string, rule *papi.Rules) {
+		for _, behavior := range rule.Behaviors {
+			if behavior.Name != behaviorName || encodeErr != nil {
+				continue
+			}
+			options := behavior.Options
+			if options == nil {
+				options = papi.RuleOptionsMap{}
+			}
+			encoded, err := json.Marshal(options)
+			if err != nil {
+				encodeErr = err
+				return
+			}
+			matches = append(matches, behaviorMatch{Path: path, Options: string(encoded)})
+		}
+	})
+	if encodeErr != nil {
+		resp.Error = function.NewFuncError(encodeErr.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, matches)
+}
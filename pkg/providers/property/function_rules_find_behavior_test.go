@@ -0,0 +1,52 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionRulesFindBehavior(t *testing.T) {
+	tests := map[string]struct {
+		givenTF     string
+		check       resource.TestCheckFunc
+		expectError *regexp.Regexp
+	}{
+		"find behavior in rule tree": {
+			givenTF: "rules_find_behavior.tf",
+			check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckOutput("count", "2"),
+				resource.TestCheckOutput("first_path", "default"),
+				resource.TestCheckOutput("first_ttl", "1d"),
+				resource.TestCheckOutput("second_path", "default/Static content"),
+				resource.TestCheckOutput("second_ttl", "7d"),
+				resource.TestCheckOutput("missing_count", "0"),
+			),
+		},
+		"invalid rules": {
+			givenTF:     "rules_find_behavior_invalid_json.tf",
+			expectError: regexp.MustCompile("rules are not valid JSON"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureStringf(t, "testdata/TestFunctionRules/%s", test.givenTF),
+						Check:       test.check,
+						ExpectError: test.expectError,
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package property
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &rulesMergeFunction{}
+
+// NewRulesMergeFunction returns a new function merging two property rule trees
+func NewRulesMergeFunction() function.Function {
+	return &rulesMergeFunction{}
+}
+
+// rulesMergeFunction defines the rules_merge function implementation
+type rulesMergeFunction struct{}
+
+// Metadata configures function's meta information
+func (f *rulesMergeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rules_merge"
+}
+
+// Definition is used to define function's parameters and return type
+func (f *rulesMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merges two property rule trees",
+		MarkdownDescription: "Merges the `overlay` rule tree into the `base` rule tree. Rules, behaviors and variables are " +
+			"matched by name. Options of matching behaviors are merged with the overlay values taking precedence, " +
+			"criteria of the overlay rule replace the base criteria and anything missing in the base is appended. " +
+			"Both the PAPI rules envelope (`{\"rules\": {...}}`) and a bare rule are accepted, " +
+			"the result has the same shape as `base`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: "Rule tree in JSON format to merge into.",
+			},
+			function.StringParameter{
+				Name:                "overlay",
+				MarkdownDescription: "Rule tree in JSON format to merge into the base rule tree.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the function logic
+func (f *rulesMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var baseJSON, overlayJSON string
+	if resp.Error = req.Arguments.Get(ctx, &baseJSON, &overlayJSON); resp.Error != nil {
+		return
+	}
+
+	base, err := parseRuleTree(baseJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	overlay, err := parseRuleTree(overlayJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	base.Rules = mergeRules(base.Rules, overlay.Rules)
+	if overlay.Comments != "" {
+		base.Comments = overlay.Comments
+	}
+
+	merged, err := base.JSON()
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, merged)
+}
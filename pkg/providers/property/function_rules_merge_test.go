@@ -0,0 +1,46 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionRulesMerge(t *testing.T) {
+	tests := map[string]struct {
+		givenTF     string
+		check       resource.TestCheckFunc
+		expectError *regexp.Regexp
+	}{
+		"merge rule trees": {
+			givenTF: "rules_merge.tf",
+			check: resource.TestCheckOutput("merged",
+				compactJSON(testutils.LoadFixtureBytes(t, "testdata/TestFunctionRules/merged.json"))),
+		},
+		"invalid base rules": {
+			givenTF:     "rules_merge_invalid_json.tf",
+			expectError: regexp.MustCompile("rules are not valid JSON"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureStringf(t, "testdata/TestFunctionRules/%s", test.givenTF),
+						Check:       test.check,
+						ExpectError: test.expectError,
+					},
+				},
+			})
+		})
+	}
+}
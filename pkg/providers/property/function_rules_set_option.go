@@ -0,0 +1,188 @@
+package property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ function.Function = &rulesSetOptionFunction{}
+
+// NewRulesSetOptionFunction returns a new function setting a behavior option in a property rule tree
+func NewRulesSetOptionFunction() function.Function {
+	return &rulesSetOptionFunction{}
+}
+
+// rulesSetOptionFunction defines the rules_set_option function implementation
+type rulesSetOptionFunction struct{}
+
+// Metadata configures function's meta information
+func (f *rulesSetOptionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rules_set_option"
+}
+
+// Definition is used to define function's parameters and return type
+func (f *rulesSetOptionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Sets an option of a behavior in a property rule tree",
+		MarkdownDescription: "Sets the `option` of every behavior named `behavior` in the rule located under `rule_path` " +
+			"and returns the updated rule tree in the same shape as `rules`. The rule path consists of rule names " +
+			"separated with `/`, starting with the top-level rule, e.g. `default/Performance/Compression`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rules",
+				MarkdownDescription: "Rule tree in JSON format.",
+			},
+			function.StringParameter{
+				Name:                "rule_path",
+				MarkdownDescription: "Path of the rule containing the behavior.",
+			},
+			function.StringParameter{
+				Name:                "behavior",
+				MarkdownDescription: "Name of the behavior to update.",
+			},
+			function.StringParameter{
+				Name:                "option",
+				MarkdownDescription: "Name of the behavior option to set.",
+			},
+			function.DynamicParameter{
+				Name:                "value",
+				MarkdownDescription: "New value of the option. Strings, numbers, booleans, lists and objects are supported.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the function logic
+func (f *rulesSetOptionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rulesJSON, rulePath, behaviorName, optionName string
+	var value types.Dynamic
+	if resp.Error = req.Arguments.Get(ctx, &rulesJSON, &rulePath, &behaviorName, &optionName, &value); resp.Error != nil {
+		return
+	}
+
+	tree, err := parseRuleTree(rulesJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	rule, err := findRule(&tree.Rules, rulePath)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	optionValue, err := dynamicToValue(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, err.Error())
+		return
+	}
+
+	found := false
+	for i := range rule.Behaviors {
+		if rule.Behaviors[i].Name != behaviorName {
+			continue
+		}
+		if rule.Behaviors[i].Options == nil {
+			rule.Behaviors[i].Options = papi.RuleOptionsMap{}
+		}
+		rule.Behaviors[i].Options[optionName] = optionValue
+		found = true
+	}
+	if !found {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("%s: '%s' in rule '%s'", ErrBehaviorNotFound, behaviorName, rulePath))
+		return
+	}
+
+	updated, err := tree.JSON()
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, updated)
+}
+
+// dynamicToValue converts a dynamic terraform value into its go representation, which can be encoded to JSON
+func dynamicToValue(value types.Dynamic) (any, error) {
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() || value.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+	return attrToValue(value.UnderlyingValue())
+}
+
+func attrToValue(value attr.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	switch v := value.(type) {
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		number := v.ValueBigFloat()
+		if number.IsInt() {
+			i, _ := number.Int64()
+			return i, nil
+		}
+		f, _ := number.Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return attrsToValues(v.Elements())
+	case basetypes.SetValue:
+		return attrsToValues(v.Elements())
+	case basetypes.TupleValue:
+		return attrsToValues(v.Elements())
+	case basetypes.MapValue:
+		return attrMapToValues(v.Elements())
+	case basetypes.ObjectValue:
+		return attrMapToValues(v.Attributes())
+	case basetypes.DynamicValue:
+		return dynamicToValue(v)
+	default:
+		return nil, fmt.Errorf("unsupported value type: %s", value.Type(context.Background()))
+	}
+}
+
+func attrsToValues(elements []attr.Value) ([]any, error) {
+	values := make([]any, 0, len(elements))
+	for _, element := range elements {
+		v, err := attrToValue(element)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func attrMapToValues(elements map[string]attr.Value) (map[string]any, error) {
+	values := make(map[string]any, len(elements))
+	for k, element := range elements {
+		v, err := attrToValue(element)
+		if err != nil {
+			return nil, err
+		}
+		values[k] = v
+	}
+	return values, nil
+}
@@ -0,0 +1,53 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFunctionRulesSetOption(t *testing.T) {
+	tests := map[string]struct {
+		givenTF     string
+		check       resource.TestCheckFunc
+		expectError *regexp.Regexp
+	}{
+		"set options of different types": {
+			givenTF: "rules_set_option.tf",
+			check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckOutput("gzip", "ORIGIN_RESPONSE"),
+				resource.TestCheckOutput("port", "8080"),
+				resource.TestCheckOutput("list", "a,b"),
+			),
+		},
+		"rule not found": {
+			givenTF:     "rules_set_option_missing_rule.tf",
+			expectError: regexp.MustCompile("rule not found: 'default/Missing'"),
+		},
+		"behavior not found": {
+			givenTF:     "rules_set_option_missing_behavior.tf",
+			expectError: regexp.MustCompile("behavior not found: 'origin' in rule\\s+'default/Performance'"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureStringf(t, "testdata/TestFunctionRules/%s", test.givenTF),
+						Check:       test.check,
+						ExpectError: test.expectError,
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package property
+
+import (
+	"context"
+	"errors"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/providers/property/ruleformats"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &rulesToBuilderHCLFunction{}
+
+// NewRulesToBuilderHCLFunction returns a new function converting property rules into akamai_property_rules_builder HCL
+func NewRulesToBuilderHCLFunction() function.Function {
+	return &rulesToBuilderHCLFunction{}
+}
+
+// rulesToBuilderHCLFunction defines the rules_to_builder_hcl function implementation
+type rulesToBuilderHCLFunction struct{}
+
+// Metadata configures function's meta information
+func (f *rulesToBuilderHCLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rules_to_builder_hcl"
+}
+
+// Definition is used to define function's parameters and return type
+func (f *rulesToBuilderHCLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a property rule tree into akamai_property_rules_builder data sources",
+		MarkdownDescription: "Converts `rules` into `akamai_property_rules_builder` data sources in HCL, one for every rule, " +
+			"named after the rule. Parent rules refer to the `json` attribute of their children. The returned HCL builds " +
+			"the same rules as the given ones, otherwise an error is returned.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rules",
+				MarkdownDescription: "Rule tree in JSON format.",
+			},
+			function.StringParameter{
+				Name:                "rule_format",
+				MarkdownDescription: "Frozen rule format of the rules, e.g. `v2025-10-16`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the function logic
+func (f *rulesToBuilderHCLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rulesJSON, ruleFormat string
+	if resp.Error = req.Arguments.Get(ctx, &rulesJSON, &ruleFormat); resp.Error != nil {
+		return
+	}
+
+	tree, err := parseRuleTree(rulesJSON)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	hcl, err := ruleformats.RulesToHCL(ruleFormat, tree.Rules)
+	if err != nil {
+		if errors.Is(err, ruleformats.ErrUnknownRuleFormat) {
+			resp.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, hcl)
+}
@@ -0,0 +1,83 @@
+package property
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/providers/property/ruleformats"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunctionRulesToBuilderHCL(t *testing.T) {
+	tests := map[string]struct {
+		givenTF     string
+		check       resource.TestCheckFunc
+		expectError *regexp.Regexp
+	}{
+		"convert rule tree": {
+			givenTF: "rules_to_builder_hcl.tf",
+			check: resource.TestCheckOutput("hcl",
+				testutils.LoadFixtureString(t, "testdata/TestFunctionRules/rules_to_builder_hcl_expected.hcl")),
+		},
+		"unknown rule format": {
+			givenTF:     "rules_to_builder_hcl_unknown_rule_format.tf",
+			expectError: regexp.MustCompile("unknown rule format: v2015-08-08"),
+		},
+		"invalid rules": {
+			givenTF:     "rules_to_builder_hcl_invalid_rules.tf",
+			expectError: regexp.MustCompile(`rules are not valid for rule format\s+v2025-10-16:\s+default: behavior gzipResponse.behavior`),
+		},
+		"variable in boolean option": {
+			givenTF:     "rules_to_builder_hcl_variable_in_boolean.tf",
+			expectError: regexp.MustCompile(`prefetch.enabled: values\s+referring\s+to\s+variables\s+can\s+be\s+converted\s+only\s+for\s+string\s+options`),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureStringf(t, "testdata/TestFunctionRules/%s", test.givenTF),
+						Check:       test.check,
+						ExpectError: test.expectError,
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestRulesToHCL converts rules built from the rules builder fixtures of every rule format
+// and checks that the converted data sources build the same rules
+func TestRulesToHCL(t *testing.T) {
+	for _, ruleFormat := range ruleformats.RulesFormats() {
+		t.Run(ruleFormat.Version(), func(t *testing.T) {
+			fixture := fmt.Sprintf("testdata/TestDSPropertyRulesBuilder/ruleformat/%s/default.json",
+				strings.TrimPrefix(ruleFormat.SchemaKey(), "rules_"))
+			rulesJSON := testutils.LoadFixtureString(t, fixture)
+			tree, err := parseRuleTree(rulesJSON)
+			require.NoError(t, err)
+
+			hcl, err := ruleformats.RulesToHCL(ruleFormat.Version(), tree.Rules)
+			require.NoError(t, err)
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestFunctionRules/provider.tf") + hcl,
+					Check:  testCheckResourceAttrJSON("data.akamai_property_rules_builder.default", "json", rulesJSON),
+				}},
+			})
+		})
+	}
+}
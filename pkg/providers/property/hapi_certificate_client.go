@@ -0,0 +1,124 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/errs"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/hapi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+)
+
+type (
+	// HAPICertificate is the interface for changing the certificate of an edge hostname with the Edge Hostnames API.
+	//
+	// The EdgeGrid library can only read the certificate of an edge hostname, so a minimal client is implemented here.
+	HAPICertificate interface {
+		// UpdateCertificate submits a change request, which replaces the certificate of the edge hostname
+		// with the certificate of the enrollment.
+		//
+		// See: https://techdocs.akamai.com/edge-hostnames/reference/put-edge-hostname-certificate
+		UpdateCertificate(ctx context.Context, params UpdateCertificateRequest) (*hapi.UpdateEdgeHostnameResponse, error)
+	}
+
+	hapiCertificate struct {
+		session.Session
+	}
+
+	// UpdateCertificateRequest identifies the edge hostname by its DNS zone and record name, and the new certificate by its enrollment.
+	UpdateCertificateRequest struct {
+		DNSZone           string
+		RecordName        string
+		CertEnrollmentID  int
+		StatusUpdateEmail []string
+		Comments          string
+	}
+)
+
+var (
+	// ErrUpdateCertificate is returned when changing the certificate of an edge hostname fails.
+	ErrUpdateCertificate = errors.New("updating edge hostname certificate")
+)
+
+// NewHAPICertificateClient creates a new client for changing certificates of edge hostnames.
+func NewHAPICertificateClient(sess session.Session) HAPICertificate {
+	return &hapiCertificate{Session: sess}
+}
+
+// Validate validates UpdateCertificateRequest.
+func (r UpdateCertificateRequest) Validate() error {
+	if r.DNSZone == "" || r.RecordName == "" {
+		return errors.New("DNS zone and record name of the edge hostname are required")
+	}
+	if r.CertEnrollmentID <= 0 {
+		return errors.New("certificate enrollment ID has to be positive")
+	}
+	return nil
+}
+
+func (c *hapiCertificate) UpdateCertificate(ctx context.Context, params UpdateCertificateRequest) (*hapi.UpdateEdgeHostnameResponse, error) {
+	logger := c.Log(ctx)
+	logger.Debug("UpdateCertificate")
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateCertificate, err)
+	}
+
+	uri := fmt.Sprintf("/hapi/v1/dns-zones/%s/edge-hostnames/%s/certificate",
+		url.PathEscape(params.DNSZone), url.PathEscape(params.RecordName))
+	query := url.Values{}
+	if len(params.StatusUpdateEmail) > 0 {
+		query.Set("statusUpdateEmail", strings.Join(params.StatusUpdateEmail, ","))
+	}
+	if params.Comments != "" {
+		query.Set("comments", params.Comments)
+	}
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrUpdateCertificate, err)
+	}
+
+	var result hapi.UpdateEdgeHostnameResponse
+	resp, err := c.Exec(req, &result, struct {
+		CertEnrollmentID int `json:"certEnrollmentId"`
+	}{CertEnrollmentID: params.CertEnrollmentID})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrUpdateCertificate, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%s: %w", ErrUpdateCertificate, c.Error(resp))
+	}
+
+	return &result, nil
+}
+
+// Error parses an error from the Edge Hostnames API response.
+func (c *hapiCertificate) Error(r *http.Response) error {
+	var e hapi.Error
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		c.Log(r.Request.Context()).Errorf("reading error response body: %s", err)
+		e.Status = r.StatusCode
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		c.Log(r.Request.Context()).Errorf("could not unmarshal API error: %s", err)
+		e.Title = "Failed to unmarshal error body. HAPI API failed. Check details for more information."
+		e.Detail = errs.UnescapeContent(string(body))
+	}
+	e.Status = r.StatusCode
+	return &e
+}
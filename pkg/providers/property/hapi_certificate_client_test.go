@@ -0,0 +1,116 @@
+package property
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/hapi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockHAPICertificateAPIClient(t *testing.T, mockServer *httptest.Server) HAPICertificate {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
+	require.NoError(t, err)
+	return NewHAPICertificateClient(s)
+}
+
+func TestHAPICertificateClient(t *testing.T) {
+	request := UpdateCertificateRequest{
+		DNSZone:           "edgekey.net",
+		RecordName:        "www.example.com",
+		CertEnrollmentID:  800800,
+		StatusUpdateEmail: []string{"a@example.com", "b@example.com"},
+		Comments:          "change certificate",
+	}
+
+	tests := map[string]struct {
+		request          UpdateCertificateRequest
+		responseStatus   int
+		responseBody     string
+		expectedPath     string
+		expectedBody     string
+		expectedResponse *hapi.UpdateEdgeHostnameResponse
+		withError        func(*testing.T, error)
+	}{
+		"202 update certificate": {
+			request:        request,
+			responseStatus: http.StatusAccepted,
+			responseBody:   `{"action": "EDIT", "changeId": 3456, "status": "PENDING", "comments": "change certificate"}`,
+			expectedPath:   "/hapi/v1/dns-zones/edgekey.net/edge-hostnames/www.example.com/certificate?comments=change+certificate&statusUpdateEmail=a%40example.com%2Cb%40example.com",
+			expectedBody:   `{"certEnrollmentId": 800800}`,
+			expectedResponse: &hapi.UpdateEdgeHostnameResponse{
+				Action:   "EDIT",
+				ChangeID: 3456,
+				Status:   "PENDING",
+				Comments: "change certificate",
+			},
+		},
+		"403 update certificate": {
+			request:        UpdateCertificateRequest{DNSZone: "edgekey.net", RecordName: "www.example.com", CertEnrollmentID: 800800},
+			responseStatus: http.StatusForbidden,
+			responseBody:   `{"type": "/hapi/problems/forbidden", "title": "Forbidden", "detail": "No access to enrollment 800800", "status": 403}`,
+			expectedPath:   "/hapi/v1/dns-zones/edgekey.net/edge-hostnames/www.example.com/certificate",
+			expectedBody:   `{"certEnrollmentId": 800800}`,
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "updating edge hostname certificate")
+				var apiErr *hapi.Error
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, http.StatusForbidden, apiErr.Status)
+				assert.Equal(t, "No access to enrollment 800800", apiErr.Detail)
+			},
+		},
+		"validation error - no enrollment": {
+			request: UpdateCertificateRequest{DNSZone: "edgekey.net", RecordName: "www.example.com"},
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "updating edge hostname certificate")
+				assert.Contains(t, err.Error(), "certificate enrollment ID has to be positive")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, http.MethodPut, r.Method)
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, test.expectedBody, string(body))
+				w.WriteHeader(test.responseStatus)
+				_, err = w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			client := mockHAPICertificateAPIClient(t, mockServer)
+			result, err := client.UpdateCertificate(context.Background(), test.request)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package property
+
+import (
+	"context"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/hapi"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockHAPICertificate struct {
+	mock.Mock
+}
+
+var _ HAPICertificate = &mockHAPICertificate{}
+
+func (m *mockHAPICertificate) UpdateCertificate(ctx context.Context, params UpdateCertificateRequest) (*hapi.UpdateEdgeHostnameResponse, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*hapi.UpdateEdgeHostnameResponse), args.Error(1)
+}
@@ -0,0 +1,134 @@
+package property
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hostnamesFileColumns are the columns of a CSV hostnames file, and the members of the objects of a JSON hostnames file.
+var hostnamesFileColumns = []string{"cname_from", "edge_hostname_id", "cert_provisioning_type"}
+
+// hostnamesFileEntry is a single hostname of a hostnames file.
+type hostnamesFileEntry struct {
+	CnameFrom            string `json:"cname_from"`
+	EdgeHostnameID       string `json:"edge_hostname_id"`
+	CertProvisioningType string `json:"cert_provisioning_type"`
+}
+
+// readHostnamesFile reads the hostnames of a hostname bucket from a CSV or a JSON file, depending on the file extension.
+// A CSV file starts with a header naming the `cname_from`, `edge_hostname_id` and `cert_provisioning_type` columns,
+// a JSON file contains an array of objects with these members. The `cname_to` attribute of the returned hostnames is
+// unknown, as it is computed by the API.
+func readHostnamesFile(name string) (map[string]Hostname, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("could not read hostnames file: %w", err)
+	}
+
+	var entries []hostnamesFileEntry
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		entries, err = parseHostnamesCSV(content)
+	case ".json":
+		entries, err = parseHostnamesJSON(content)
+	default:
+		return nil, fmt.Errorf("hostnames file %s must have either the .csv or the .json extension", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid hostnames file %s: %w", name, err)
+	}
+
+	if len(entries) == 0 || len(entries) > maxHostnamesNumber {
+		return nil, fmt.Errorf("hostnames file %s must contain at least 1 and at most %d hostnames, got: %d", name, maxHostnamesNumber, len(entries))
+	}
+	hostnames := make(map[string]Hostname, len(entries))
+	for i, entry := range entries {
+		if err := entry.validate(); err != nil {
+			return nil, fmt.Errorf("invalid hostnames file %s: hostname %d: %w", name, i+1, err)
+		}
+		if _, ok := hostnames[entry.CnameFrom]; ok {
+			return nil, fmt.Errorf("invalid hostnames file %s: hostname %s is listed more than once", name, entry.CnameFrom)
+		}
+		hostnames[entry.CnameFrom] = Hostname{
+			CertProvisioningType: types.StringValue(entry.CertProvisioningType),
+			EdgeHostnameID:       types.StringValue(entry.EdgeHostnameID),
+			CnameTo:              types.StringUnknown(),
+		}
+	}
+	return hostnames, nil
+}
+
+func parseHostnamesCSV(content []byte) ([]hostnamesFileEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the header: %w", err)
+	}
+	columns := make(map[string]int, len(hostnamesFileColumns))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if !slices.Contains(hostnamesFileColumns, column) {
+			return nil, fmt.Errorf("unknown column %q, expected: %s", column, strings.Join(hostnamesFileColumns, ", "))
+		}
+		columns[column] = i
+	}
+	for _, column := range hostnamesFileColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing column %q", column)
+		}
+	}
+
+	var entries []hostnamesFileEntry
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, hostnamesFileEntry{
+			CnameFrom:            strings.TrimSpace(record[columns["cname_from"]]),
+			EdgeHostnameID:       strings.TrimSpace(record[columns["edge_hostname_id"]]),
+			CertProvisioningType: strings.TrimSpace(record[columns["cert_provisioning_type"]]),
+		})
+	}
+}
+
+func parseHostnamesJSON(content []byte) ([]hostnamesFileEntry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	var entries []hostnamesFileEntry
+	if err := decoder.Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (e hostnamesFileEntry) validate() error {
+	if e.CnameFrom == "" {
+		return fmt.Errorf("missing cname_from")
+	}
+	if e.EdgeHostnameID == "" {
+		return fmt.Errorf("missing edge_hostname_id of %s", e.CnameFrom)
+	}
+	if e.CertProvisioningType != string(papi.CertTypeDefault) && e.CertProvisioningType != string(papi.CertTypeCPSManaged) {
+		return fmt.Errorf("cert_provisioning_type of %s must be either %s or %s, got: %q",
+			e.CnameFrom, papi.CertTypeDefault, papi.CertTypeCPSManaged, e.CertProvisioningType)
+	}
+	return nil
+}
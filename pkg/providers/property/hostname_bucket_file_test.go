@@ -0,0 +1,116 @@
+package property
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadHostnamesFile(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		content   string
+		expected  map[string]Hostname
+		withError string
+	}{
+		"CSV file": {
+			name: "hostnames.csv",
+			content: "edge_hostname_id, cname_from, cert_provisioning_type\n" +
+				"ehn_1, www.example.com, CPS_MANAGED\n" +
+				"\n" +
+				"ehn_2, static.example.com, DEFAULT\n",
+			expected: map[string]Hostname{
+				"www.example.com": {
+					CertProvisioningType: types.StringValue("CPS_MANAGED"),
+					EdgeHostnameID:       types.StringValue("ehn_1"),
+					CnameTo:              types.StringUnknown(),
+				},
+				"static.example.com": {
+					CertProvisioningType: types.StringValue("DEFAULT"),
+					EdgeHostnameID:       types.StringValue("ehn_2"),
+					CnameTo:              types.StringUnknown(),
+				},
+			},
+		},
+		"JSON file": {
+			name:    "hostnames.JSON",
+			content: `[{"cname_from": "www.example.com", "edge_hostname_id": "ehn_1", "cert_provisioning_type": "CPS_MANAGED"}]`,
+			expected: map[string]Hostname{
+				"www.example.com": {
+					CertProvisioningType: types.StringValue("CPS_MANAGED"),
+					EdgeHostnameID:       types.StringValue("ehn_1"),
+					CnameTo:              types.StringUnknown(),
+				},
+			},
+		},
+		"unsupported extension": {
+			name:      "hostnames.txt",
+			content:   "www.example.com",
+			withError: "must have either the .csv or the .json extension",
+		},
+		"CSV file with unknown column": {
+			name:      "hostnames.csv",
+			content:   "cname_from,cname_to,edge_hostname_id,cert_provisioning_type\n",
+			withError: `unknown column "cname_to"`,
+		},
+		"CSV file with missing column": {
+			name:      "hostnames.csv",
+			content:   "cname_from,edge_hostname_id\nwww.example.com,ehn_1\n",
+			withError: `missing column "cert_provisioning_type"`,
+		},
+		"CSV file with wrong number of fields": {
+			name:      "hostnames.csv",
+			content:   "cname_from,edge_hostname_id,cert_provisioning_type\nwww.example.com,ehn_1\n",
+			withError: "wrong number of fields",
+		},
+		"JSON file with unknown member": {
+			name:      "hostnames.json",
+			content:   `[{"cname_from": "www.example.com", "cname_to": "www.example.com.edgekey.net"}]`,
+			withError: `unknown field "cname_to"`,
+		},
+		"empty file": {
+			name:      "hostnames.json",
+			content:   `[]`,
+			withError: "must contain at least 1 and at most 99999 hostnames, got: 0",
+		},
+		"missing edge hostname": {
+			name:      "hostnames.json",
+			content:   `[{"cname_from": "www.example.com", "cert_provisioning_type": "DEFAULT"}]`,
+			withError: "hostname 1: missing edge_hostname_id of www.example.com",
+		},
+		"invalid cert provisioning type": {
+			name:      "hostnames.csv",
+			content:   "cname_from,edge_hostname_id,cert_provisioning_type\nwww.example.com,ehn_1,CCM\n",
+			withError: `cert_provisioning_type of www.example.com must be either DEFAULT or CPS_MANAGED, got: "CCM"`,
+		},
+		"duplicated hostname": {
+			name:      "hostnames.csv",
+			content:   "cname_from,edge_hostname_id,cert_provisioning_type\nwww.example.com,ehn_1,DEFAULT\nwww.example.com,ehn_2,DEFAULT\n",
+			withError: "hostname www.example.com is listed more than once",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), test.name)
+			require.NoError(t, os.WriteFile(file, []byte(test.content), 0600))
+
+			hostnames, err := readHostnamesFile(file)
+			if test.withError != "" {
+				assert.ErrorContains(t, err, test.withError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hostnames)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := readHostnamesFile(filepath.Join(t.TempDir(), "hostnames.csv"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,123 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/framework/listresource"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource                 = &cpCodeListResource{}
+	_ list.ListResourceWithConfigure    = &cpCodeListResource{}
+	_ list.ListResourceWithRawV6Schemas = &cpCodeListResource{}
+)
+
+type (
+	cpCodeListResource struct {
+		meta meta.Meta
+	}
+
+	cpCodeListResourceModel struct {
+		ContractID types.String `tfsdk:"contract_id"`
+		GroupID    types.String `tfsdk:"group_id"`
+	}
+)
+
+// NewCPCodeListResource returns a new list resource discovering CP codes in the given contract and group
+func NewCPCodeListResource() list.ListResource {
+	return &cpCodeListResource{}
+}
+
+func (r *cpCodeListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected List Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	r.meta = meta.Must(req.ProviderData)
+}
+
+func (r *cpCodeListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_cp_code"
+}
+
+func (r *cpCodeListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists CP codes available in the given contract and group.",
+		Attributes: map[string]schema.Attribute{
+			"contract_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the contract to list CP codes from.",
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the group to list CP codes from.",
+			},
+		},
+	}
+}
+
+func (r *cpCodeListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	listresource.RawV6Schemas(ctx, resourceCPCode(), resp)
+}
+
+func (r *cpCodeListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "CP Code List")
+	var data cpCodeListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	contractID := str.AddPrefix(data.ContractID.ValueString(), "ctr_")
+	groupID := str.AddPrefix(data.GroupID.ValueString(), "grp_")
+	cpCodes, err := Client(r.meta).GetCPCodes(ctx, papi.GetCPCodesRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+	})
+	if err != nil {
+		stream.Results = listresource.ErrorResults("fetching cp codes failed", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(cpCodes.CPCodes.Items))
+	for _, cpCode := range cpCodes.CPCodes.Items {
+		cpCodeID := strings.TrimPrefix(cpCode.ID, cpCodePrefix)
+		var productID string
+		if len(cpCode.ProductIDs) > 0 {
+			productID = cpCode.ProductIDs[0]
+		}
+		items = append(items, listresource.Item{
+			DisplayName: cpCode.Name,
+			Identity: map[string]any{
+				"cp_code_id":  cpCodeID,
+				"contract_id": contractID,
+				"group_id":    groupID,
+			},
+			Resource: map[string]any{
+				"id":          cpCodeID,
+				"name":        cpCode.Name,
+				"contract_id": contractID,
+				"group_id":    groupID,
+				"product_id":  productID,
+			},
+		})
+	}
+	listresource.Stream(ctx, req, stream, items)
+}
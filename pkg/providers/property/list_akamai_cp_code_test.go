@@ -0,0 +1,111 @@
+package property
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCPCodes(t *testing.T) {
+	cpCodes := &papi.GetCPCodesResponse{
+		ContractID: "ctr_1",
+		GroupID:    "grp_2",
+		CPCodes: papi.CPCodeItems{Items: []papi.CPCode{
+			{ID: "cpc_100", Name: "first", ProductIDs: []string{"prd_1", "prd_2"}},
+			{ID: "cpc_200", Name: "second"},
+		}},
+	}
+	config := map[string]tftypes.Value{
+		"contract_id": tftypes.NewValue(tftypes.String, "ctr_1"),
+		"group_id":    tftypes.NewValue(tftypes.String, "2"),
+	}
+	identity := func(cpCodeID string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"cp_code_id":  tftypes.NewValue(tftypes.String, cpCodeID),
+			"contract_id": tftypes.NewValue(tftypes.String, "ctr_1"),
+			"group_id":    tftypes.NewValue(tftypes.String, "grp_2"),
+		}
+	}
+
+	tests := map[string]struct {
+		request         testutils.ListResourceRequest
+		init            func(*papi.Mock)
+		expectedResults []testutils.ListResourceResult
+		expectedError   string
+	}{
+		"list identities": {
+			request: testutils.ListResourceRequest{Config: config},
+			init: func(m *papi.Mock) {
+				m.On("GetCPCodes", testutils.MockContext, papi.GetCPCodesRequest{ContractID: "ctr_1", GroupID: "grp_2"}).
+					Return(cpCodes, nil).Once()
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{DisplayName: "first", Identity: identity("100")},
+				{DisplayName: "second", Identity: identity("200")},
+			},
+		},
+		"list with resource": {
+			request: testutils.ListResourceRequest{Config: config, IncludeResource: true},
+			init: func(m *papi.Mock) {
+				m.On("GetCPCodes", testutils.MockContext, papi.GetCPCodesRequest{ContractID: "ctr_1", GroupID: "grp_2"}).
+					Return(cpCodes, nil).Once()
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "first",
+					Identity:    identity("100"),
+					Resource: map[string]tftypes.Value{
+						"id":          tftypes.NewValue(tftypes.String, "100"),
+						"name":        tftypes.NewValue(tftypes.String, "first"),
+						"contract_id": tftypes.NewValue(tftypes.String, "ctr_1"),
+						"group_id":    tftypes.NewValue(tftypes.String, "grp_2"),
+						"product_id":  tftypes.NewValue(tftypes.String, "prd_1"),
+					},
+				},
+				{
+					DisplayName: "second",
+					Identity:    identity("200"),
+					Resource: map[string]tftypes.Value{
+						"id":         tftypes.NewValue(tftypes.String, "200"),
+						"name":       tftypes.NewValue(tftypes.String, "second"),
+						"product_id": tftypes.NewValue(tftypes.String, ""),
+					},
+				},
+			},
+		},
+		"fetching cp codes fails": {
+			request: testutils.ListResourceRequest{Config: config},
+			init: func(m *papi.Mock) {
+				m.On("GetCPCodes", testutils.MockContext, papi.GetCPCodesRequest{ContractID: "ctr_1", GroupID: "grp_2"}).
+					Return(nil, errors.New("oops")).Once()
+			},
+			expectedError: "fetching cp codes failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			test.init(client)
+			test.request.TypeName = "akamai_cp_code"
+
+			useClient(client, nil, func() {
+				results, diags := testutils.ListResource(t, NewSubprovider(), test.request)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				testutils.AssertListResourceResults(t, test.expectedResults, results)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
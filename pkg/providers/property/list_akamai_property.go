@@ -0,0 +1,122 @@
+package property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/framework/listresource"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource                 = &propertyListResource{}
+	_ list.ListResourceWithConfigure    = &propertyListResource{}
+	_ list.ListResourceWithRawV6Schemas = &propertyListResource{}
+)
+
+type (
+	propertyListResource struct {
+		meta meta.Meta
+	}
+
+	propertyListResourceModel struct {
+		ContractID types.String `tfsdk:"contract_id"`
+		GroupID    types.String `tfsdk:"group_id"`
+	}
+)
+
+// NewPropertyListResource returns a new list resource discovering properties in the given contract and group
+func NewPropertyListResource() list.ListResource {
+	return &propertyListResource{}
+}
+
+func (r *propertyListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected List Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.",
+					req.ProviderData))
+		}
+	}()
+	r.meta = meta.Must(req.ProviderData)
+}
+
+func (r *propertyListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_property"
+}
+
+func (r *propertyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists properties available in the given contract and group.",
+		Attributes: map[string]schema.Attribute{
+			"contract_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the contract to list properties from.",
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the group to list properties from.",
+			},
+		},
+	}
+}
+
+func (r *propertyListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	listresource.RawV6Schemas(ctx, resourceProperty(), resp)
+}
+
+func (r *propertyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Property List")
+	var data propertyListResourceModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	contractID := str.AddPrefix(data.ContractID.ValueString(), "ctr_")
+	groupID := str.AddPrefix(data.GroupID.ValueString(), "grp_")
+	props, err := getProperties(ctx, groupID, contractID, r.meta)
+	if err != nil {
+		stream.Results = listresource.ErrorResults("fetching properties failed", err)
+		return
+	}
+
+	items := make([]listresource.Item, 0, len(props.Properties.Items))
+	for _, prop := range props.Properties.Items {
+		var stagingVersion, productionVersion int
+		if prop.StagingVersion != nil {
+			stagingVersion = *prop.StagingVersion
+		}
+		if prop.ProductionVersion != nil {
+			productionVersion = *prop.ProductionVersion
+		}
+		items = append(items, listresource.Item{
+			DisplayName: prop.PropertyName,
+			Identity: map[string]any{
+				"property_id": prop.PropertyID,
+			},
+			Resource: map[string]any{
+				"id":                 prop.PropertyID,
+				"property_id":        prop.PropertyID,
+				"name":               prop.PropertyName,
+				"contract_id":        prop.ContractID,
+				"group_id":           prop.GroupID,
+				"asset_id":           prop.AssetID,
+				"latest_version":     int64(prop.LatestVersion),
+				"staging_version":    int64(stagingVersion),
+				"production_version": int64(productionVersion),
+			},
+		})
+	}
+	listresource.Stream(ctx, req, stream, items)
+}
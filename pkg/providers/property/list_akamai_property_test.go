@@ -0,0 +1,119 @@
+package property
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/ptr"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListProperties(t *testing.T) {
+	properties := papi.PropertiesItems{Items: []*papi.Property{
+		{
+			AssetID:           "aid_1",
+			ContractID:        "ctr_1",
+			GroupID:           "grp_2",
+			LatestVersion:     3,
+			PropertyID:        "prp_10",
+			PropertyName:      "first",
+			ProductionVersion: ptr.To(2),
+		},
+		{
+			AssetID:        "aid_2",
+			ContractID:     "ctr_1",
+			GroupID:        "grp_2",
+			LatestVersion:  1,
+			PropertyID:     "prp_20",
+			PropertyName:   "second",
+			StagingVersion: ptr.To(1),
+		},
+	}}
+	config := map[string]tftypes.Value{
+		"contract_id": tftypes.NewValue(tftypes.String, "1"),
+		"group_id":    tftypes.NewValue(tftypes.String, "grp_2"),
+	}
+
+	tests := map[string]struct {
+		request         testutils.ListResourceRequest
+		init            func(*papi.Mock)
+		expectedResults []testutils.ListResourceResult
+		expectedError   string
+	}{
+		"list identities": {
+			request: testutils.ListResourceRequest{Config: config},
+			init: func(m *papi.Mock) {
+				m.On("GetProperties", testutils.MockContext, papi.GetPropertiesRequest{ContractID: "ctr_1", GroupID: "grp_2"}).
+					Return(&papi.GetPropertiesResponse{Properties: properties}, nil).Once()
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "first",
+					Identity:    map[string]tftypes.Value{"property_id": tftypes.NewValue(tftypes.String, "prp_10")},
+				},
+				{
+					DisplayName: "second",
+					Identity:    map[string]tftypes.Value{"property_id": tftypes.NewValue(tftypes.String, "prp_20")},
+				},
+			},
+		},
+		"list with limit and resource": {
+			request: testutils.ListResourceRequest{Config: config, Limit: 1, IncludeResource: true},
+			init: func(m *papi.Mock) {
+				m.On("GetProperties", testutils.MockContext, papi.GetPropertiesRequest{ContractID: "ctr_1", GroupID: "grp_2"}).
+					Return(&papi.GetPropertiesResponse{Properties: properties}, nil).Once()
+			},
+			expectedResults: []testutils.ListResourceResult{
+				{
+					DisplayName: "first",
+					Identity:    map[string]tftypes.Value{"property_id": tftypes.NewValue(tftypes.String, "prp_10")},
+					Resource: map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "prp_10"),
+						"property_id":        tftypes.NewValue(tftypes.String, "prp_10"),
+						"name":               tftypes.NewValue(tftypes.String, "first"),
+						"contract_id":        tftypes.NewValue(tftypes.String, "ctr_1"),
+						"group_id":           tftypes.NewValue(tftypes.String, "grp_2"),
+						"asset_id":           tftypes.NewValue(tftypes.String, "aid_1"),
+						"latest_version":     tftypes.NewValue(tftypes.Number, big.NewFloat(3)),
+						"staging_version":    tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+						"production_version": tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+					},
+				},
+			},
+		},
+		"fetching properties fails": {
+			request: testutils.ListResourceRequest{Config: config},
+			init: func(m *papi.Mock) {
+				m.On("GetProperties", testutils.MockContext, papi.GetPropertiesRequest{ContractID: "ctr_1", GroupID: "grp_2"}).
+					Return(nil, errors.New("oops")).Once()
+			},
+			expectedError: "fetching properties failed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &papi.Mock{}
+			test.init(client)
+			test.request.TypeName = "akamai_property"
+
+			useClient(client, nil, func() {
+				results, diags := testutils.ListResource(t, NewSubprovider(), test.request)
+				if test.expectedError != "" {
+					require.Len(t, diags, 1)
+					assert.Equal(t, test.expectedError, diags[0].Summary)
+					return
+				}
+				require.Empty(t, diags)
+				testutils.AssertListResourceResults(t, test.expectedResults, results)
+			})
+
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/errs"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+)
+
+type (
+	// PAPIBulk is the interface for the bulk search of rule trees of the Property Manager API.
+	//
+	// The EdgeGrid library does not cover the bulk operations of this API, so a minimal client is implemented here.
+	PAPIBulk interface {
+		// CreateBulkSearch submits an asynchronous search of the rule trees of all properties the client can access.
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/post-bulk-search
+		CreateBulkSearch(ctx context.Context, params CreateBulkSearchRequest) (*CreateBulkSearchResponse, error)
+
+		// GetBulkSearch returns the status of the bulk search and, once it is complete, its results.
+		//
+		// See: https://techdocs.akamai.com/property-mgr/reference/get-bulk-search
+		GetBulkSearch(ctx context.Context, params GetBulkSearchRequest) (*BulkSearch, error)
+	}
+
+	papiBulk struct {
+		session.Session
+	}
+
+	// CreateBulkSearchRequest contains the query of the bulk search and, optionally, the contract and group it is limited to.
+	CreateBulkSearchRequest struct {
+		ContractID string
+		GroupID    string
+		Query      BulkSearchQuery
+	}
+
+	// BulkSearchQuery is a JSONPath expression matching the searched locations of the rule trees,
+	// along with expressions which all have to match the rule tree of a property for its matches to be reported.
+	BulkSearchQuery struct {
+		Syntax     string   `json:"syntax"`
+		Match      string   `json:"match"`
+		Qualifiers []string `json:"bulkSearchQualifiers,omitempty"`
+	}
+
+	// CreateBulkSearchResponse contains the link to the submitted bulk search.
+	CreateBulkSearchResponse struct {
+		BulkSearchID   int    `json:"-"`
+		BulkSearchLink string `json:"bulkSearchLink"`
+	}
+
+	// GetBulkSearchRequest identifies the bulk search.
+	GetBulkSearchRequest struct {
+		BulkSearchID int
+		ContractID   string
+		GroupID      string
+	}
+
+	// BulkSearch is the status and the results of a bulk search.
+	BulkSearch struct {
+		BulkSearchID int                `json:"bulkSearchId"`
+		Status       string             `json:"searchTargetStatus"`
+		SubmitDate   string             `json:"searchSubmitDate"`
+		UpdateDate   string             `json:"searchUpdateDate"`
+		Query        BulkSearchQuery    `json:"bulkSearchQuery"`
+		Results      []BulkSearchResult `json:"results"`
+	}
+
+	// BulkSearchResult is a property version whose rule tree matches the query, along with the matched locations.
+	BulkSearchResult struct {
+		PropertyID       string   `json:"propertyId"`
+		PropertyName     string   `json:"propertyName"`
+		PropertyVersion  int      `json:"propertyVersion"`
+		PropertyType     string   `json:"propertyType"`
+		GroupID          string   `json:"groupId"`
+		IsLatest         bool     `json:"isLatest"`
+		IsLocked         bool     `json:"isLocked"`
+		StagingStatus    string   `json:"stagingStatus"`
+		ProductionStatus string   `json:"productionStatus"`
+		MatchLocations   []string `json:"matchLocations"`
+	}
+)
+
+const (
+	// BulkSearchSyntaxJSONPath is the only supported syntax of bulk search queries
+	BulkSearchSyntaxJSONPath = "JSONPATH"
+
+	// BulkSearchStatusComplete is the status of a bulk search whose results are available
+	BulkSearchStatusComplete = "COMPLETE"
+	// BulkSearchStatusError is the status of a bulk search which failed
+	BulkSearchStatusError = "ERROR"
+)
+
+var (
+	// ErrCreateBulkSearch is returned when submitting the bulk search fails.
+	ErrCreateBulkSearch = errors.New("creating bulk search")
+	// ErrGetBulkSearch is returned when fetching the bulk search fails.
+	ErrGetBulkSearch = errors.New("fetching bulk search")
+)
+
+// NewPAPIBulkClient creates a new client of the bulk search of the Property Manager API.
+func NewPAPIBulkClient(sess session.Session) PAPIBulk {
+	return &papiBulk{Session: sess}
+}
+
+// Validate validates CreateBulkSearchRequest.
+func (r CreateBulkSearchRequest) Validate() error {
+	if r.Query.Match == "" {
+		return errors.New("match expression is required")
+	}
+	if r.Query.Syntax != BulkSearchSyntaxJSONPath {
+		return fmt.Errorf("unsupported syntax %q, only %s is supported", r.Query.Syntax, BulkSearchSyntaxJSONPath)
+	}
+	return nil
+}
+
+func (c *papiBulk) CreateBulkSearch(ctx context.Context, params CreateBulkSearchRequest) (*CreateBulkSearchResponse, error) {
+	logger := c.Log(ctx)
+	logger.Debug("CreateBulkSearch")
+
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCreateBulkSearch, err)
+	}
+
+	uri := "/papi/v1/bulk/rules-search-requests" + contractAndGroupQuery(params.ContractID, params.GroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrCreateBulkSearch, err)
+	}
+
+	var result CreateBulkSearchResponse
+	resp, err := c.Exec(req, &result, struct {
+		Query BulkSearchQuery `json:"bulkSearchQuery"`
+	}{Query: params.Query})
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrCreateBulkSearch, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusAccepted {
+		return nil, fmt.Errorf("%s: %w", ErrCreateBulkSearch, c.Error(resp))
+	}
+
+	if result.BulkSearchID, err = bulkSearchIDFromLink(result.BulkSearchLink); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCreateBulkSearch, err)
+	}
+	return &result, nil
+}
+
+func (c *papiBulk) GetBulkSearch(ctx context.Context, params GetBulkSearchRequest) (*BulkSearch, error) {
+	logger := c.Log(ctx)
+	logger.Debug("GetBulkSearch")
+
+	uri := fmt.Sprintf("/papi/v1/bulk/rules-search-requests/%d", params.BulkSearchID) +
+		contractAndGroupQuery(params.ContractID, params.GroupID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to create request: %s", ErrGetBulkSearch, err)
+	}
+
+	var result BulkSearch
+	resp, err := c.Exec(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed: %s", ErrGetBulkSearch, err)
+	}
+	defer session.CloseResponseBody(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", ErrGetBulkSearch, c.Error(resp))
+	}
+
+	return &result, nil
+}
+
+// Error parses an error from the Property Manager API response.
+func (c *papiBulk) Error(r *http.Response) error {
+	var e papi.Error
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		c.Log(r.Request.Context()).Errorf("reading error response body: %s", err)
+		e.StatusCode = r.StatusCode
+		e.Title = "Failed to read error body"
+		e.Detail = err.Error()
+		return &e
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		c.Log(r.Request.Context()).Errorf("could not unmarshal API error: %s", err)
+		e.Title = "Failed to unmarshal error body. PAPI API failed. Check details for more information."
+		e.Detail = errs.UnescapeContent(string(body))
+	}
+	e.StatusCode = r.StatusCode
+	return &e
+}
+
+// contractAndGroupQuery returns the query limiting the bulk search to the contract and the group, if they are given.
+func contractAndGroupQuery(contractID, groupID string) string {
+	query := url.Values{}
+	if contractID != "" {
+		query.Set("contractId", contractID)
+	}
+	if groupID != "" {
+		query.Set("groupId", groupID)
+	}
+	if len(query) == 0 {
+		return ""
+	}
+	return "?" + query.Encode()
+}
+
+// bulkSearchIDFromLink returns the ID of the bulk search, which is the last segment of the path of its link.
+func bulkSearchIDFromLink(link string) (int, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0, fmt.Errorf("invalid bulk search link %q: %s", link, err)
+	}
+	id, err := strconv.Atoi(path.Base(u.Path))
+	if err != nil {
+		return 0, fmt.Errorf("invalid bulk search link %q: no bulk search ID", link)
+	}
+	return id, nil
+}
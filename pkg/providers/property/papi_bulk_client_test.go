@@ -0,0 +1,191 @@
+package property
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/edgegrid"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPAPIBulkAPIClient(t *testing.T, mockServer *httptest.Server) PAPIBulk {
+	serverURL, err := url.Parse(mockServer.URL)
+	require.NoError(t, err)
+	certPool := x509.NewCertPool()
+	certPool.AddCert(mockServer.Certificate())
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: certPool,
+			},
+		},
+	}
+	s, err := session.New(session.WithClient(httpClient), session.WithSigner(&edgegrid.Config{Host: serverURL.Host}))
+	require.NoError(t, err)
+	return NewPAPIBulkClient(s)
+}
+
+func TestPAPIBulkClient(t *testing.T) {
+	query := BulkSearchQuery{
+		Syntax:     BulkSearchSyntaxJSONPath,
+		Match:      "$..behaviors[?(@.name == 'origin')]",
+		Qualifiers: []string{"$.rules[?(@.name == 'default')]"},
+	}
+
+	tests := map[string]struct {
+		call             func(PAPIBulk) (any, error)
+		responseStatus   int
+		responseBody     string
+		expectedMethod   string
+		expectedPath     string
+		expectedBody     string
+		expectedResponse any
+		withError        func(*testing.T, error)
+	}{
+		"202 create bulk search": {
+			call: func(c PAPIBulk) (any, error) {
+				return c.CreateBulkSearch(context.Background(), CreateBulkSearchRequest{ContractID: "ctr_1", GroupID: "grp_2", Query: query})
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody:   `{"bulkSearchLink": "/papi/v1/bulk/rules-search-requests/5?contractId=ctr_1&groupId=grp_2"}`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/papi/v1/bulk/rules-search-requests?contractId=ctr_1&groupId=grp_2",
+			expectedBody:   `{"bulkSearchQuery": {"syntax": "JSONPATH", "match": "$..behaviors[?(@.name == 'origin')]", "bulkSearchQualifiers": ["$.rules[?(@.name == 'default')]"]}}`,
+			expectedResponse: &CreateBulkSearchResponse{
+				BulkSearchID:   5,
+				BulkSearchLink: "/papi/v1/bulk/rules-search-requests/5?contractId=ctr_1&groupId=grp_2",
+			},
+		},
+		"202 create bulk search without bulk search ID": {
+			call: func(c PAPIBulk) (any, error) {
+				return c.CreateBulkSearch(context.Background(), CreateBulkSearchRequest{Query: query})
+			},
+			responseStatus: http.StatusAccepted,
+			responseBody:   `{"bulkSearchLink": "/papi/v1/bulk/rules-search-requests/"}`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/papi/v1/bulk/rules-search-requests",
+			withError: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, ErrCreateBulkSearch))
+				assert.Contains(t, err.Error(), "no bulk search ID")
+			},
+		},
+		"400 create bulk search": {
+			call: func(c PAPIBulk) (any, error) {
+				return c.CreateBulkSearch(context.Background(), CreateBulkSearchRequest{ContractID: "ctr_1", Query: query})
+			},
+			responseStatus: http.StatusBadRequest,
+			responseBody:   `{"type": "https://problems.luna.akamaiapis.net/papi/v0/json-path-invalid", "title": "Invalid JSONPath", "detail": "The match expression is invalid", "status": 400}`,
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/papi/v1/bulk/rules-search-requests?contractId=ctr_1",
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "creating bulk search")
+				var apiErr *papi.Error
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+				assert.Equal(t, "The match expression is invalid", apiErr.Detail)
+			},
+		},
+		"validation error - no match": {
+			call: func(c PAPIBulk) (any, error) {
+				return c.CreateBulkSearch(context.Background(), CreateBulkSearchRequest{Query: BulkSearchQuery{Syntax: BulkSearchSyntaxJSONPath}})
+			},
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "creating bulk search")
+				assert.Contains(t, err.Error(), "match expression is required")
+			},
+		},
+		"200 get bulk search": {
+			call: func(c PAPIBulk) (any, error) {
+				return c.GetBulkSearch(context.Background(), GetBulkSearchRequest{BulkSearchID: 5, ContractID: "ctr_1"})
+			},
+			responseStatus: http.StatusOK,
+			responseBody: `{
+	"bulkSearchId": 5,
+	"searchTargetStatus": "COMPLETE",
+	"bulkSearchQuery": {"syntax": "JSONPATH", "match": "$..behaviors[?(@.name == 'origin')]"},
+	"results": [{
+		"propertyId": "prp_1",
+		"propertyName": "www",
+		"propertyVersion": 3,
+		"propertyType": "TRADITIONAL",
+		"groupId": "grp_2",
+		"isLatest": true,
+		"isLocked": false,
+		"stagingStatus": "ACTIVE",
+		"productionStatus": "INACTIVE",
+		"matchLocations": ["/rules/behaviors/0"]
+	}]
+}`,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/papi/v1/bulk/rules-search-requests/5?contractId=ctr_1",
+			expectedResponse: &BulkSearch{
+				BulkSearchID: 5,
+				Status:       BulkSearchStatusComplete,
+				Query:        BulkSearchQuery{Syntax: BulkSearchSyntaxJSONPath, Match: "$..behaviors[?(@.name == 'origin')]"},
+				Results: []BulkSearchResult{{
+					PropertyID:       "prp_1",
+					PropertyName:     "www",
+					PropertyVersion:  3,
+					PropertyType:     "TRADITIONAL",
+					GroupID:          "grp_2",
+					IsLatest:         true,
+					StagingStatus:    "ACTIVE",
+					ProductionStatus: "INACTIVE",
+					MatchLocations:   []string{"/rules/behaviors/0"},
+				}},
+			},
+		},
+		"404 get bulk search": {
+			call: func(c PAPIBulk) (any, error) {
+				return c.GetBulkSearch(context.Background(), GetBulkSearchRequest{BulkSearchID: 5})
+			},
+			responseStatus: http.StatusNotFound,
+			responseBody:   `{"title": "Not Found", "status": 404}`,
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/papi/v1/bulk/rules-search-requests/5",
+			withError: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "fetching bulk search")
+				var apiErr *papi.Error
+				require.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, test.expectedPath, r.URL.String())
+				assert.Equal(t, test.expectedMethod, r.Method)
+				if test.expectedBody != "" {
+					body, err := io.ReadAll(r.Body)
+					assert.NoError(t, err)
+					assert.JSONEq(t, test.expectedBody, string(body))
+				}
+				w.WriteHeader(test.responseStatus)
+				_, err := w.Write([]byte(test.responseBody))
+				assert.NoError(t, err)
+			}))
+			defer mockServer.Close()
+
+			client := mockPAPIBulkAPIClient(t, mockServer)
+			result, err := test.call(client)
+			if test.withError != nil {
+				test.withError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, result)
+		})
+	}
+}
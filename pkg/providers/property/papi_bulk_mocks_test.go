@@ -0,0 +1,29 @@
+package property
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPAPIBulk struct {
+	mock.Mock
+}
+
+var _ PAPIBulk = &mockPAPIBulk{}
+
+func (m *mockPAPIBulk) CreateBulkSearch(ctx context.Context, params CreateBulkSearchRequest) (*CreateBulkSearchResponse, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*CreateBulkSearchResponse), args.Error(1)
+}
+
+func (m *mockPAPIBulk) GetBulkSearch(ctx context.Context, params GetBulkSearchRequest) (*BulkSearch, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BulkSearch), args.Error(1)
+}
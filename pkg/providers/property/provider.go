@@ -14,7 +14,11 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/subprovider"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,6 +45,9 @@ var (
 	hapiClient            hapi.HAPI
 	iamClient             iam.IAM
 	domainownershipClient domainownership.DomainOwnership
+	cprgClient            CPRG
+	bulkClient            PAPIBulk
+	hapiCertificateClient HAPICertificate
 )
 
 // NewSubprovider returns a new property subprovider
@@ -88,15 +95,41 @@ func DomainOwnershipClient(meta meta.Meta) domainownership.DomainOwnership {
 	return domainownership.Client(meta.Session())
 }
 
+// CPRGClient returns the CPRG interface
+func CPRGClient(meta meta.Meta) CPRG {
+	if cprgClient != nil {
+		return cprgClient
+	}
+	return NewCPRGClient(meta.Session())
+}
+
+// HapiCertificateClient returns the HAPICertificate interface
+func HapiCertificateClient(meta meta.Meta) HAPICertificate {
+	if hapiCertificateClient != nil {
+		return hapiCertificateClient
+	}
+	return NewHAPICertificateClient(meta.Session())
+}
+
+// BulkClient returns the PAPIBulk interface
+func BulkClient(meta meta.Meta) PAPIBulk {
+	if bulkClient != nil {
+		return bulkClient
+	}
+	return NewPAPIBulkClient(meta.Session())
+}
+
 // SDKResources returns the property resources implemented using terraform-plugin-sdk
 func (p *Subprovider) SDKResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"akamai_cp_code":                     resourceCPCode(),
-		"akamai_edge_hostname":               resourceSecureEdgeHostName(),
-		"akamai_property":                    resourceProperty(),
-		"akamai_property_activation":         resourcePropertyActivation(),
-		"akamai_property_include":            resourcePropertyInclude(),
-		"akamai_property_include_activation": resourcePropertyIncludeActivation(),
+		"akamai_cp_code":                      resourceCPCode(),
+		"akamai_edge_hostname":                resourceSecureEdgeHostName(),
+		"akamai_property":                     resourceProperty(),
+		"akamai_property_activation":          resourcePropertyActivation(),
+		"akamai_property_activation_pipeline": resourcePropertyActivationPipeline(),
+		"akamai_property_bulk_patch":          resourcePropertyBulkPatch(),
+		"akamai_property_include":             resourcePropertyInclude(),
+		"akamai_property_include_activation":  resourcePropertyIncludeActivation(),
 	}
 }
 
@@ -106,12 +139,14 @@ func (p *Subprovider) SDKDataSources() map[string]*schema.Resource {
 		"akamai_contract":                    dataSourcePropertyContract(),
 		"akamai_contracts":                   dataSourceContracts(),
 		"akamai_cp_code":                     dataSourceCPCode(),
+		"akamai_edge_hostnames":              dataSourceEdgeHostnames(),
 		"akamai_group":                       dataSourcePropertyGroup(),
 		"akamai_groups":                      dataSourcePropertyMultipleGroups(),
 		"akamai_properties":                  dataSourceProperties(),
 		"akamai_properties_search":           dataSourcePropertiesSearch(),
 		"akamai_property":                    dataSourceProperty(),
 		"akamai_property_activation":         dataSourcePropertyActivation(),
+		"akamai_property_bulk_search":        dataSourcePropertyBulkSearch(),
 		"akamai_property_hostnames":          dataSourcePropertyHostnames(),
 		"akamai_property_include_activation": dataSourcePropertyIncludeActivation(),
 		"akamai_property_include_parents":    dataSourcePropertyIncludeParents(),
@@ -129,6 +164,8 @@ func (p *Subprovider) SDKDataSources() map[string]*schema.Resource {
 func (p *Subprovider) FrameworkResources() []func() resource.Resource {
 	return []func() resource.Resource{
 		NewBootstrapResource,
+		NewCloneResource,
+		NewCPCodeReportingGroupResource,
 		NewDomainsResource,
 		NewHostnameBucketResource,
 		NewDomainOwnershipValidationResource,
@@ -146,6 +183,38 @@ func (p *Subprovider) FrameworkDataSources() []func() datasource.DataSource {
 		NewHostnameActivationsDataSource,
 		NewHostnamesDiffDataSource,
 		NewIncludeDataSource,
+		NewRuleFormatUpgradeDataSource,
+		NewRulesValidateDataSource,
+	}
+}
+
+// FrameworkFunctions returns the property functions implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkFunctions() []func() function.Function {
+	return []func() function.Function{
+		NewRulesFindBehaviorFunction,
+		NewRulesMergeFunction,
+		NewRulesSetOptionFunction,
+		NewRulesToBuilderHCLFunction,
+	}
+}
+
+// FrameworkEphemeralResources returns the property ephemeral resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+// FrameworkListResources returns the property list resources implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewCPCodeListResource,
+		NewPropertyListResource,
+	}
+}
+
+// FrameworkActions returns the property actions implemented using terraform-plugin-framework
+func (p *Subprovider) FrameworkActions() []func() action.Action {
+	return []func() action.Action{
+		NewPropertyRollbackAction,
 	}
 }
 
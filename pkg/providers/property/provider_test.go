@@ -8,6 +8,7 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/hapi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/iam"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/cache"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
 )
 
@@ -33,6 +34,11 @@ func useClient(papiCli papi.PAPI, hapiCli hapi.HAPI, f func()) {
 		clientLock.Unlock()
 	}()
 
+	// mocked responses cached by previous tests must not be served to this one
+	for _, bucket := range []cache.Bucket{cache.BucketContracts, cache.BucketGroups, cache.BucketProducts, cache.BucketRuleFormats} {
+		_ = cache.Invalidate(bucket)
+	}
+
 	f()
 }
 
@@ -60,6 +66,45 @@ func useDomainOwnership(domainownershipCli domainownership.DomainOwnership, f fu
 	f()
 }
 
+func useCPRG(cprgCli CPRG, f func()) {
+	clientLock.Lock()
+	origClient := cprgClient
+	cprgClient = cprgCli
+
+	defer func() {
+		cprgClient = origClient
+		clientLock.Unlock()
+	}()
+
+	f()
+}
+
+// useHapiCertificate swaps out the HAPI certificate client for the duration of the given func.
+// It does not take clientLock, so it has to be called inside useClient.
+func useHapiCertificate(certificateCli HAPICertificate, f func()) {
+	origClient := hapiCertificateClient
+	hapiCertificateClient = certificateCli
+
+	defer func() {
+		hapiCertificateClient = origClient
+	}()
+
+	f()
+}
+
+// useBulkClient swaps out the PAPI bulk client for the duration of the given func.
+// It does not take clientLock, so it has to be called inside useClient.
+func useBulkClient(bulkCli PAPIBulk, f func()) {
+	origClient := bulkClient
+	bulkClient = bulkCli
+
+	defer func() {
+		bulkClient = origClient
+	}()
+
+	f()
+}
+
 // Wrapper to intercept the papi.Mock's call of t.FailNow(). The Terraform test driver runs the provider code on
 // goroutines other than the one created for the test. When t.FailNow() is called from any other goroutine, it causes
 // the test to hang because the TF test driver is still waiting to serve requests. Mockery's failure message neglects to
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -31,6 +32,27 @@ func resourceCPCode() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCPCodeImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"cp_code_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Identifier of the CP code",
+					},
+					"contract_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Identifier of the contract under which the CP code was created",
+					},
+					"group_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Identifier of the group under which the CP code was created",
+					},
+				}
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -53,6 +75,23 @@ func resourceCPCode() *schema.Resource {
 				Computed:  true,
 				StateFunc: addPrefixToState("prd_"),
 			},
+			"purgeable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the content served under the CP code can be purged.",
+			},
+			"time_zone_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the time zone which overrides the default time zone of the CP code in reports.",
+			},
+			"default_time_zone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default time zone of the CP code in reports.",
+			},
 			"timeouts": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -134,8 +173,26 @@ func resourceCPCodeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	} else {
 		cpCodeID = cpCode.ID
 	}
-
 	d.SetId(strings.TrimPrefix(cpCodeID, cpCodePrefix))
+
+	// Purgeability and time zone can only be set with the CPRG API, once the CP code exists
+	config := d.GetRawConfig()
+	if !config.GetAttr("purgeable").IsNull() || !config.GetAttr("time_zone_id").IsNull() {
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		update := cpCodeDetailUpdate{name: name}
+		if !config.GetAttr("purgeable").IsNull() {
+			purgeable := d.Get("purgeable").(bool)
+			update.purgeable = &purgeable
+		}
+		update.timeZoneID = d.Get("time_zone_id").(string)
+		if err := updateCPCodeDetail(ctx, client, id, update); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCPCodeRead(ctx, d, m)
 }
 
@@ -164,6 +221,14 @@ func resourceCPCodeRead(ctx context.Context, d *schema.ResourceData, m interface
 	if err := d.Set("contract_id", contractID); err != nil {
 		return diag.Errorf("%s: %s", tf.ErrValueSet, err.Error())
 	}
+	err = tf.SetIdentity(d, map[string]any{
+		"cp_code_id":  d.Id(),
+		"contract_id": contractID,
+		"group_id":    groupID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	cpCodeResp, err := client.GetCPCode(ctx, papi.GetCPCodeRequest{
 		CPCodeID:   d.Id(),
 		ContractID: contractID,
@@ -182,7 +247,45 @@ func resourceCPCodeRead(ctx context.Context, d *schema.ResourceData, m interface
 	if len(cpCode.ProductIDs) == 0 {
 		return diag.Errorf("Couldn't find product id on the CP Code")
 	}
-	if err := d.Set("product_id", cpCode.ProductIDs[0]); err != nil {
+	productID := cpCode.ProductIDs[0]
+	// keep the product from the state, as long as the CP code still has it
+	if current, err := tf.GetStringValue("product_id", d); err == nil && slices.Contains(cpCode.ProductIDs, str.AddPrefix(current, "prd_")) {
+		productID = str.AddPrefix(current, "prd_")
+	}
+	if err := d.Set("product_id", productID); err != nil {
+		return diag.Errorf("%s: %s", tf.ErrValueSet, err.Error())
+	}
+
+	// the CP code details come from the CPRG API, which the API client may have no access to,
+	// so they are read only for CP codes which manage them
+	if !cpCodeDetailManaged(d) {
+		logger.Debugf("Read CP Code: %+v", cpCode)
+		return nil
+	}
+	cpCodeID, err := strconv.Atoi(strings.TrimPrefix(d.Id(), cpCodePrefix))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cpCodeDetail, err := client.GetCPCodeDetail(ctx, cpCodeID)
+	if err != nil {
+		if isHTTP403(err) {
+			logger.Warnf("could not read details of CP code %d: %s", cpCodeID, err)
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Could not read purgeability and time zone of the CP code",
+				Detail: fmt.Sprintf("The API client has no access to the details of CP code %d in the CP Codes and Reporting Groups API, "+
+					"so 'purgeable' and 'time_zone_id' are not refreshed: %s", cpCodeID, err),
+			}}
+		}
+		return diag.FromErr(err)
+	}
+	if err := d.Set("purgeable", cpCodeDetail.Purgeable); err != nil {
+		return diag.Errorf("%s: %s", tf.ErrValueSet, err.Error())
+	}
+	if err := d.Set("time_zone_id", cpCodeDetail.OverrideTimeZone.TimeZoneID); err != nil {
+		return diag.Errorf("%s: %s", tf.ErrValueSet, err.Error())
+	}
+	if err := d.Set("default_time_zone", cpCodeDetail.DefaultTimeZone); err != nil {
 		return diag.Errorf("%s: %s", tf.ErrValueSet, err.Error())
 	}
 
@@ -190,6 +293,19 @@ func resourceCPCodeRead(ctx context.Context, d *schema.ResourceData, m interface
 	return nil
 }
 
+// cpCodeDetailManaged tells whether purgeability or time zone of the CP code is configured, or was already read into the state
+func cpCodeDetailManaged(d *schema.ResourceData) bool {
+	for _, attr := range []string{"purgeable", "time_zone_id"} {
+		if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr(attr).IsNull() {
+			return true
+		}
+		if state := d.GetRawState(); !state.IsNull() && !state.GetAttr(attr).IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceCPCodeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := meta.Must(m)
 	logger := meta.Log("PAPI", "resourceCPCodeUpdate")
@@ -228,25 +344,26 @@ func resourceCPCodeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	cpCode, err := client.GetCPCodeDetail(ctx, cpCodeID)
-	if err != nil {
-		return diag.FromErr(err)
+	update := cpCodeDetailUpdate{
+		name:       name,
+		timeZoneID: d.Get("time_zone_id").(string),
 	}
-
-	_, err = client.UpdateCPCode(ctx, papi.UpdateCPCodeRequest{
-		ID:               cpCode.ID,
-		Name:             name,
-		Purgeable:        &cpCode.Purgeable,
-		OverrideTimeZone: &cpCode.OverrideTimeZone,
-		Contracts:        cpCode.Contracts,
-		Products:         cpCode.Products,
-	})
-	if err != nil {
+	// purgeability is kept as it is, unless it is configured
+	if !d.GetRawConfig().GetAttr("purgeable").IsNull() {
+		purgeable := d.Get("purgeable").(bool)
+		update.purgeable = &purgeable
+	}
+	oldProductID, productID := d.GetChange("product_id")
+	if d.HasChange("product_id") {
+		update.replacedProductID = str.AddPrefix(oldProductID.(string), "prd_")
+		update.productID = str.AddPrefix(productID.(string), "prd_")
+	}
+	if err := updateCPCodeDetail(ctx, client, cpCodeID, update); err != nil {
 		return diag.FromErr(err)
 	}
 
 	// Because we use CPRG API for update, we need to ensure that changes are also present when fetching cpCode with PAPI
-	if err := waitForCPCodeNameUpdate(ctx, client, contractID, groupID, d.Id(), name); err != nil {
+	if err := waitForCPCodeUpdate(ctx, client, contractID, groupID, d.Id(), name, str.AddPrefix(productID.(string), "prd_")); err != nil {
 		if errors.Is(err, ErrCPCodeUpdateTimeout) {
 			return append(tf.DiagWarningf("%s", err), tf.DiagWarningf("Resource has been updated, but the change is still ongoing on the server")...)
 		}
@@ -262,6 +379,10 @@ func resourceCPCodeImport(ctx context.Context, d *schema.ResourceData, m interfa
 	client := Client(meta)
 	logger.Debugf("Import CP Code")
 
+	if err := tf.ImportIDFromIdentity(d, "cp_code_id", "contract_id", "group_id"); err != nil {
+		return nil, err
+	}
+
 	parts := strings.Split(d.Id(), ",")
 
 	if len(parts) < 3 {
@@ -327,7 +448,6 @@ func checkImmutableChanged(d *schema.ResourceData) diag.Diagnostics {
 	immutables := []string{
 		"contract_id",
 		"group_id",
-		"product_id",
 	}
 
 	var diags diag.Diagnostics
@@ -339,14 +459,63 @@ func checkImmutableChanged(d *schema.ResourceData) diag.Diagnostics {
 	return diags
 }
 
-func waitForCPCodeNameUpdate(ctx context.Context, client papi.PAPI, contractID, groupID, CPCodeID, updatedName string) error {
+// cpCodeDetailUpdate holds the CP code attributes which are updated with the CPRG API.
+// The product with replacedProductID is replaced by productID, when the latter is set.
+type cpCodeDetailUpdate struct {
+	name              string
+	purgeable         *bool
+	timeZoneID        string
+	replacedProductID string
+	productID         string
+}
+
+// updateCPCodeDetail updates the CP code with the CPRG API, keeping the current values of attributes not set in the update
+func updateCPCodeDetail(ctx context.Context, client papi.PAPI, cpCodeID int, update cpCodeDetailUpdate) error {
+	cpCode, err := client.GetCPCodeDetail(ctx, cpCodeID)
+	if err != nil {
+		return err
+	}
+
+	purgeable := cpCode.Purgeable
+	if update.purgeable != nil {
+		purgeable = *update.purgeable
+	}
+	timeZone := cpCode.OverrideTimeZone
+	if update.timeZoneID != "" && update.timeZoneID != timeZone.TimeZoneID {
+		timeZone = papi.CPCodeTimeZone{TimeZoneID: update.timeZoneID}
+	}
+	products := cpCode.Products
+	if update.productID != "" {
+		products = make([]papi.CPCodeProduct, 0, len(cpCode.Products)+1)
+		for _, product := range cpCode.Products {
+			productID := str.AddPrefix(product.ProductID, "prd_")
+			if productID != update.replacedProductID && productID != update.productID {
+				products = append(products, product)
+			}
+		}
+		products = append(products, papi.CPCodeProduct{ProductID: update.productID})
+	}
+
+	_, err = client.UpdateCPCode(ctx, papi.UpdateCPCodeRequest{
+		ID:               cpCode.ID,
+		Name:             update.name,
+		Purgeable:        &purgeable,
+		OverrideTimeZone: &timeZone,
+		Contracts:        cpCode.Contracts,
+		Products:         products,
+	})
+	return err
+}
+
+// waitForCPCodeUpdate waits until the name and the product updated with the CPRG API are returned by PAPI
+func waitForCPCodeUpdate(ctx context.Context, client papi.PAPI, contractID, groupID, CPCodeID, updatedName, updatedProductID string) error {
 	req := papi.GetCPCodeRequest{CPCodeID: CPCodeID, ContractID: contractID, GroupID: groupID}
 	CPCodeResp, err := client.GetCPCode(ctx, req)
 	if err != nil {
 		return err
 	}
 
-	for CPCodeResp.CPCode.Name != updatedName {
+	for CPCodeResp.CPCode.Name != updatedName || !slices.Contains(CPCodeResp.CPCode.ProductIDs, updatedProductID) {
 		select {
 		case <-time.After(tf.MaxDuration(updatePollInterval, updatePollMinimum)):
 			CPCodeResp, err = client.GetCPCode(ctx, req)
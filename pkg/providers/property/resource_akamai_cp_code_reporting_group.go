@@ -0,0 +1,328 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/framework/modifiers"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &CPCodeReportingGroupResource{}
+	_ resource.ResourceWithImportState = &CPCodeReportingGroupResource{}
+	_ resource.ResourceWithConfigure   = &CPCodeReportingGroupResource{}
+)
+
+// CPCodeReportingGroupResource represents akamai_cp_code_reporting_group resource
+type CPCodeReportingGroupResource struct {
+	meta meta.Meta
+}
+
+// CPCodeReportingGroupResourceModel is a model for akamai_cp_code_reporting_group resource
+type CPCodeReportingGroupResourceModel struct {
+	ID         types.String                  `tfsdk:"id"`
+	Name       types.String                  `tfsdk:"name"`
+	ContractID types.String                  `tfsdk:"contract_id"`
+	GroupID    types.String                  `tfsdk:"group_id"`
+	Contracts  []ReportingGroupContractModel `tfsdk:"contracts"`
+}
+
+// ReportingGroupContractModel is a model for the CP codes of a reporting group belonging to a contract
+type ReportingGroupContractModel struct {
+	ContractID types.String  `tfsdk:"contract_id"`
+	CPCodeIDs  []types.Int64 `tfsdk:"cp_code_ids"`
+}
+
+// NewCPCodeReportingGroupResource returns new CP code reporting group resource
+func NewCPCodeReportingGroupResource() resource.Resource {
+	return &CPCodeReportingGroupResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *CPCodeReportingGroupResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_cp_code_reporting_group"
+}
+
+// Schema implements resource's Schema
+func (r *CPCodeReportingGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reporting group, which reports the traffic of its CP codes together.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the reporting group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the reporting group",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"contract_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Contract ID of the access group, whose users can access the reporting group",
+				PlanModifiers: []planmodifier.String{
+					modifiers.StringUseStateIf(modifiers.EqualUpToPrefixFunc("ctr_")),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Group ID of the access group, whose users can access the reporting group",
+				PlanModifiers: []planmodifier.String{
+					modifiers.StringUseStateIf(modifiers.EqualUpToPrefixFunc("grp_")),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contracts": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "CP codes of the reporting group by contract",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"contract_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Contract ID of the CP codes",
+						},
+						"cp_code_ids": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.Int64Type,
+							Description: "IDs of the CP codes reported in the reporting group",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *CPCodeReportingGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// ProviderData is nil when Configure is run first time as part of ValidateDataSourceConfig in framework provider
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+		}
+	}()
+	r.meta = meta.Must(req.ProviderData)
+}
+
+// Create implements resource's Create method
+func (r *CPCodeReportingGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating CP Code Reporting Group")
+
+	var data CPCodeReportingGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportingGroup, err := data.toReportingGroup()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid reporting group", err.Error())
+		return
+	}
+
+	created, err := CPRGClient(r.meta).CreateReportingGroup(ctx, *reportingGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not create reporting group", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(created.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read implements resource's Read method
+func (r *CPCodeReportingGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading CP Code Reporting Group")
+
+	var data CPCodeReportingGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportingGroupID, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid reporting group ID", err.Error())
+		return
+	}
+
+	reportingGroup, err := CPRGClient(r.meta).GetReportingGroup(ctx, reportingGroupID)
+	if errors.Is(err, ErrReportingGroupNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("reporting group %d removed on server. Removing from local state", reportingGroupID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Could not read reporting group", err.Error())
+		return
+	}
+
+	data.setReportingGroup(reportingGroup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update supports changes of `name` and `contracts`. Changes of the access group result in resource replacement.
+func (r *CPCodeReportingGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating CP Code Reporting Group")
+
+	var plan CPCodeReportingGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportingGroup, err := plan.toReportingGroup()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid reporting group", err.Error())
+		return
+	}
+	if reportingGroup.ID, err = strconv.Atoi(plan.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Invalid reporting group ID", err.Error())
+		return
+	}
+
+	if _, err := CPRGClient(r.meta).UpdateReportingGroup(ctx, *reportingGroup); err != nil {
+		resp.Diagnostics.AddError("Could not update reporting group", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource's Delete method
+func (r *CPCodeReportingGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting CP Code Reporting Group")
+
+	var data CPCodeReportingGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportingGroupID, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid reporting group ID", err.Error())
+		return
+	}
+
+	err = CPRGClient(r.meta).DeleteReportingGroup(ctx, reportingGroupID)
+	if err != nil && !errors.Is(err, ErrReportingGroupNotFound) {
+		resp.Diagnostics.AddError("Could not delete reporting group", err.Error())
+	}
+}
+
+// ImportState implements resource's ImportState method
+func (r *CPCodeReportingGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing CP Code Reporting Group")
+
+	reportingGroupID, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("invalid reporting group identifier: %s", req.ID), "the identifier must be a number")
+		return
+	}
+
+	reportingGroup, err := CPRGClient(r.meta).GetReportingGroup(ctx, reportingGroupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not read reporting group", err.Error())
+		return
+	}
+
+	data := CPCodeReportingGroupResourceModel{ID: types.StringValue(strconv.Itoa(reportingGroupID))}
+	data.setReportingGroup(reportingGroup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (m CPCodeReportingGroupResourceModel) toReportingGroup() (*ReportingGroup, error) {
+	groupID, err := str.GetIntID(m.GroupID.ValueString(), "grp_")
+	if err != nil {
+		return nil, fmt.Errorf("invalid group ID %q: %w", m.GroupID.ValueString(), err)
+	}
+
+	contracts := make([]ReportingGroupContract, 0, len(m.Contracts))
+	for _, contract := range m.Contracts {
+		cpCodes := make([]ReportingGroupCPCode, 0, len(contract.CPCodeIDs))
+		for _, cpCodeID := range contract.CPCodeIDs {
+			cpCodes = append(cpCodes, ReportingGroupCPCode{ID: int(cpCodeID.ValueInt64())})
+		}
+		contracts = append(contracts, ReportingGroupContract{
+			ContractID: strings.TrimPrefix(contract.ContractID.ValueString(), "ctr_"),
+			CPCodes:    cpCodes,
+		})
+	}
+
+	return &ReportingGroup{
+		Name:      m.Name.ValueString(),
+		Contracts: contracts,
+		AccessGroup: ReportingGroupAccessGroup{
+			GroupID:    groupID,
+			ContractID: strings.TrimPrefix(m.ContractID.ValueString(), "ctr_"),
+		},
+	}, nil
+}
+
+// setReportingGroup sets the model to the reporting group returned by the API, keeping IDs which differ only by prefix
+func (m *CPCodeReportingGroupResourceModel) setReportingGroup(reportingGroup *ReportingGroup) {
+	eqContract := modifiers.EqualUpToPrefixFunc("ctr_")
+	contractID := func(current, id string) string {
+		if eqContract(current, id) {
+			return current
+		}
+		return str.AddPrefix(id, "ctr_")
+	}
+
+	m.Name = types.StringValue(reportingGroup.Name)
+	m.ContractID = types.StringValue(contractID(m.ContractID.ValueString(), reportingGroup.AccessGroup.ContractID))
+	if groupID := strconv.Itoa(reportingGroup.AccessGroup.GroupID); !modifiers.EqualUpToPrefixFunc("grp_")(m.GroupID.ValueString(), groupID) {
+		m.GroupID = types.StringValue(str.AddPrefix(groupID, "grp_"))
+	}
+
+	currentContracts := make(map[string]string, len(m.Contracts))
+	for _, contract := range m.Contracts {
+		currentContracts[strings.TrimPrefix(contract.ContractID.ValueString(), "ctr_")] = contract.ContractID.ValueString()
+	}
+	contracts := make([]ReportingGroupContractModel, 0, len(reportingGroup.Contracts))
+	for _, contract := range reportingGroup.Contracts {
+		cpCodeIDs := make([]types.Int64, 0, len(contract.CPCodes))
+		for _, cpCode := range contract.CPCodes {
+			cpCodeIDs = append(cpCodeIDs, types.Int64Value(int64(cpCode.ID)))
+		}
+		contracts = append(contracts, ReportingGroupContractModel{
+			ContractID: types.StringValue(contractID(currentContracts[contract.ContractID], contract.ContractID)),
+			CPCodeIDs:  cpCodeIDs,
+		})
+	}
+	m.Contracts = contracts
+}
@@ -0,0 +1,213 @@
+package property
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResCPCodeReportingGroup(t *testing.T) {
+	newReportingGroup := func(name string, cpCodeIDs ...int) ReportingGroup {
+		cpCodes := make([]ReportingGroupCPCode, 0, len(cpCodeIDs))
+		for _, id := range cpCodeIDs {
+			cpCodes = append(cpCodes, ReportingGroupCPCode{ID: id})
+		}
+		return ReportingGroup{
+			Name: name,
+			Contracts: []ReportingGroupContract{{
+				ContractID: "1-ABC",
+				CPCodes:    cpCodes,
+			}},
+			AccessGroup: ReportingGroupAccessGroup{GroupID: 2, ContractID: "1-ABC"},
+		}
+	}
+	// mockServer keeps the reporting group returned by GetReportingGroup in sync with the create and update calls
+	mockServer := func(client *mockCPRG, toCreate ReportingGroup) *ReportingGroup {
+		current := &ReportingGroup{}
+		client.On("CreateReportingGroup", testutils.MockContext, toCreate).Run(func(mock.Arguments) {
+			*current = toCreate
+			current.ID = 42
+		}).Return(current, nil).Once()
+		client.On("GetReportingGroup", testutils.MockContext, 42).Return(current, nil)
+		return current
+	}
+	checker := test.NewStateChecker("akamai_cp_code_reporting_group.test").
+		CheckEqual("id", "42").
+		CheckEqual("contract_id", "ctr_1-ABC").
+		CheckEqual("group_id", "grp_2").
+		CheckEqual("contracts.#", "1").
+		CheckEqual("contracts.0.contract_id", "ctr_1-ABC").
+		CheckEqual("contracts.0.cp_code_ids.#", "2")
+
+	t.Run("create, update and delete reporting group", func(t *testing.T) {
+		client := &mockCPRG{}
+		current := mockServer(client, newReportingGroup("web", 123, 456))
+		toUpdate := newReportingGroup("web and media", 123, 789)
+		toUpdate.ID = 42
+		client.On("UpdateReportingGroup", testutils.MockContext, toUpdate).Run(func(mock.Arguments) {
+			*current = toUpdate
+		}).Return(&toUpdate, nil).Once()
+		client.On("DeleteReportingGroup", testutils.MockContext, 42).Return(nil).Once()
+
+		useCPRG(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+						Check: checker.
+							CheckEqual("name", "web").
+							CheckEqual("contracts.0.cp_code_ids.0", "123").
+							CheckEqual("contracts.0.cp_code_ids.1", "456").
+							Build(),
+					},
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/update.tf"),
+						Check: checker.
+							CheckEqual("name", "web and media").
+							CheckEqual("contracts.0.cp_code_ids.0", "123").
+							CheckEqual("contracts.0.cp_code_ids.1", "789").
+							Build(),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("import reporting group", func(t *testing.T) {
+		client := &mockCPRG{}
+		mockServer(client, newReportingGroup("web", 123, 456))
+		client.On("DeleteReportingGroup", testutils.MockContext, 42).Return(nil).Once()
+
+		useCPRG(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+					},
+					{
+						Config:            testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+						ImportState:       true,
+						ImportStateId:     "42",
+						ResourceName:      "akamai_cp_code_reporting_group.test",
+						ImportStateVerify: true,
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid import ID", func(t *testing.T) {
+		client := &mockCPRG{}
+
+		useCPRG(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{
+					{
+						Config:        testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+						ImportState:   true,
+						ImportStateId: "rg_42",
+						ResourceName:  "akamai_cp_code_reporting_group.test",
+						ExpectError:   regexp.MustCompile("invalid reporting group identifier: rg_42"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("reporting group removed on server is recreated", func(t *testing.T) {
+		client := &mockCPRG{}
+		toCreate := newReportingGroup("web", 123, 456)
+		client.On("CreateReportingGroup", testutils.MockContext, toCreate).Return(&ReportingGroup{ID: 42}, nil).Once()
+		client.On("GetReportingGroup", testutils.MockContext, 42).Return(&ReportingGroup{
+			ID:          42,
+			Name:        toCreate.Name,
+			Contracts:   toCreate.Contracts,
+			AccessGroup: toCreate.AccessGroup,
+		}, nil).Once()
+		client.On("GetReportingGroup", testutils.MockContext, 42).Return(nil, fmt.Errorf("%s: %w", ErrGetReportingGroup, &CPRGError{Status: 404})).Once()
+		client.On("CreateReportingGroup", testutils.MockContext, toCreate).Return(&ReportingGroup{ID: 43}, nil).Once()
+		client.On("GetReportingGroup", testutils.MockContext, 43).Return(&ReportingGroup{
+			ID:          43,
+			Name:        toCreate.Name,
+			Contracts:   toCreate.Contracts,
+			AccessGroup: toCreate.AccessGroup,
+		}, nil)
+		client.On("DeleteReportingGroup", testutils.MockContext, 43).Return(nil).Once()
+
+		useCPRG(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+						Check:  test.NewStateChecker("akamai_cp_code_reporting_group.test").CheckEqual("id", "42").Build(),
+					},
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+						Check:  test.NewStateChecker("akamai_cp_code_reporting_group.test").CheckEqual("id", "43").Build(),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error creating reporting group", func(t *testing.T) {
+		client := &mockCPRG{}
+		client.On("CreateReportingGroup", testutils.MockContext, newReportingGroup("web", 123, 456)).
+			Return(nil, fmt.Errorf("%s: %w", ErrCreateReportingGroup, &CPRGError{Status: 400, Detail: "CP code 456 does not belong to contract 1-ABC"})).Once()
+
+		useCPRG(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/create.tf"),
+						ExpectError: regexp.MustCompile("CP code 456 does not belong to contract 1-ABC"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("empty list of CP codes", func(t *testing.T) {
+		client := &mockCPRG{}
+
+		useCPRG(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureString(t, "testdata/TestResCPCodeReportingGroup/no_cp_codes.tf"),
+						ExpectError: regexp.MustCompile(`(?s)Attribute.+cp_code_ids\s+set must contain at least 1 elements`),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
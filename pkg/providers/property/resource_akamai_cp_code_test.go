@@ -2,6 +2,7 @@ package property
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"testing"
@@ -10,6 +11,8 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -264,6 +267,153 @@ func TestResCPCode(t *testing.T) {
 		})
 	})
 
+	t.Run("create CP Code with purgeable flag and time zone", func(t *testing.T) {
+		client := &papi.Mock{}
+		defer client.AssertExpectations(t)
+
+		expectGetCPCodes(client, "ctr_1", "grp_1", nil).Once()
+		expectCreateCPCode(client, "test cpcode", "prd_1", "ctr_1", "grp_1").Once()
+		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_1"}, nil)
+		client.On("GetCPCodeDetail", testutils.MockContext, 0).Return(&papi.CPCodeDetailResponse{
+			ID:              0,
+			Name:            "test cpcode",
+			DefaultTimeZone: "GMT 0 (Greenwich Mean Time)",
+			Contracts:       []papi.CPCodeContract{{ContractID: "1"}},
+			Products:        []papi.CPCodeProduct{{ProductID: "prd_1"}},
+		}, nil).Once()
+		purgeable := true
+		client.On("UpdateCPCode", testutils.MockContext, papi.UpdateCPCodeRequest{
+			ID:               0,
+			Name:             "test cpcode",
+			Purgeable:        &purgeable,
+			OverrideTimeZone: &papi.CPCodeTimeZone{TimeZoneID: "1"},
+			Contracts:        []papi.CPCodeContract{{ContractID: "1"}},
+			Products:         []papi.CPCodeProduct{{ProductID: "prd_1"}},
+		}).Return(&papi.CPCodeDetailResponse{}, nil).Once()
+		client.On("GetCPCodeDetail", testutils.MockContext, 0).Return(&papi.CPCodeDetailResponse{
+			ID:               0,
+			Name:             "test cpcode",
+			Purgeable:        true,
+			DefaultTimeZone:  "GMT 0 (Greenwich Mean Time)",
+			OverrideTimeZone: papi.CPCodeTimeZone{TimeZoneID: "1", TimeZoneValue: "GMT + 1"},
+		}, nil)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResCPCode/create_with_details.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "id", "0"),
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "purgeable", "true"),
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "time_zone_id", "1"),
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "default_time_zone", "GMT 0 (Greenwich Mean Time)"),
+					),
+				}},
+			})
+		})
+	})
+
+	t.Run("details not readable without access to CPRG API", func(t *testing.T) {
+		client := &papi.Mock{}
+		defer client.AssertExpectations(t)
+
+		expectGetCPCodes(client, "ctr_1", "grp_1", nil).Once()
+		expectCreateCPCode(client, "test cpcode", "prd_1", "ctr_1", "grp_1").Once()
+		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_1"}, nil)
+		client.On("GetCPCodeDetail", testutils.MockContext, 0).Return(&papi.CPCodeDetailResponse{
+			ID:        0,
+			Name:      "test cpcode",
+			Contracts: []papi.CPCodeContract{{ContractID: "1"}},
+			Products:  []papi.CPCodeProduct{{ProductID: "prd_1"}},
+		}, nil).Once()
+		purgeable := true
+		client.On("UpdateCPCode", testutils.MockContext, papi.UpdateCPCodeRequest{
+			ID:               0,
+			Name:             "test cpcode",
+			Purgeable:        &purgeable,
+			OverrideTimeZone: &papi.CPCodeTimeZone{TimeZoneID: "1"},
+			Contracts:        []papi.CPCodeContract{{ContractID: "1"}},
+			Products:         []papi.CPCodeProduct{{ProductID: "prd_1"}},
+		}).Return(&papi.CPCodeDetailResponse{}, nil).Once()
+		// reading the details is forbidden, which is reported as a warning
+		client.On("GetCPCodeDetail", testutils.MockContext, 0).Return(nil, fmt.Errorf("%s: %w", papi.ErrGetCPCodeDetail,
+			&papi.Error{StatusCode: http.StatusForbidden, Title: "Forbidden"}))
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResCPCode/create_with_details.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "id", "0"),
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "purgeable", "true"),
+						resource.TestCheckResourceAttr("akamai_cp_code.test", "time_zone_id", "1"),
+					),
+				}},
+			})
+		})
+	})
+
+	t.Run("change product, purgeable flag and time zone", func(t *testing.T) {
+		client := &papi.Mock{}
+		defer client.AssertExpectations(t)
+
+		expectGetCPCodes(client, "ctr_1", "grp_1", nil).Once()
+		expectCreateCPCode(client, "test cpcode", "prd_1", "ctr_1", "grp_1").Once()
+		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_1"}, nil).Times(3)
+		client.On("GetCPCodeDetail", testutils.MockContext, 0).Return(&papi.CPCodeDetailResponse{
+			ID:              0,
+			Name:            "test cpcode",
+			DefaultTimeZone: "GMT 0 (Greenwich Mean Time)",
+			Contracts:       []papi.CPCodeContract{{ContractID: "1"}},
+			Products:        []papi.CPCodeProduct{{ProductID: "prd_1", ProductName: "Product 1"}, {ProductID: "prd_3"}},
+		}, nil).Once()
+		purgeable := true
+		client.On("UpdateCPCode", testutils.MockContext, papi.UpdateCPCodeRequest{
+			ID:               0,
+			Name:             "test cpcode",
+			Purgeable:        &purgeable,
+			OverrideTimeZone: &papi.CPCodeTimeZone{TimeZoneID: "1"},
+			Contracts:        []papi.CPCodeContract{{ContractID: "1"}},
+			Products:         []papi.CPCodeProduct{{ProductID: "prd_3"}, {ProductID: "prd_2"}},
+		}).Return(&papi.CPCodeDetailResponse{}, nil).Once()
+		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_1", "prd_3"}, nil).Once()
+		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_3", "prd_2"}, nil)
+		client.On("GetCPCodeDetail", testutils.MockContext, 0).Return(&papi.CPCodeDetailResponse{
+			ID:               0,
+			Name:             "test cpcode",
+			Purgeable:        true,
+			DefaultTimeZone:  "GMT 0 (Greenwich Mean Time)",
+			OverrideTimeZone: papi.CPCodeTimeZone{TimeZoneID: "1", TimeZoneValue: "GMT + 1"},
+		}, nil)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCode/change_name_step0.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cp_code.test", "product_id", "prd_1"),
+							// details are not read, as they are not configured
+							resource.TestCheckNoResourceAttr("akamai_cp_code.test", "purgeable"),
+							resource.TestCheckNoResourceAttr("akamai_cp_code.test", "time_zone_id"),
+						),
+					},
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCode/change_product_and_details.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_cp_code.test", "product_id", "prd_2"),
+							resource.TestCheckResourceAttr("akamai_cp_code.test", "purgeable", "true"),
+							resource.TestCheckResourceAttr("akamai_cp_code.test", "time_zone_id", "1"),
+						),
+					},
+				},
+			})
+		})
+	})
+
 	t.Run("import existing cp code", func(t *testing.T) {
 		client := &papi.Mock{}
 		id := "0,1,2"
@@ -300,6 +450,38 @@ func TestResCPCode(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("import existing cp code by identity", func(t *testing.T) {
+		client := &papi.Mock{}
+
+		CPCodes := []papi.CPCode{{ID: "0", Name: "test cpcode", ProductIDs: []string{"prd_Web_Accel"}}}
+		expectGetCPCodes(client, "ctr_1", "grp_2", CPCodes)
+		expectGetCPCode(client, "ctr_1", "grp_2", 0, "test cpcode", []string{"prd_Web_Accel"}, nil)
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResCPCode/import_cp_code.tf"),
+						ConfigStateChecks: []statecheck.StateCheck{
+							statecheck.ExpectIdentity("akamai_cp_code.test", map[string]knownvalue.Check{
+								"cp_code_id":  knownvalue.StringExact("0"),
+								"contract_id": knownvalue.StringExact("ctr_1"),
+								"group_id":    knownvalue.StringExact("grp_2"),
+							}),
+						},
+					},
+					{
+						Config:          testutils.LoadFixtureString(t, "testdata/TestResCPCode/import_cp_code.tf"),
+						ImportState:     true,
+						ImportStateKind: resource.ImportBlockWithResourceIdentity,
+						ResourceName:    "akamai_cp_code.test",
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
 	t.Run("invalid import ID passed", func(t *testing.T) {
 		client := &papi.Mock{}
 		id := "123"
@@ -348,7 +530,7 @@ func TestResCPCode(t *testing.T) {
 
 		expectGetCPCodes(client, "ctr_1", "grp_1", nil).Once()
 		expectCreateCPCode(client, "test cpcode", "prd_1", "ctr_1", "grp_1").Once()
-		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_1"}, nil).Times(5)
+		expectGetCPCode(client, "ctr_1", "grp_1", 0, "test cpcode", []string{"prd_1"}, nil).Times(4)
 
 		// No mock behavior for delete because there is no delete operation for CP Codes
 
@@ -377,17 +559,6 @@ func TestResCPCode(t *testing.T) {
 							resource.TestCheckResourceAttr("akamai_cp_code.test", "product_id", "prd_1"),
 						),
 					},
-					{
-						Config:      testutils.LoadFixtureString(t, "testdata/TestResCPCode/change_immutable.tf"),
-						ExpectError: regexp.MustCompile(`cp code attribute 'product_id' cannot be changed after creation \(immutable\)`),
-						Check: resource.ComposeAggregateTestCheckFunc(
-							resource.TestCheckResourceAttr("akamai_cp_code.test", "id", "0"),
-							resource.TestCheckResourceAttr("akamai_cp_code.test", "name", "test cpcode"),
-							resource.TestCheckResourceAttr("akamai_cp_code.test", "group_id", "grp_1"),
-							resource.TestCheckResourceAttr("akamai_cp_code.test", "contract_id", "ctr_1"),
-							resource.TestCheckResourceAttr("akamai_cp_code.test", "product_id", "prd_1"),
-						),
-					},
 					{
 						Config:      testutils.LoadFixtureString(t, "testdata/TestResCPCode/change_immutable.tf"),
 						ExpectError: regexp.MustCompile(`cp code attribute 'group_id' cannot be changed after creation \(immutable\)`),
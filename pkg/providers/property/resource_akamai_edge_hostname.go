@@ -117,9 +117,9 @@ var akamaiSecureEdgeHostNameSchema = map[string]*schema.Schema{
 		Description: "Email address that should receive updates on the IP behavior update request.",
 	},
 	"certificate": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		ForceNew: true,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The certificate enrollment ID. Changing it replaces the certificate of the edge hostname with a change request.",
 	},
 	"use_cases": {
 		Type:             schema.TypeString,
@@ -520,19 +520,23 @@ func resourceSecureEdgeHostNameUpdate(ctx context.Context, d *schema.ResourceDat
 		})
 	}
 
+	edgeHostnameID, err := strconv.Atoi(strings.TrimPrefix(d.Id(), "ehn_"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	if len(patches) > 0 {
-		edgeHostnameIDString := d.Id()
-		edgeHostnameID, err := strconv.Atoi(strings.TrimPrefix(edgeHostnameIDString, "ehn_"))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
 		diagnostics := patchEdgeHostname(ctx, d, meta, edgeHostnameID, patches)
 		if diagnostics != nil {
 			return diagnostics
 		}
 	}
 
+	if d.HasChange("certificate") {
+		if diagnostics := updateEdgeHostnameCertificate(ctx, d, meta, edgeHostnameID); diagnostics != nil {
+			return diagnostics
+		}
+	}
+
 	return resourceSecureEdgeHostNameRead(ctx, d, m)
 }
 
@@ -608,6 +612,62 @@ Failed to restore previous local schema values. The schema will remain in tainte
 	return nil
 }
 
+// updateEdgeHostnameCertificate replaces the certificate of the edge hostname with the certificate of the configured enrollment
+// and waits until the change request is completed
+func updateEdgeHostnameCertificate(ctx context.Context, d *schema.ResourceData, meta meta.Meta, edgeHostnameID int) diag.Diagnostics {
+	logger := meta.Log("PAPI", "updateEdgeHostnameCertificate")
+
+	certEnrollmentID, err := tf.GetIntValue("certificate", d)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return diag.Errorf("the certificate enrollment ID cannot be removed from an edge hostname")
+		}
+		return diag.FromErr(err)
+	}
+	edgeHostname, err := tf.GetStringValue("edge_hostname", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dnsZone, _ := parseEdgeHostname(edgeHostname)
+	emails, err := tf.GetListValue("status_update_email", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	statusUpdateEmails := make([]string, 0, len(emails))
+	for _, email := range emails {
+		statusUpdateEmails = append(statusUpdateEmails, email.(string))
+	}
+
+	hapiClient := HapiClient(meta)
+	if _, err = waitForHAPIPropagation(ctx, hapiClient, edgeHostnameID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	logger.Debugf("Proceeding to change certificate of %s to enrollment %d", edgeHostname, certEnrollmentID)
+	resp, err := HapiCertificateClient(meta).UpdateCertificate(ctx, UpdateCertificateRequest{
+		DNSZone:           dnsZone,
+		RecordName:        strings.TrimSuffix(edgeHostname, "."+dnsZone),
+		CertEnrollmentID:  certEnrollmentID,
+		StatusUpdateEmail: statusUpdateEmails,
+		Comments:          fmt.Sprintf("change certificate to enrollment %d", certEnrollmentID),
+	})
+	if err != nil {
+		if err2 := tf.RestoreOldValues(d, []string{"certificate"}); err2 != nil {
+			return diag.Errorf(`%s failed. No changes were written to server: 
+%s
+
+Failed to restore previous local schema values. The schema will remain in tainted state:
+%s`, ErrUpdateCertificate, err.Error(), err2.Error())
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = waitForChange(ctx, hapiClient, resp.ChangeID); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
 func waitForHAPIPropagation(ctx context.Context, hapiClient hapi.HAPI, edgeHostnameID int) (*hapi.GetEdgeHostnameResponse, error) {
 	retries := 0
 	for {
@@ -905,8 +965,8 @@ func validateImmutableFields(_ context.Context, diff *schema.ResourceDiff, _ int
 		o := oldValue.(string)
 		n := newValue.(string)
 
-		if diff.HasChange("certificate") || str.AddPrefix(o, "prd_") != str.AddPrefix(n, "prd_") {
-			return fmt.Errorf("error: Changes to non-updatable fields 'product_id' and 'certificate' are not permitted")
+		if str.AddPrefix(o, "prd_") != str.AddPrefix(n, "prd_") {
+			return fmt.Errorf("error: Changes to non-updatable field 'product_id' are not permitted")
 		}
 	}
 	return nil
@@ -26,9 +26,10 @@ func TestResourceEdgeHostname(t *testing.T) {
 	GetEdgeHostnamePollInterval = time.Microsecond
 
 	tests := map[string]struct {
-		init      func(*papi.Mock, *hapi.Mock)
-		withError *regexp.Regexp
-		steps     []resource.TestStep
+		init        func(*papi.Mock, *hapi.Mock)
+		certificate func(*mockHAPICertificate)
+		withError   *regexp.Regexp
+		steps       []resource.TestStep
 	}{
 		"edge hostname with .edgesuite.net, create edge hostname": {
 			init: func(mp *papi.Mock, mh *hapi.Mock) {
@@ -2265,11 +2266,11 @@ func TestResourceEdgeHostname(t *testing.T) {
 				},
 				{
 					Config:      testutils.LoadFixtureStringf(t, "%s/%s", testDir, "new_akamaized_net_different_product_id.tf"),
-					ExpectError: regexp.MustCompile(`Changes to non-updatable fields 'product_id' and 'certificate' are not permitted`),
+					ExpectError: regexp.MustCompile(`Changes to non-updatable field 'product_id' are not permitted`),
 				},
 			},
 		},
-		"error on updating certificate": {
+		"update certificate": {
 			init: func(mp *papi.Mock, mh *hapi.Mock) {
 				// Create
 				mp.On("GetEdgeHostnames", testutils.MockContext, papi.GetEdgeHostnamesRequest{
@@ -2328,7 +2329,7 @@ func TestResourceEdgeHostname(t *testing.T) {
 						IPVersionBehavior: "IPV6_PERFORMANCE",
 					},
 				}, nil).Once()
-				// Read + refresh
+				// Read + refresh, in both steps
 				mp.On("GetEdgeHostname", testutils.MockContext, papi.GetEdgeHostnameRequest{
 					EdgeHostnameID: "ehn_456",
 					ContractID:     "ctr_2",
@@ -2344,23 +2345,42 @@ func TestResourceEdgeHostname(t *testing.T) {
 						Domain:            "test.edgekey.net",
 						IPVersionBehavior: "IPV6_PERFORMANCE",
 					},
-				}, nil).Times(3)
+				}, nil)
 
 				mockData := createEdgeHostnameMockDataBuilder(456).
 					withDNSZone("edgekey.net").
 					withRecordName("test").build()
+				// Update
+				mockData.mockGetEdgeHostname(mh)
+				mockData.mockGetChangeStatus(mh, changeRequestStatusSucceeded)
 				// Delete
 				mockData.mockGetEdgeHostname(mh)
 				mockData.mockDeleteEdgeHostname(mh)
 				mockData.mockGetChangeStatus(mh, changeRequestStatusSucceeded)
 			},
+			certificate: func(mc *mockHAPICertificate) {
+				mc.On("UpdateCertificate", testutils.MockContext, UpdateCertificateRequest{
+					DNSZone:           "edgekey.net",
+					RecordName:        "test",
+					CertEnrollmentID:  800800,
+					StatusUpdateEmail: []string{},
+					Comments:          "change certificate to enrollment 800800",
+				}).Return(&hapi.UpdateEdgeHostnameResponse{
+					Action:   "EDIT",
+					ChangeID: 3456,
+					Status:   "PENDING",
+				}, nil).Once()
+			},
 			steps: []resource.TestStep{
 				{
 					Config: testutils.LoadFixtureStringf(t, "%s/%s", testDir, "new_edgekey_net.tf"),
 				},
 				{
-					Config:      testutils.LoadFixtureStringf(t, "%s/%s", testDir, "new_edgekey_net_different_certificate.tf"),
-					ExpectError: regexp.MustCompile(`Changes to non-updatable fields 'product_id' and 'certificate' are not permitted`),
+					Config: testutils.LoadFixtureStringf(t, "%s/%s", testDir, "new_edgekey_net_different_certificate.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "id", "ehn_456"),
+						resource.TestCheckResourceAttr("akamai_edge_hostname.edgehostname", "certificate", "800800"),
+					),
 				},
 			},
 		},
@@ -3281,17 +3301,24 @@ func TestResourceEdgeHostname(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			client := &papi.Mock{}
 			clientHapi := &hapi.Mock{}
+			clientCertificate := &mockHAPICertificate{}
 			if test.init != nil {
 				test.init(client, clientHapi)
 			}
+			if test.certificate != nil {
+				test.certificate(clientCertificate)
+			}
 			useClient(client, clientHapi, func() {
-				resource.UnitTest(t, resource.TestCase{
-					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
-					Steps:                    test.steps,
+				useHapiCertificate(clientCertificate, func() {
+					resource.UnitTest(t, resource.TestCase{
+						ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+						Steps:                    test.steps,
+					})
 				})
 			})
 			client.AssertExpectations(t)
 			clientHapi.AssertExpectations(t)
+			clientCertificate.AssertExpectations(t)
 		})
 	}
 }
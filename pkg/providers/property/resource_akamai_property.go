@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/providers/property/ruleformats"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -57,13 +59,28 @@ func resourceProperty() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			hostNamesCustomDiff,
 			propertyRulesCustomDiff,
+			setRuleFragmentsDiff,
+			validatePropertyRulesFormat,
+			propertyDriftCustomDiff,
 			setPropertyVersionsComputed,
+			clearRulesChangeSummary,
 			ensureHostnamesSingleDefinition,
 			ensureCCMCertificatesConsistency,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePropertyImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"property_id": {
+						Type:              schema.TypeString,
+						RequiredForImport: true,
+						Description:       "Identifier of the property",
+					},
+				}
+			},
+		},
 		StateUpgraders: []schema.StateUpgrader{{
 			Version: 0,
 			Type:    resourcePropertyV0().CoreConfigSchema().ImpliedType(),
@@ -217,6 +234,25 @@ func resourceProperty() *schema.Resource {
 				Computed:    true,
 				Description: "Required property's version to be read",
 			},
+			"managed_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Property's version last written by Terraform. Newer latest, staging or production versions " +
+					"were changed outside of Terraform and are reported as drift",
+			},
+			"drift": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     propertyDriftSchema,
+				Description: "Versions of the property newer than managed_version, which are going to be overwritten " +
+					"by the next update of the property. They are also reported as warnings when the property is refreshed",
+			},
+			"fail_on_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Fails the plan when the property is going to be updated while there is drift, " +
+					"instead of overwriting the changes made outside of Terraform",
+			},
 			"rule_errors": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -233,6 +269,35 @@ func resourceProperty() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the property in the Identity and Access Management API.",
 			},
+			"rules_change_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Changes of the rule tree made by the last update of the property, one entry per added, removed or modified " +
+					"rule, behavior, criterion or variable, prefixed with the rule path, e.g. " +
+					"`default/Performance/Compression: behavior gzipResponse.behavior ALWAYS -> ORIGIN_RESPONSE`. " +
+					"Updates which do not change the rules clear it.",
+			},
+			"rule_fragments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Fragments built by `akamai_property_rules_builder` data sources, which are used by the rules " +
+					"of the property, with their versions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the fragment.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the fragment.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -311,7 +376,12 @@ func getCCMHashPart(hostname map[string]any) string {
 // propertyRulesCustomDiff compares Rules.Criteria and Rules.Children fields from terraform state
 // and from a new configuration. If some of these fields are empty lists in the new configuration and
 // are nil in the terraform state, then this function returns no difference for these fields.
-func propertyRulesCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+// When the rules change, rules_change_summary describes the changes of the rule tree.
+func propertyRulesCustomDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() != "" && diff.HasChange("rules") && !diff.NewValueKnown("rules") {
+		return diff.SetNewComputed("rules_change_summary")
+	}
+
 	o, n := diff.GetChange("rules")
 	oldValue, newValue := o.(string), n.(string)
 
@@ -345,7 +415,8 @@ func propertyRulesCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ int
 	}
 
 	normalizeFields(&oldRulesUpdate, &newRulesUpdate)
-	if rulesEqual(&oldRulesUpdate.Rules, &newRulesUpdate.Rules) && oldRulesUpdate.Comments == newRulesUpdate.Comments {
+	rulesTreeEqual := rulesEqual(&oldRulesUpdate.Rules, &newRulesUpdate.Rules)
+	if rulesTreeEqual && oldRulesUpdate.Comments == newRulesUpdate.Comments {
 		return nil
 	}
 
@@ -362,9 +433,99 @@ func propertyRulesCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ int
 	if err = diff.SetNew("rules", string(rules)); err != nil {
 		return fmt.Errorf("cannot set a new diff value for 'rules' %s", err)
 	}
+
+	summary, err := rulesChangeSummary(oldValue, string(rules))
+	if err != nil {
+		return err
+	}
+	// SDK does not support warnings in plan, the changes are reported in the log and in rules_change_summary
+	logger := meta.Must(m).Log("PAPI", "propertyRulesCustomDiff")
+	for _, change := range summary {
+		logger.Warnf("rules of property %s change: %s", diff.Id(), change)
+	}
+	if err = diff.SetNew("rules_change_summary", summary); err != nil {
+		return fmt.Errorf("cannot set a new diff value for 'rules_change_summary' %s", err)
+	}
 	return nil
 }
 
+// validatePropertyRulesFormat validates changed rules against the frozen rule format, so that errors
+// in behaviors and options are reported at plan time rather than by PAPI. Rules using the latest
+// or a rule format not known to the provider are left to be validated by PAPI.
+func validatePropertyRulesFormat(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChanges("rules", "rule_format") || !diff.NewValueKnown("rules") || !diff.NewValueKnown("rule_format") {
+		return nil
+	}
+	rulesJSON, ruleFormat := diff.Get("rules").(string), diff.Get("rule_format").(string)
+	if rulesJSON == "" || ruleFormat == "" || ruleFormat == "latest" {
+		return nil
+	}
+
+	var rules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+		return fmt.Errorf("cannot parse rules JSON from config: %s", err)
+	}
+	ruleErrors, err := ruleformats.ValidateRules(ruleFormat, rules.Rules)
+	if err != nil {
+		if errors.Is(err, ruleformats.ErrUnknownRuleFormat) {
+			return nil
+		}
+		return err
+	}
+	if len(ruleErrors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(ruleErrors))
+	for _, e := range ruleErrors {
+		messages = append(messages, e.Error())
+	}
+	return fmt.Errorf("rules are not valid for rule format %s:\n%s", ruleFormat, strings.Join(messages, "\n"))
+}
+
+// setRuleFragmentsDiff plans rule_fragments for the fragments listed in the configured rules.
+func setRuleFragmentsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	fragments, known, err := ruleFragments(diff.GetRawConfig())
+	if err != nil {
+		return err
+	}
+	if !known {
+		return diff.SetNewComputed("rule_fragments")
+	}
+	if reflect.DeepEqual(diff.Get("rule_fragments"), fragments) {
+		return nil
+	}
+	return diff.SetNew("rule_fragments", fragments)
+}
+
+// ruleFragments returns the fragments listed in the configured rules by akamai_property_rules_builder,
+// or false if the rules are not known yet.
+func ruleFragments(config cty.Value) ([]interface{}, bool, error) {
+	if config.IsNull() || !config.IsKnown() {
+		return nil, false, nil
+	}
+	rules := config.GetAttr("rules")
+	if !rules.IsKnown() {
+		return nil, false, nil
+	}
+	fragments := make([]interface{}, 0)
+	if rules.IsNull() {
+		return fragments, true, nil
+	}
+
+	var rulesUpdate ruleformats.RulesUpdate
+	if err := json.Unmarshal([]byte(rules.AsString()), &rulesUpdate); err != nil {
+		return nil, false, fmt.Errorf("cannot parse rules JSON from config: %s", err)
+	}
+	for _, fragment := range rulesUpdate.Fragments {
+		fragments = append(fragments, map[string]interface{}{
+			"name":    fragment.Name,
+			"version": fragment.Version,
+		})
+	}
+	return fragments, true, nil
+}
+
 // unifyRulesDiff is invoked on first planning for property creation
 // Its main purpose is to unify the rules JSON with what we expect will be created by PAPI
 // It is used in order to prevent diffs on output on subsequent terraform applies
@@ -474,6 +635,21 @@ func setPropertyVersionsComputed(_ context.Context, rd *schema.ResourceDiff, _ i
 	return nil
 }
 
+// clearRulesChangeSummary clears rules_change_summary of the previous update, when the property is updated
+// without changing the rules.
+func clearRulesChangeSummary(_ context.Context, rd *schema.ResourceDiff, _ interface{}) error {
+	if rd.Id() == "" || rd.HasChange("rules") || len(rd.Get("rules_change_summary").([]interface{})) == 0 {
+		return nil
+	}
+	if len(rd.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+	if err := rd.SetNew("rules_change_summary", []string{}); err != nil {
+		return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
+	}
+	return nil
+}
+
 func ensureHostnamesSingleDefinition(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
 	useHostnameBucket := d.Get("use_hostname_bucket").(bool)
 	h := d.Get("hostnames")
@@ -629,6 +805,12 @@ func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m inter
 			return diag.FromErr(err)
 		}
 	}
+	if err := setRuleFragments(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("managed_version", property.LatestVersion); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+	}
 
 	return resourcePropertyRead(ctx, d, m)
 }
@@ -668,6 +850,10 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 	groupID := str.AddPrefix(d.Get("group_id").(string), "grp_")
 	readVersionID := d.Get("read_version").(int)
 
+	if err := tf.SetIdentity(d, map[string]any{"property_id": propertyID}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var property *papi.Property
 	var err error
 	var v int
@@ -744,6 +930,21 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("received rules that could not be rendered to JSON: %s", err)
 	}
 
+	managedVersion := d.Get("managed_version").(int)
+	if managedVersion == 0 {
+		// the property was imported or its state was written by a provider without drift detection
+		managedVersion = property.LatestVersion
+	}
+	drift, err := detectPropertyDrift(ctx, client, *property, managedVersion, propertyVersionDetails{version: res.Version, rules: rules})
+	if err != nil {
+		return diag.Errorf("could not detect drift of property: %s", err)
+	}
+	var diags diag.Diagnostics
+	for _, p := range drift {
+		logger.Warnf("property %s version %d was changed outside of Terraform by %s", propertyID, p.version, p.updatedByUser)
+		diags = append(diags, p.diagnostic(propertyID, managedVersion))
+	}
+
 	attrs := map[string]interface{}{
 		"asset_id":            property.AssetID,
 		"name":                property.PropertyName,
@@ -759,15 +960,25 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		"rule_errors":         papiErrorsToList(ruleErrors),
 		"read_version":        readVersionID,
 		"version_notes":       res.Version.Note,
+		"managed_version":     managedVersion,
+		"drift":               flattenPropertyDrift(drift),
 	}
 	if res.Version.ProductID != "" {
 		attrs["product_id"] = res.Version.ProductID
 	}
+	// rules_change_summary is only changed by updates, but it has to be set to not be planned as unknown
+	if len(d.Get("rules_change_summary").([]interface{})) == 0 {
+		attrs["rules_change_summary"] = nil
+	}
+	// rule_fragments are only known from the configuration
+	if len(d.Get("rule_fragments").([]interface{})) == 0 {
+		attrs["rule_fragments"] = nil
+	}
 	if err := tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -905,11 +1116,46 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		if err := updateRuleTree(ctx, client, property, d); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := setRulesChangeSummary(d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := setRuleFragments(d); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("managed_version", property.LatestVersion); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
 	}
 
 	return resourcePropertyRead(ctx, d, m)
 }
 
+// setRulesChangeSummary sets rules_change_summary for the applied rules. It is needed when
+// the rules were not known at plan time, otherwise it confirms the summary from the plan.
+func setRulesChangeSummary(d *schema.ResourceData) error {
+	if !d.HasChange("rules") {
+		return nil
+	}
+	o, n := d.GetChange("rules")
+	if o.(string) == "" || n.(string) == "" {
+		return nil
+	}
+	summary, err := rulesChangeSummary(o.(string), n.(string))
+	if err != nil {
+		return err
+	}
+	return d.Set("rules_change_summary", summary)
+}
+
+// setRuleFragments sets rule_fragments for the applied rules.
+func setRuleFragments(d *schema.ResourceData) error {
+	fragments, known, err := ruleFragments(d.GetRawConfig())
+	if err != nil || !known {
+		return err
+	}
+	return d.Set("rule_fragments", fragments)
+}
+
 func updateRuleTree(ctx context.Context, client papi.PAPI, property papi.Property,
 	d *schema.ResourceData) error {
 	ruleFormat, err := tf.GetStringValue("rule_format", d)
@@ -974,6 +1220,10 @@ func resourcePropertyDelete(ctx context.Context, d *schema.ResourceData, m inter
 func resourcePropertyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	ctx = log.NewContext(ctx, meta.Must(m).Log("PAPI", "resourcePropertyImport"))
 
+	if err := tf.ImportIDFromIdentity(d, "property_id"); err != nil {
+		return nil, err
+	}
+
 	// User-supplied import ID is a comma-separated list of propertyID[,groupID[,contractID]]
 	// contractID and groupID are optional as long as the propertyID is sufficient to fetch the property
 	var propertyID, groupID, contractID, version string
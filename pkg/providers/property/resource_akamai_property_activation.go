@@ -142,6 +142,20 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 		Description: "Provides an audit record when activating on a production network.",
 		Elem:        complianceRecordSchema,
 	},
+	"rollback": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Reactivates the version, which was active on the network before, when the activation fails " +
+			"or the health check fails. Activations which time out are not rolled back.",
+		Elem: rollbackSchema,
+	},
+	"rollback_result": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Details of the rollback performed by the last activation, if any.",
+		Elem:        rollbackResultSchema,
+	},
 	"timeouts": {
 		Type:        schema.TypeList,
 		Optional:    true,
@@ -231,6 +245,11 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	rollback, err := getActivationRollback(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	activation, err := lookupActivation(ctx, client, lookupActivationRequest{
 		propertyID: propertyID,
 		network:    network,
@@ -271,8 +290,15 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 			},
 		}
 
+		createActivationRequest = addPropertyComplianceRecord(complianceRecord, createActivationRequest)
+		if rollback != nil {
+			if err := rollback.prepare(ctx, client, createActivationRequest); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
 		logger.Debug("creating activation")
-		activationID, diagErr := createActivation(ctx, client, addPropertyComplianceRecord(complianceRecord, createActivationRequest))
+		activationID, diagErr := createActivation(ctx, client, createActivationRequest)
 		if diagErr != nil {
 			return diagErr
 		}
@@ -293,15 +319,15 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	activation, diagErr := pollActivation(ctx, client, activation, propertyID)
-	if diagErr != nil {
-		return diagErr
+	activation, diags = awaitActivation(ctx, client, d, activation, propertyID, rollback, logger)
+	if diags.HasError() {
+		return diags
 	}
 
+	// the configured version is kept in state also when it was rolled back; rollback_result holds the active version
 	attrs := map[string]interface{}{
 		"status":        string(activation.Status),
 		"activation_id": activation.ActivationID,
-		"version":       version,
 	}
 	if err := tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
@@ -309,7 +335,7 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(propertyID + ":" + string(network))
 
-	return nil
+	return diags
 }
 
 func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -342,6 +368,12 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	// the version reactivated by the rollback is the one to deactivate
+	if reactivatedVersion, rolledBack, err := rolledBackVersion(d); err != nil {
+		return diag.FromErr(err)
+	} else if rolledBack {
+		version = reactivatedVersion
+	}
 
 	complianceRecord, err := tf.GetListValue("compliance_record", d)
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
@@ -508,12 +540,18 @@ func resourcePropertyActivationRead(ctx context.Context, d *schema.ResourceData,
 
 	attrs := map[string]interface{}{
 		"status":        string(activation.Status),
-		"version":       activation.PropertyVersion,
 		"network":       network,
 		"activation_id": activation.ActivationID,
 		"note":          activation.Note,
 		"contact":       activation.NotifyEmails,
 	}
+	reactivatedVersion, rolledBack, err := rolledBackVersion(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !rolledBack || reactivatedVersion != activation.PropertyVersion {
+		attrs["version"] = activation.PropertyVersion
+	}
 
 	if err = tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
@@ -581,8 +619,8 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		session.WithContextLog(logger),
 	)
 
-	if !d.HasChangesExcept("timeouts", "compliance_record") {
-		logger.Debug("Only timeouts and/or compliance_record were updated, update with no API calls")
+	if !d.HasChangesExcept("timeouts", "compliance_record", "rollback") {
+		logger.Debug("Only timeouts, compliance_record and/or rollback were updated, update with no API calls")
 		return nil
 	}
 
@@ -609,6 +647,11 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	rollback, err := getActivationRollback(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Schema guarantees these types
 	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
 
@@ -686,7 +729,14 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 			},
 		}
 
-		activationID, diagErr := createActivation(ctx, client, addPropertyComplianceRecord(complianceRecord, createActivationRequest))
+		createActivationRequest = addPropertyComplianceRecord(complianceRecord, createActivationRequest)
+		if rollback != nil {
+			if err := rollback.prepare(ctx, client, createActivationRequest); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		activationID, diagErr := createActivation(ctx, client, createActivationRequest)
 		if diagErr != nil {
 			return diagErr
 		}
@@ -707,15 +757,15 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	propertyActivation, diagErr := pollActivation(ctx, client, propertyActivation, propertyID)
-	if diagErr != nil {
-		return diagErr
+	propertyActivation, diags = awaitActivation(ctx, client, d, propertyActivation, propertyID, rollback, logger)
+	if diags.HasError() {
+		return diags
 	}
 
+	// the configured version is kept in state also when it was rolled back; rollback_result holds the active version
 	attrs := map[string]interface{}{
 		"status":        string(propertyActivation.Status),
 		"activation_id": propertyActivation.ActivationID,
-		"version":       version,
 	}
 	if err := tf.SetAttrs(d, attrs); err != nil {
 		return diag.FromErr(err)
@@ -723,7 +773,7 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(propertyID + ":" + string(network))
 
-	return nil
+	return diags
 }
 
 func resourcePropertyActivationImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -954,6 +1004,7 @@ func networkAlias(d *schema.ResourceData) (papi.ActivationNetwork, error) {
 	return papi.ActivationNetwork(alias), nil
 }
 
+// pollActivation waits until the activation is active. If the activation is aborted or fails, it is returned along with the error.
 func pollActivation(ctx context.Context, client papi.PAPI, activation *papi.Activation, propertyID string) (*papi.Activation, diag.Diagnostics) {
 
 	retriesMax := 5
@@ -961,10 +1012,10 @@ func pollActivation(ctx context.Context, client papi.PAPI, activation *papi.Acti
 
 	for activation.Status != papi.ActivationStatusActive {
 		if activation.Status == papi.ActivationStatusAborted {
-			return nil, diag.FromErr(fmt.Errorf("activation request aborted"))
+			return activation, diag.FromErr(fmt.Errorf("activation request aborted"))
 		}
 		if activation.Status == papi.ActivationStatusFailed {
-			return nil, diag.FromErr(fmt.Errorf("activation request failed in downstream system"))
+			return activation, diag.FromErr(fmt.Errorf("activation request failed in downstream system"))
 		}
 		select {
 		case <-time.After(tf.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
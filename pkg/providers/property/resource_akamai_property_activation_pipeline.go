@@ -0,0 +1,483 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/timeouts"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+)
+
+type (
+	// activationPipeline activates a property version on staging, verifies it and then activates it on production.
+	activationPipeline struct {
+		propertyID              string
+		version                 int
+		contacts                []string
+		note                    string
+		acknowledgeRuleWarnings bool
+		complianceRecord        []interface{}
+		stagingHost             string
+		verifications           []pipelineVerification
+		attempts                int
+		interval                time.Duration
+		timeout                 time.Duration
+	}
+
+	// pipelineVerification is a request sent to the staging network, whose response is checked before activating on production.
+	pipelineVerification struct {
+		url                 string
+		expectedStatusCodes map[int]struct{}
+		expectedHeaders     map[string]string
+	}
+)
+
+func resourcePropertyActivationPipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyActivationPipelineCreate,
+		ReadContext:   resourcePropertyActivationPipelineRead,
+		UpdateContext: resourcePropertyActivationPipelineUpdate,
+		DeleteContext: resourcePropertyActivationPipelineDelete,
+		Schema:        akamaiPropertyActivationPipelineSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Default: &PropertyResourceTimeout,
+		},
+	}
+}
+
+var akamaiPropertyActivationPipelineSchema = map[string]*schema.Schema{
+	"property_id": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		StateFunc:   addPrefixToState("prp_"),
+		Description: "Your property's ID, including the prp_ prefix.",
+	},
+	"version": {
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Your property's version number, activated on staging and then on production.",
+	},
+	"contact": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "One or more email addresses to which to send activation status changes.",
+	},
+	"note": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Assigns a log message to the activation requests.",
+	},
+	"auto_acknowledge_rule_warnings": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Automatically acknowledge all rule warnings for activations to continue. Default is false",
+	},
+	"compliance_record": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Provides an audit record for the activation on the production network.",
+		Elem:        complianceRecordSchema,
+	},
+	"staging_host": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Host to which the verification requests are sent instead of the hosts in their URLs, " +
+			"e.g. the staging edge hostname 'www.example.com.edgekey-staging.net'. The hosts in the URLs are still used " +
+			"as Host headers and for TLS. Required with verification, as the hosts in the URLs resolve to the production network.",
+	},
+	"verification": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		RequiredWith: []string{"staging_host"},
+		Description:  "Requests sent to the staging network once the version is active on it. The version is activated on production only if all responses are as expected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The requested URL.",
+				},
+				"expected_status_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+					Description: "Expected status codes of the response. Any 2xx or 3xx status code is expected by default.",
+				},
+				"expected_headers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Expected values of headers of the response by header name.",
+				},
+			},
+		},
+	},
+	"verification_attempts": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      3,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Number of requests sent for a verification until the response is as expected. Default is 3.",
+	},
+	"verification_interval": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "10s",
+		ValidateDiagFunc: timeouts.ValidateDurationFormat,
+		Description:      "Duration between the attempts of a verification, e.g. '30s'. Default is '10s'.",
+	},
+	"verification_timeout": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          "10s",
+		ValidateDiagFunc: timeouts.ValidateDurationFormat,
+		Description:      "Timeout of a single verification request, e.g. '5s'. Default is '10s'.",
+	},
+	"staging_activation_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the activation on the staging network.",
+	},
+	"staging_status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The property version's activation status on the staging network.",
+	},
+	"production_activation_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the activation on the production network.",
+	},
+	"production_status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The property version's activation status on the production network.",
+	},
+}
+
+func resourcePropertyActivationPipelineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationPipelineCreate")
+	client := Client(meta)
+
+	logger.Debug("resourcePropertyActivationPipelineCreate call")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	pipeline, err := getActivationPipeline(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the resource is stored in state only once the version is active on production,
+	// so that a failed pipeline is resumed on the next apply
+	if diags := pipeline.run(ctx, client, d, logger); diags.HasError() {
+		return diags
+	}
+	d.SetId(pipeline.propertyID)
+
+	return resourcePropertyActivationPipelineRead(ctx, d, m)
+}
+
+func resourcePropertyActivationPipelineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationPipelineRead")
+	client := Client(meta)
+
+	logger.Debug("resourcePropertyActivationPipelineRead call")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{
+		PropertyID: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get activations for property: %w", err))
+	}
+
+	production, err := findLatestActive(resp.Activations.Items, papi.ActivationNetworkProduction)
+	if errors.Is(err, errNoActiveVersionFound) {
+		logger.Warnf("property %s is not active on production, removing the pipeline from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("unexpected error searching for latest activation: %s", err)
+	}
+
+	attrs := map[string]interface{}{
+		"property_id":              production.PropertyID,
+		"version":                  production.PropertyVersion,
+		"production_activation_id": production.ActivationID,
+		"production_status":        string(production.Status),
+		"staging_activation_id":    "",
+		"staging_status":           "",
+	}
+	staging, err := findLatestActive(resp.Activations.Items, papi.ActivationNetworkStaging)
+	if err != nil && !errors.Is(err, errNoActiveVersionFound) {
+		return diag.Errorf("unexpected error searching for latest activation: %s", err)
+	}
+	if staging != nil {
+		attrs["staging_activation_id"] = staging.ActivationID
+		attrs["staging_status"] = string(staging.Status)
+	}
+	if err := tf.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePropertyActivationPipelineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationPipelineUpdate")
+	client := Client(meta)
+
+	logger.Debug("resourcePropertyActivationPipelineUpdate call")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	if !d.HasChange("version") {
+		logger.Debug("version was not updated, update with no API calls")
+		return nil
+	}
+
+	pipeline, err := getActivationPipeline(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := pipeline.run(ctx, client, d, logger); diags.HasError() {
+		// keep the previous version in state, so that the pipeline is resumed on the next apply
+		d.Partial(true)
+		return diags
+	}
+
+	return resourcePropertyActivationPipelineRead(ctx, d, m)
+}
+
+func resourcePropertyActivationPipelineDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := meta.Must(m)
+	logger := meta.Log("PAPI", "resourcePropertyActivationPipelineDelete")
+	client := Client(meta)
+
+	logger.Debug("resourcePropertyActivationPipelineDelete call")
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	pipeline, err := getActivationPipeline(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{
+		PropertyID: pipeline.propertyID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to get activations for property: %w", err))
+	}
+
+	for _, network := range []papi.ActivationNetwork{papi.ActivationNetworkProduction, papi.ActivationNetworkStaging} {
+		active, err := findLatestActive(resp.Activations.Items, network)
+		if err != nil && !errors.Is(err, errNoActiveVersionFound) {
+			return diag.Errorf("unexpected error searching for latest activation: %s", err)
+		}
+		// a different version may have been activated on the network since
+		if active == nil || active.PropertyVersion != pipeline.version {
+			logger.Debugf("version %d is not active on %s, skipping deactivation", pipeline.version, network)
+			continue
+		}
+
+		logger.Debugf("deactivating version %d on %s", pipeline.version, network)
+		if _, diags := activatePropertyVersion(ctx, client, pipeline.request(papi.ActivationTypeDeactivate, network)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
+
+func getActivationPipeline(d *schema.ResourceData) (*activationPipeline, error) {
+	propertyID, err := resolvePropertyID(d)
+	if err != nil {
+		return nil, err
+	}
+	pipeline := &activationPipeline{
+		propertyID:              propertyID,
+		version:                 d.Get("version").(int),
+		note:                    d.Get("note").(string),
+		acknowledgeRuleWarnings: d.Get("auto_acknowledge_rule_warnings").(bool),
+		complianceRecord:        d.Get("compliance_record").([]interface{}),
+		stagingHost:             d.Get("staging_host").(string),
+		attempts:                d.Get("verification_attempts").(int),
+	}
+	for _, contact := range d.Get("contact").(*schema.Set).List() {
+		pipeline.contacts = append(pipeline.contacts, cast.ToString(contact))
+	}
+	if pipeline.interval, err = time.ParseDuration(d.Get("verification_interval").(string)); err != nil {
+		return nil, fmt.Errorf("invalid verification interval: %w", err)
+	}
+	if pipeline.timeout, err = time.ParseDuration(d.Get("verification_timeout").(string)); err != nil {
+		return nil, fmt.Errorf("invalid verification timeout: %w", err)
+	}
+
+	for _, val := range d.Get("verification").([]interface{}) {
+		verificationMap, ok := val.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		verification := pipelineVerification{
+			url:                 cast.ToString(verificationMap["url"]),
+			expectedStatusCodes: map[int]struct{}{},
+			expectedHeaders:     map[string]string{},
+		}
+		for _, code := range verificationMap["expected_status_codes"].(*schema.Set).List() {
+			verification.expectedStatusCodes[cast.ToInt(code)] = struct{}{}
+		}
+		for name, value := range verificationMap["expected_headers"].(map[string]interface{}) {
+			verification.expectedHeaders[name] = cast.ToString(value)
+		}
+		pipeline.verifications = append(pipeline.verifications, verification)
+	}
+
+	return pipeline, nil
+}
+
+// run activates the version on staging, verifies it and activates it on production.
+func (p *activationPipeline) run(ctx context.Context, client papi.PAPI, d *schema.ResourceData, logger log.Interface) diag.Diagnostics {
+	logger.Debugf("activating version %d on %s", p.version, papi.ActivationNetworkStaging)
+	staging, diags := activatePropertyVersion(ctx, client, p.request(papi.ActivationTypeActivate, papi.ActivationNetworkStaging))
+	if diags.HasError() {
+		return diags
+	}
+	if err := tf.SetAttrs(d, map[string]interface{}{
+		"staging_activation_id": staging.ActivationID,
+		"staging_status":        string(staging.Status),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := p.verify(ctx, logger); err != nil {
+		return diag.Errorf("verification of version %d on %s failed, the version was not activated on %s: %s",
+			p.version, papi.ActivationNetworkStaging, papi.ActivationNetworkProduction, err)
+	}
+
+	logger.Debugf("activating version %d on %s", p.version, papi.ActivationNetworkProduction)
+	production, diags := activatePropertyVersion(ctx, client, p.request(papi.ActivationTypeActivate, papi.ActivationNetworkProduction))
+	if diags.HasError() {
+		return diags
+	}
+	if err := tf.SetAttrs(d, map[string]interface{}{
+		"production_activation_id": production.ActivationID,
+		"production_status":        string(production.Status),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func (p *activationPipeline) request(activationType papi.ActivationType, network papi.ActivationNetwork) papi.CreateActivationRequest {
+	request := papi.CreateActivationRequest{
+		PropertyID: p.propertyID,
+		Activation: papi.Activation{
+			ActivationType:         activationType,
+			Network:                network,
+			PropertyVersion:        p.version,
+			NotifyEmails:           p.contacts,
+			AcknowledgeAllWarnings: p.acknowledgeRuleWarnings,
+			Note:                   p.note,
+		},
+	}
+	if network == papi.ActivationNetworkProduction {
+		request = addPropertyComplianceRecord(p.complianceRecord, request)
+	}
+	return request
+}
+
+// activatePropertyVersion submits the activation request, unless the same activation is already pending or active,
+// and waits for it.
+func activatePropertyVersion(ctx context.Context, client papi.PAPI, request papi.CreateActivationRequest) (*papi.Activation, diag.Diagnostics) {
+	activation, err := lookupActivation(ctx, client, lookupActivationRequest{
+		propertyID: request.PropertyID,
+		version:    request.Activation.PropertyVersion,
+		network:    request.Activation.Network,
+		activationType: map[papi.ActivationType]struct{}{
+			request.Activation.ActivationType: {},
+		},
+	})
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if activation == nil {
+		activationID, diags := createActivation(ctx, client, request)
+		if diags.HasError() {
+			return nil, diags
+		}
+		act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+			ActivationID: activationID,
+			PropertyID:   request.PropertyID,
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		activation = act.Activation
+	}
+
+	return pollActivation(ctx, client, activation, request.PropertyID)
+}
+
+// verify sends the verification requests to the staging network and checks their responses.
+func (p *activationPipeline) verify(ctx context.Context, logger log.Interface) error {
+	client := newProbeClient(p.timeout, func(address string) string {
+		_, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return address
+		}
+		return net.JoinHostPort(p.stagingHost, port)
+	})
+	for _, verification := range p.verifications {
+		err := retryProbe(ctx, p.attempts, p.interval, logger, verification.url, func() error {
+			return probe(ctx, client, verification.url, verification.check)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", verification.url, err)
+		}
+	}
+	return nil
+}
+
+func (v pipelineVerification) check(resp *http.Response) error {
+	if err := checkStatusCode(resp, v.expectedStatusCodes); err != nil {
+		return err
+	}
+	for name, expected := range v.expectedHeaders {
+		if value := resp.Header.Get(name); value != expected {
+			return fmt.Errorf("unexpected value of header %s: want '%s', got '%s'", name, expected, value)
+		}
+	}
+	return nil
+}
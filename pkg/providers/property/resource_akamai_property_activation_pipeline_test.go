@@ -0,0 +1,179 @@
+package property
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResourcePropertyActivationPipeline(t *testing.T) {
+	contacts := []string{"user@example.com"}
+	note := "pipeline note"
+	// version of the property served by the staging network
+	var stagingVersion string
+
+	expectPipelineActivation := func(m *papi.Mock, activationType papi.ActivationType, version int, network papi.ActivationNetwork, activationID string) {
+		request := papi.CreateActivationRequest{
+			PropertyID: "prp_test",
+			Activation: papi.Activation{
+				ActivationType:         activationType,
+				Network:                network,
+				PropertyVersion:        version,
+				NotifyEmails:           contacts,
+				AcknowledgeAllWarnings: true,
+				Note:                   note,
+			},
+		}
+		if network == papi.ActivationNetworkProduction {
+			request.Activation.ComplianceRecord = &papi.ComplianceRecordNone{
+				CustomerEmail:  "user@example.com",
+				PeerReviewedBy: "user1@example.com",
+				UnitTested:     true,
+			}
+		}
+		m.On("CreateActivation", testutils.MockContext, request).
+			Return(&papi.CreateActivationResponse{ActivationID: activationID}, nil).
+			Run(func(_ mock.Arguments) {
+				if network == papi.ActivationNetworkStaging && activationType == papi.ActivationTypeActivate {
+					stagingVersion = strconv.Itoa(version)
+				}
+			}).Once()
+		expectGetActivation(m, "prp_test", activationID, version, network, papi.ActivationStatusActive,
+			activationType, note, contacts, nil).Once()
+	}
+	activations := func(items ...*papi.Activation) papi.GetActivationsResponse {
+		return papi.GetActivationsResponse{Activations: papi.ActivationsItems{Items: items}}
+	}
+	activation := func(activationID string, version int, network papi.ActivationNetwork, date string) *papi.Activation {
+		item := generateActivationItemMock(activationID, note, version, papi.ActivationTypeActivate, date, contacts)
+		item.Network = network
+		return item
+	}
+	stagingVersion1 := activation("atv_s1", 1, papi.ActivationNetworkStaging, "2025-10-28T14:00:00Z")
+	productionVersion1 := activation("atv_p1", 1, papi.ActivationNetworkProduction, "2025-10-28T15:00:00Z")
+	stagingVersion2 := activation("atv_s2", 2, papi.ActivationNetworkStaging, "2025-10-29T14:00:00Z")
+	productionVersion2 := activation("atv_p2", 2, papi.ActivationNetworkProduction, "2025-10-29T15:00:00Z")
+
+	tests := map[string]struct {
+		init func(*papi.Mock)
+		// staleStaging makes the staging network serve a version other than the activated one
+		staleStaging bool
+		steps        func(port string) []resource.TestStep
+	}{
+		"activation on staging and production with verification": {
+			init: func(m *papi.Mock) {
+				// create
+				expectGetActivations(m, "prp_test", activations(), nil).Once()
+				expectPipelineActivation(m, papi.ActivationTypeActivate, 1, papi.ActivationNetworkStaging, "atv_s1")
+				expectGetActivations(m, "prp_test", activations(stagingVersion1), nil).Once()
+				expectPipelineActivation(m, papi.ActivationTypeActivate, 1, papi.ActivationNetworkProduction, "atv_p1")
+				// read x3
+				expectGetActivations(m, "prp_test", activations(stagingVersion1, productionVersion1), nil).Times(3)
+				// update
+				expectGetActivations(m, "prp_test", activations(stagingVersion1, productionVersion1), nil).Once()
+				expectPipelineActivation(m, papi.ActivationTypeActivate, 2, papi.ActivationNetworkStaging, "atv_s2")
+				expectGetActivations(m, "prp_test", activations(stagingVersion1, productionVersion1, stagingVersion2), nil).Once()
+				expectPipelineActivation(m, papi.ActivationTypeActivate, 2, papi.ActivationNetworkProduction, "atv_p2")
+				// read x2
+				allActive := activations(stagingVersion1, productionVersion1, stagingVersion2, productionVersion2)
+				expectGetActivations(m, "prp_test", allActive, nil).Twice()
+				// delete, deactivating version 2 on both networks
+				expectGetActivations(m, "prp_test", allActive, nil).Twice()
+				expectPipelineActivation(m, papi.ActivationTypeDeactivate, 2, papi.ActivationNetworkProduction, "atv_deactivation_p2")
+				expectGetActivations(m, "prp_test", allActive, nil).Once()
+				expectPipelineActivation(m, papi.ActivationTypeDeactivate, 2, papi.ActivationNetworkStaging, "atv_deactivation_s2")
+			},
+			steps: func(port string) []resource.TestStep {
+				return []resource.TestStep{
+					{
+						Config: fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestResPropertyActivationPipeline/version_1.tf"), port),
+						Check: test.NewStateChecker("akamai_property_activation_pipeline.test").
+							CheckEqual("id", "prp_test").
+							CheckEqual("version", "1").
+							CheckEqual("staging_activation_id", "atv_s1").
+							CheckEqual("staging_status", "ACTIVE").
+							CheckEqual("production_activation_id", "atv_p1").
+							CheckEqual("production_status", "ACTIVE").
+							Build(),
+					},
+					{
+						Config: fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestResPropertyActivationPipeline/version_2.tf"), port),
+						Check: test.NewStateChecker("akamai_property_activation_pipeline.test").
+							CheckEqual("version", "2").
+							CheckEqual("staging_activation_id", "atv_s2").
+							CheckEqual("production_activation_id", "atv_p2").
+							Build(),
+					},
+				}
+			},
+		},
+		"failed verification stops the pipeline before production": {
+			staleStaging: true,
+			init: func(m *papi.Mock) {
+				expectGetActivations(m, "prp_test", activations(), nil).Once()
+				expectPipelineActivation(m, papi.ActivationTypeActivate, 1, papi.ActivationNetworkStaging, "atv_s1")
+			},
+			steps: func(port string) []resource.TestStep {
+				return []resource.TestStep{
+					{
+						Config: fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestResPropertyActivationPipeline/version_1.tf"), port),
+						ExpectError: regexp.MustCompile(`(?s)verification of version 1 on STAGING failed, the version was not activated.+` +
+							`on PRODUCTION: http://www.example.com:\d+/health: unexpected value of header.+X-Property-Version: want '1', got '0'`),
+					},
+				}
+			},
+		},
+		"verification without staging host": {
+			init: func(_ *papi.Mock) {},
+			steps: func(port string) []resource.TestStep {
+				return []resource.TestStep{
+					{
+						Config:      fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestResPropertyActivationPipeline/no_staging_host.tf"), port),
+						ExpectError: regexp.MustCompile("all of `staging_host,verification` must be specified"),
+					},
+				}
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			stagingVersion = "0"
+			// the server plays the staging network
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Regexp(t, `^www\.example\.com:\d+$`, r.Host)
+				if test.staleStaging {
+					w.Header().Set("X-Property-Version", "0")
+				} else {
+					w.Header().Set("X-Property-Version", stagingVersion)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+			serverURL, err := url.Parse(server.URL)
+			assert.NoError(t, err)
+
+			client := &papi.Mock{}
+			test.init(client)
+			useClient(client, nil, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					IsUnitTest:               true,
+					Steps:                    test.steps(serverURL.Port()),
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/timeouts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+)
+
+type (
+	// activationRollback describes when an activation is rolled back and to which version.
+	activationRollback struct {
+		onFailure   bool
+		healthCheck *healthCheck
+		// previous is the activation of the version active on the network before the new activation
+		previous *papi.Activation
+		// request is the request of the new activation, reused to reactivate the previous version
+		request papi.CreateActivationRequest
+	}
+
+	// healthCheck probes hostnames served by the property after its activation.
+	healthCheck struct {
+		hostnames           []string
+		path                string
+		scheme              string
+		connectTo           string
+		expectedStatusCodes map[int]struct{}
+		attempts            int
+		interval            time.Duration
+		timeout             time.Duration
+	}
+)
+
+var rollbackSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"on_failure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Reactivates the previously active version when the activation fails or is aborted. Default is true.",
+		},
+		"health_check": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "HTTP requests sent by the provider to the hostnames of the property once it is active. The previously active version is reactivated if any hostname does not respond with an expected status code.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostnames": {
+						Type:        schema.TypeSet,
+						Required:    true,
+						MinItems:    1,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Hostnames to which the requests are sent.",
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "/",
+						Description: "Path of the requested URLs. Default is '/'.",
+					},
+					"scheme": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "https",
+						ValidateDiagFunc: tf.ValidateStringInSlice([]string{"http", "https"}),
+						Description:      "Scheme of the requested URLs, either 'http' or 'https'. Default is 'https'.",
+					},
+					"connect_to": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Description: "Address, as 'host:port', to which the requests are sent instead of the addresses the hostnames " +
+							"resolve to, e.g. the staging edge hostname of the property to check the staging network.",
+					},
+					"expected_status_codes": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+						Description: "Status codes of healthy responses. Any 2xx or 3xx status code is expected by default.",
+					},
+					"attempts": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      3,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "Number of requests sent to a hostname until it responds with an expected status code. Default is 3.",
+					},
+					"interval": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "10s",
+						ValidateDiagFunc: timeouts.ValidateDurationFormat,
+						Description:      "Duration between the attempts, e.g. '30s'. Default is '10s'.",
+					},
+					"timeout": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "10s",
+						ValidateDiagFunc: timeouts.ValidateDurationFormat,
+						Description:      "Timeout of a single request, e.g. '5s'. Default is '10s'.",
+					},
+				},
+			},
+		},
+	},
+}
+
+var rollbackResultSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"reason": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Why the activation was rolled back.",
+		},
+		"failed_version": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The version whose activation was rolled back.",
+		},
+		"failed_activation_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the activation which was rolled back.",
+		},
+		"version": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The reactivated version.",
+		},
+		"activation_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the activation of the reactivated version.",
+		},
+	},
+}
+
+// getActivationRollback returns the rollback configuration of the resource or nil, if it is not configured.
+func getActivationRollback(d *schema.ResourceData) (*activationRollback, error) {
+	rollbackList, err := tf.GetListValue("rollback", d)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	rollbackMap, ok := rollbackList[0].(map[string]interface{})
+	if !ok {
+		return &activationRollback{onFailure: true}, nil
+	}
+
+	rollback := &activationRollback{onFailure: cast.ToBool(rollbackMap["on_failure"])}
+	healthCheckList, _ := rollbackMap["health_check"].([]interface{})
+	if len(healthCheckList) == 0 || healthCheckList[0] == nil {
+		return rollback, nil
+	}
+	healthCheckMap := healthCheckList[0].(map[string]interface{})
+
+	check := healthCheck{
+		path:                cast.ToString(healthCheckMap["path"]),
+		scheme:              cast.ToString(healthCheckMap["scheme"]),
+		connectTo:           cast.ToString(healthCheckMap["connect_to"]),
+		expectedStatusCodes: map[int]struct{}{},
+		attempts:            cast.ToInt(healthCheckMap["attempts"]),
+	}
+	for _, hostname := range healthCheckMap["hostnames"].(*schema.Set).List() {
+		check.hostnames = append(check.hostnames, cast.ToString(hostname))
+	}
+	for _, code := range healthCheckMap["expected_status_codes"].(*schema.Set).List() {
+		check.expectedStatusCodes[cast.ToInt(code)] = struct{}{}
+	}
+	if check.interval, err = time.ParseDuration(cast.ToString(healthCheckMap["interval"])); err != nil {
+		return nil, fmt.Errorf("invalid health check interval: %w", err)
+	}
+	if check.timeout, err = time.ParseDuration(cast.ToString(healthCheckMap["timeout"])); err != nil {
+		return nil, fmt.Errorf("invalid health check timeout: %w", err)
+	}
+	rollback.healthCheck = &check
+
+	return rollback, nil
+}
+
+// prepare remembers the version active on the network before the given activation request is submitted.
+func (r *activationRollback) prepare(ctx context.Context, client papi.PAPI, request papi.CreateActivationRequest) error {
+	resp, err := client.GetActivations(ctx, papi.GetActivationsRequest{
+		PropertyID: request.PropertyID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get activations for property: %w", err)
+	}
+	previous, err := findLatestActive(resp.Activations.Items, request.Activation.Network)
+	if err != nil && !errors.Is(err, errNoActiveVersionFound) {
+		return err
+	}
+	if previous != nil && previous.PropertyVersion == request.Activation.PropertyVersion {
+		previous = nil
+	}
+	r.previous = previous
+	r.request = request
+	return nil
+}
+
+// awaitActivation polls the activation and, if it fails or the health check fails once it is active,
+// reactivates the previous version of the property. The rollback is recorded as rollback_result.
+// If the activation is rolled back, the activation of the previous version is returned along with a warning.
+func awaitActivation(ctx context.Context, client papi.PAPI, d *schema.ResourceData, activation *papi.Activation,
+	propertyID string, rollback *activationRollback, logger log.Interface) (*papi.Activation, diag.Diagnostics) {
+	if err := d.Set("rollback_result", nil); err != nil {
+		return nil, diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+	}
+
+	polled, diags := pollActivation(ctx, client, activation, propertyID)
+	if rollback == nil || rollback.request.PropertyID == "" {
+		return polled, diags
+	}
+
+	var reason string
+	switch {
+	case diags.HasError():
+		if polled == nil || !rollback.onFailure {
+			return nil, diags
+		}
+		reason = fmt.Sprintf("activation %s", polled.Status)
+	case rollback.healthCheck != nil:
+		if err := rollback.healthCheck.run(ctx, logger); err != nil {
+			reason = fmt.Sprintf("health check failed: %s", err)
+			diags = diag.Errorf("activation of version %d failed the %s", polled.PropertyVersion, reason)
+		}
+	}
+	if reason == "" {
+		return polled, diags
+	}
+	if rollback.previous == nil {
+		return nil, append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "activation cannot be rolled back",
+			Detail:   fmt.Sprintf("no version was active on %s before version %d", rollback.request.Activation.Network, polled.PropertyVersion),
+		})
+	}
+
+	logger.Warnf("rolling back activation %s of version %d on %s to version %d: %s", polled.ActivationID,
+		polled.PropertyVersion, polled.Network, rollback.previous.PropertyVersion, reason)
+	reactivated, rollbackDiags := rollback.reactivate(ctx, client, polled, reason)
+	if rollbackDiags.HasError() {
+		return nil, append(diags, rollbackDiags...)
+	}
+
+	result := map[string]interface{}{
+		"reason":               reason,
+		"failed_version":       polled.PropertyVersion,
+		"failed_activation_id": polled.ActivationID,
+		"version":              reactivated.PropertyVersion,
+		"activation_id":        reactivated.ActivationID,
+	}
+	if err := d.Set("rollback_result", []interface{}{result}); err != nil {
+		return nil, diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+	}
+
+	return reactivated, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("activation of version %d was rolled back", polled.PropertyVersion),
+		Detail: fmt.Sprintf("%s; version %d was reactivated on %s and is given in rollback_result. The version in configuration "+
+			"is kept in state and is not activated again until it changes.", reason, reactivated.PropertyVersion, reactivated.Network),
+	}}
+}
+
+// rolledBackVersion returns the version reactivated by the rollback of the version in state, if it was rolled back.
+// In such case, the version in state is kept, so that the rolled back version is not proposed for activation again.
+func rolledBackVersion(d *schema.ResourceData) (int, bool, error) {
+	result, err := tf.GetListValue("rollback_result", d)
+	if err != nil {
+		if errors.Is(err, tf.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	if len(result) == 0 {
+		return 0, false, nil
+	}
+	rollbackResult, ok := result[0].(map[string]interface{})
+	if !ok {
+		return 0, false, fmt.Errorf("%w: %s, %q", tf.ErrInvalidType, "rollback_result", "map[string]interface{}")
+	}
+	stateVersion, err := tf.GetIntValue("version", d)
+	if err != nil {
+		return 0, false, err
+	}
+	if cast.ToInt(rollbackResult["failed_version"]) != stateVersion {
+		return 0, false, nil
+	}
+	return cast.ToInt(rollbackResult["version"]), true, nil
+}
+
+// reactivate activates the previous version using the request of the failed activation.
+func (r *activationRollback) reactivate(ctx context.Context, client papi.PAPI, failed *papi.Activation, reason string) (*papi.Activation, diag.Diagnostics) {
+	request := r.request
+	request.Activation.PropertyVersion = r.previous.PropertyVersion
+	request.Activation.Note = fmt.Sprintf("Rollback of version %d: %s", failed.PropertyVersion, reason)
+
+	activationID, diags := createActivation(ctx, client, request)
+	if diags.HasError() {
+		return nil, diags
+	}
+	act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+		ActivationID: activationID,
+		PropertyID:   request.PropertyID,
+	})
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return pollActivation(ctx, client, act.Activation, request.PropertyID)
+}
+
+// run sends requests to every hostname until it responds with an expected status code or the attempts are exhausted.
+func (h *healthCheck) run(ctx context.Context, logger log.Interface) error {
+	client := newProbeClient(h.timeout, func(address string) string {
+		if h.connectTo != "" {
+			return h.connectTo
+		}
+		return address
+	})
+	for _, hostname := range h.hostnames {
+		url := fmt.Sprintf("%s://%s%s", h.scheme, hostname, h.path)
+		err := retryProbe(ctx, h.attempts, h.interval, logger, url, func() error {
+			return probe(ctx, client, url, func(resp *http.Response) error {
+				return checkStatusCode(resp, h.expectedStatusCodes)
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", url, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcePropertyActivationRollback(t *testing.T) {
+	contacts := []string{"user@example.com"}
+	note := "property activation note"
+	rollbackNote := "Rollback of version 2: "
+	version1Active := generateActivationResponseMock("atv_v1", note, 1, papi.ActivationTypeActivate, "2020-10-28T15:04:05Z", contacts)
+	version2Active := generateActivationResponseMock("atv_v2", note, 2, papi.ActivationTypeActivate, "2020-10-28T16:04:05Z", contacts)
+
+	expectDeactivation := func(m *papi.Mock, version int) {
+		expectCreateActivation(m, "prp_test", papi.ActivationTypeDeactivate, version, "STAGING",
+			contacts, note, "atv_deactivation", true, nil).Once()
+		expectGetActivation(m, "prp_test", "atv_deactivation", version, "STAGING", papi.ActivationStatusActive,
+			papi.ActivationTypeDeactivate, note, contacts, nil).Once()
+	}
+
+	rolledBackChecker := test.NewStateChecker("akamai_property_activation.test").
+		CheckEqual("id", "prp_test:STAGING").
+		CheckEqual("version", "2").
+		CheckEqual("status", "ACTIVE").
+		CheckEqual("activation_id", "atv_v1").
+		CheckEqual("rollback_result.#", "1").
+		CheckEqual("rollback_result.0.failed_version", "2").
+		CheckEqual("rollback_result.0.failed_activation_id", "atv_v2").
+		CheckEqual("rollback_result.0.version", "1").
+		CheckEqual("rollback_result.0.activation_id", "atv_v1")
+
+	tests := map[string]struct {
+		init          func(*papi.Mock)
+		healthyStatus int
+		steps         func(serverAddress string) []resource.TestStep
+	}{
+		"failed activation is rolled back to previous version": {
+			init: func(m *papi.Mock) {
+				// create
+				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", version1Active, nil).Twice()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
+					contacts, note, "atv_v2", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_v2", 2, "STAGING", papi.ActivationStatusFailed,
+					papi.ActivationTypeActivate, note, contacts, nil).Once()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 1, "STAGING",
+					contacts, rollbackNote+"activation FAILED", "atv_v1", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_v1", 1, "STAGING", papi.ActivationStatusActive,
+					papi.ActivationTypeActivate, rollbackNote+"activation FAILED", contacts, nil).Once()
+				// read and delete
+				expectGetActivations(m, "prp_test", version1Active, nil)
+				expectDeactivation(m, 1)
+			},
+			steps: func(_ string) []resource.TestStep {
+				return []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestPropertyActivation/rollback/activation_failure.tf"),
+					Check: rolledBackChecker.
+						CheckEqual("rollback_result.0.reason", "activation FAILED").
+						Build(),
+				}}
+			},
+		},
+		"failed activation without previous version": {
+			init: func(m *papi.Mock) {
+				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", papi.GetActivationsResponse{}, nil).Twice()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
+					contacts, note, "atv_v2", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_v2", 2, "STAGING", papi.ActivationStatusAborted,
+					papi.ActivationTypeActivate, note, contacts, nil).Once()
+			},
+			steps: func(_ string) []resource.TestStep {
+				return []resource.TestStep{{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestPropertyActivation/rollback/activation_failure.tf"),
+					ExpectError: regexp.MustCompile(`(?s)activation request aborted.+no version was active on STAGING before\s+version 2`),
+				}}
+			},
+		},
+		"failed health check is rolled back to previous version": {
+			healthyStatus: http.StatusServiceUnavailable,
+			init: func(m *papi.Mock) {
+				// create
+				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", version1Active, nil).Twice()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
+					contacts, note, "atv_v2", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_v2", 2, "STAGING", papi.ActivationStatusActive,
+					papi.ActivationTypeActivate, note, contacts, nil).Once()
+				reason := "health check failed: http://www.example.com/health: unexpected status code 503"
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 1, "STAGING",
+					contacts, rollbackNote+reason, "atv_v1", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_v1", 1, "STAGING", papi.ActivationStatusActive,
+					papi.ActivationTypeActivate, rollbackNote+reason, contacts, nil).Once()
+				// read and delete
+				expectGetActivations(m, "prp_test", version1Active, nil)
+				expectDeactivation(m, 1)
+			},
+			steps: func(serverAddress string) []resource.TestStep {
+				return []resource.TestStep{{
+					Config: fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestPropertyActivation/rollback/health_check.tf"), serverAddress),
+					Check: rolledBackChecker.
+						CheckEqual("rollback_result.0.reason", "health check failed: http://www.example.com/health: unexpected status code 503").
+						Build(),
+				}}
+			},
+		},
+		"passed health check": {
+			healthyStatus: http.StatusOK,
+			init: func(m *papi.Mock) {
+				// create
+				expectGetRuleTree(m, "prp_test", 2, ruleTreeResponseValid, nil).Once()
+				expectGetActivations(m, "prp_test", version1Active, nil).Twice()
+				expectCreateActivation(m, "prp_test", papi.ActivationTypeActivate, 2, "STAGING",
+					contacts, note, "atv_v2", true, nil).Once()
+				expectGetActivation(m, "prp_test", "atv_v2", 2, "STAGING", papi.ActivationStatusActive,
+					papi.ActivationTypeActivate, note, contacts, nil).Once()
+				// read and delete
+				expectGetActivations(m, "prp_test", version2Active, nil)
+				expectDeactivation(m, 2)
+			},
+			steps: func(serverAddress string) []resource.TestStep {
+				return []resource.TestStep{{
+					Config: fmt.Sprintf(testutils.LoadFixtureString(t, "testdata/TestPropertyActivation/rollback/health_check.tf"), serverAddress),
+					Check: test.NewStateChecker("akamai_property_activation.test").
+						CheckEqual("version", "2").
+						CheckEqual("activation_id", "atv_v2").
+						CheckEqual("rollback.0.health_check.0.hostnames.#", "1").
+						CheckEqual("rollback_result.#", "0").
+						Build(),
+				}}
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "www.example.com", r.Host)
+				assert.Equal(t, "/health", r.URL.Path)
+				w.WriteHeader(test.healthyStatus)
+			}))
+			defer server.Close()
+
+			client := &papi.Mock{}
+			test.init(client)
+			useClient(client, nil, func() {
+				resource.UnitTest(t, resource.TestCase{
+					ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+					IsUnitTest:               true,
+					Steps:                    test.steps(server.Listener.Addr().String()),
+				})
+			})
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	tests := map[string]struct {
+		statuses            []int
+		expectedStatusCodes map[int]struct{}
+		attempts            int
+		expectedRequests    int
+		withError           string
+	}{
+		"redirect is healthy by default": {
+			statuses:         []int{http.StatusFound},
+			attempts:         3,
+			expectedRequests: 1,
+		},
+		"healthy after retry": {
+			statuses:         []int{http.StatusBadGateway, http.StatusOK},
+			attempts:         3,
+			expectedRequests: 2,
+		},
+		"unhealthy after all attempts": {
+			statuses:         []int{http.StatusBadGateway, http.StatusBadGateway},
+			attempts:         2,
+			expectedRequests: 2,
+			withError:        "unexpected status code 502",
+		},
+		"status code not expected": {
+			statuses:            []int{http.StatusOK},
+			expectedStatusCodes: map[int]struct{}{http.StatusNoContent: {}},
+			attempts:            1,
+			expectedRequests:    1,
+			withError:           "unexpected status code 200",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.statuses[requests])
+				requests++
+			}))
+			defer server.Close()
+
+			check := healthCheck{
+				hostnames:           []string{"www.example.com"},
+				path:                "/",
+				scheme:              "http",
+				connectTo:           server.Listener.Addr().String(),
+				expectedStatusCodes: test.expectedStatusCodes,
+				attempts:            test.attempts,
+				interval:            time.Millisecond,
+				timeout:             time.Second,
+			}
+			err := check.run(context.Background(), log.Get("PAPI", "TestHealthCheck"))
+			assert.Equal(t, test.expectedRequests, requests)
+			if test.withError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.withError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,398 @@
+package property
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spf13/cast"
+)
+
+// bulkPatchTarget is a property version found by akamai_property_bulk_search, along with the locations it matched
+type bulkPatchTarget struct {
+	propertyID string
+	groupID    string
+	version    int
+	locations  []string
+}
+
+func resourcePropertyBulkPatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyBulkPatchCreate,
+		ReadContext:   resourcePropertyBulkPatchRead,
+		UpdateContext: resourcePropertyBulkPatchUpdate,
+		DeleteContext: resourcePropertyBulkPatchDelete,
+		CustomizeDiff: bulkPatchCustomDiff,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: tf.IsNotBlank,
+				Description:      "The contract of the patched properties.",
+			},
+			"operation": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "JSON patch operations (RFC 6902) applied in order at every match location of every property.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"op": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: tf.ValidateStringInSlice(jsonPatchOperations),
+							Description:      "The operation: 'add', 'remove', 'replace', 'move', 'copy' or 'test'.",
+						},
+						"path": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateRelativeJSONPointer,
+							Description: "JSON pointer relative to the match location, e.g. '/verificationMode'. " +
+								"The match location itself is the target when it is empty.",
+						},
+						"from": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateRelativeJSONPointer,
+							Description:      "JSON pointer relative to the match location, from which the 'move' and 'copy' operations take the value.",
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsJSON,
+							Description:  "The value encoded as JSON, required by the 'add', 'replace' and 'test' operations, e.g. `jsonencode(\"CUSTOM\")`.",
+						},
+					},
+				},
+			},
+			"property": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Property versions to patch, usually the results of the `akamai_property_bulk_search` data source. " +
+					"Properties not patched by this resource yet are patched when the list changes, and the ones in `failed_properties` " +
+					"are retried on every apply. A new version is created " +
+					"from every property version, which has to be the latest version of the property.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the property.",
+						},
+						"group_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The group of the property.",
+						},
+						"property_version": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The patched version of the property.",
+						},
+						"match_locations": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validateRelativeJSONPointer},
+							Description: "JSON pointers to the locations in the rule tree where the operations are applied.",
+						},
+					},
+				},
+			},
+			"version_notes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Notes of the created property versions. The notes of the patched versions are kept by default.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Patched properties.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the property.",
+						},
+						"base_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The patched version of the property.",
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Description: "The version created with the patched rules. It equals `base_version` when the operations " +
+								"did not change the rules and no version was created.",
+						},
+						"rules_change_summary": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Changes of the rule tree made by the patch, in the format of the `rules_change_summary` " +
+								"attribute of the `akamai_property` resource.",
+						},
+					},
+				},
+			},
+			"failed_properties": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Properties which could not be patched. Their patch is retried on the next apply.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the property.",
+						},
+						"property_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version of the property which could not be patched.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the property could not be patched.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// bulkPatchCustomDiff plans the patch of the properties again, when some of them could not be patched
+func bulkPatchCustomDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || len(d.Get("failed_properties").([]interface{})) == 0 {
+		return nil
+	}
+	if err := d.SetNewComputed("results"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("failed_properties")
+}
+
+func validateRelativeJSONPointer(v interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := parseJSONPointer(v.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourcePropertyBulkPatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger := meta.Must(m).Log("PAPI", "resourcePropertyBulkPatchCreate")
+	ctx = log.NewContext(ctx, logger)
+
+	operations, err := getBulkPatchOperations(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	encoded, err := json.Marshal(operations)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID := str.AddPrefix(d.Get("contract_id").(string), "ctr_")
+	checksum := sha256.Sum256(encoded)
+	d.SetId(fmt.Sprintf("%s:%x", contractID, checksum[:8]))
+
+	return patchProperties(ctx, Client(meta.Must(m)), d, operations)
+}
+
+func resourcePropertyBulkPatchRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// the created property versions are managed by PAPI, the results only record the patch
+	return nil
+}
+
+func resourcePropertyBulkPatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger := meta.Must(m).Log("PAPI", "resourcePropertyBulkPatchUpdate")
+	ctx = log.NewContext(ctx, logger)
+
+	failed, _ := d.GetChange("failed_properties")
+	if !d.HasChange("property") && len(failed.([]interface{})) == 0 {
+		return nil
+	}
+	operations, err := getBulkPatchOperations(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return patchProperties(ctx, Client(meta.Must(m)), d, operations)
+}
+
+func resourcePropertyBulkPatchDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger := meta.Must(m).Log("PAPI", "resourcePropertyBulkPatchDelete")
+	logger.Infof("removing bulk patch %s from state, the created property versions are kept", d.Id())
+	return nil
+}
+
+func getBulkPatchOperations(d *schema.ResourceData) ([]jsonPatchOperation, error) {
+	var operations []jsonPatchOperation
+	for i, o := range d.Get("operation").([]interface{}) {
+		operationMap := o.(map[string]interface{})
+		operation := jsonPatchOperation{
+			Op:   cast.ToString(operationMap["op"]),
+			Path: cast.ToString(operationMap["path"]),
+			From: cast.ToString(operationMap["from"]),
+		}
+		value := cast.ToString(operationMap["value"])
+		switch {
+		case value != "":
+			if err := json.Unmarshal([]byte(value), &operation.Value); err != nil {
+				return nil, fmt.Errorf("operation %d: value is not valid JSON: %w", i, err)
+			}
+		case slices.Contains([]string{"add", "replace", "test"}, operation.Op):
+			return nil, fmt.Errorf("operation %d: value is required by the '%s' operation", i, operation.Op)
+		}
+		if (operation.Op == "move" || operation.Op == "copy") && operation.From == "" {
+			return nil, fmt.Errorf("operation %d: from is required by the '%s' operation", i, operation.Op)
+		}
+		operations = append(operations, operation)
+	}
+	return operations, nil
+}
+
+// patchProperties patches the configured properties which were not patched yet. A property is patched already when
+// its configured version is the base version or the version created by an earlier patch, so a search which still
+// matches the patched rules does not patch them again. Properties which could not be patched are reported as warnings
+// and recorded in failed_properties, so the next apply retries them.
+func patchProperties(ctx context.Context, client papi.PAPI, d *schema.ResourceData, operations []jsonPatchOperation) diag.Diagnostics {
+	logger := log.FromContext(ctx)
+	contractID := str.AddPrefix(d.Get("contract_id").(string), "ctr_")
+	notes := d.Get("version_notes").(string)
+
+	// results are planned as unknown when failed properties are retried, so the ones in the prior state are used
+	priorResults, _ := d.GetChange("results")
+	patched := map[string]map[string]interface{}{}
+	for _, r := range priorResults.([]interface{}) {
+		result := r.(map[string]interface{})
+		patched[result["property_id"].(string)] = result
+	}
+
+	var diags diag.Diagnostics
+	var results, failed []interface{}
+	for _, p := range d.Get("property").([]interface{}) {
+		target := bulkPatchTarget{
+			propertyID: cast.ToString(p.(map[string]interface{})["property_id"]),
+			groupID:    str.AddPrefix(cast.ToString(p.(map[string]interface{})["group_id"]), "grp_"),
+			version:    cast.ToInt(p.(map[string]interface{})["property_version"]),
+		}
+		for _, location := range p.(map[string]interface{})["match_locations"].([]interface{}) {
+			target.locations = append(target.locations, cast.ToString(location))
+		}
+
+		if result, ok := patched[target.propertyID]; ok && (result["base_version"] == target.version || result["version"] == target.version) {
+			logger.Debugf("property %s version %d was already patched", target.propertyID, target.version)
+			results = append(results, result)
+			continue
+		}
+		result, err := patchProperty(ctx, client, contractID, target, operations, notes)
+		if err != nil {
+			logger.Errorf("could not patch property %s: %s", target.propertyID, err)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("property %s was not patched", target.propertyID),
+				Detail:   fmt.Sprintf("%s. The patch of the property is retried on the next apply.", err),
+			})
+			failed = append(failed, map[string]interface{}{
+				"property_id":      target.propertyID,
+				"property_version": target.version,
+				"error":            err.Error(),
+			})
+			continue
+		}
+		results = append(results, result)
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))...)
+	}
+	if err := d.Set("failed_properties", failed); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))...)
+	}
+	return diags
+}
+
+// patchProperty applies the operations at every match location of the property version, and saves the patched
+// rules as a new version of the property
+func patchProperty(ctx context.Context, client papi.PAPI, contractID string, target bulkPatchTarget,
+	operations []jsonPatchOperation, notes string) (map[string]interface{}, error) {
+	property, err := fetchLatestProperty(ctx, client, target.propertyID, target.groupID, contractID)
+	if err != nil {
+		return nil, err
+	}
+	if property.LatestVersion != target.version {
+		return nil, fmt.Errorf("version %d is not the latest version %d of the property, search the properties again", target.version, property.LatestVersion)
+	}
+
+	rules, ruleFormat, err := fetchRulesWithoutValidation(ctx, client, *property, target.version)
+	if err != nil {
+		return nil, err
+	}
+	document, err := decodeRuleTree(rules)
+	if err != nil {
+		return nil, err
+	}
+	// locations are patched from the last one, so that operations on arrays do not shift the locations not patched yet
+	for _, location := range slices.Backward(target.locations) {
+		located := make([]jsonPatchOperation, 0, len(operations))
+		for _, operation := range operations {
+			operation.Path = location + operation.Path
+			if operation.From != "" {
+				operation.From = location + operation.From
+			}
+			located = append(located, operation)
+		}
+		if document, err = applyJSONPatch(document, located); err != nil {
+			return nil, fmt.Errorf("at %s: %w", location, err)
+		}
+	}
+
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	var patchedRules papi.RulesUpdate
+	if err := json.Unmarshal(encoded, &patchedRules); err != nil {
+		return nil, fmt.Errorf("patched rules are not a valid rule tree: %w", err)
+	}
+	if notes != "" {
+		patchedRules.Comments = notes
+	}
+	normalizeFields(&rules, &patchedRules)
+	changes := diffRules(&rules.Rules, &patchedRules.Rules)
+
+	result := map[string]interface{}{
+		"property_id":          target.propertyID,
+		"base_version":         target.version,
+		"version":              target.version,
+		"rules_change_summary": changes,
+	}
+	if len(changes) == 0 {
+		log.FromContext(ctx).Infof("operations do not change rules of property %s version %d", target.propertyID, target.version)
+		return result, nil
+	}
+
+	if property.LatestVersion, err = createPropertyVersion(ctx, client, *property, target.version); err != nil {
+		return nil, err
+	}
+	if err := updatePropertyRules(ctx, client, *property, patchedRules, ruleFormat); err != nil {
+		return nil, fmt.Errorf("could not update rules of version %d: %w", property.LatestVersion, err)
+	}
+	result["version"] = property.LatestVersion
+	return result, nil
+}
@@ -0,0 +1,177 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResPropertyBulkPatch(t *testing.T) {
+	originRules := func(verificationMode string, children ...papi.Rules) papi.Rules {
+		return papi.Rules{
+			Name: "default",
+			Behaviors: []papi.RuleBehavior{
+				{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "origin.example.com", "verificationMode": verificationMode}},
+			},
+			Children: children,
+		}
+	}
+	staticRule := func(verificationMode string) papi.Rules {
+		return papi.Rules{
+			Name:      "Static content",
+			Behaviors: []papi.RuleBehavior{{Name: "origin", Options: papi.RuleOptionsMap{"verificationMode": verificationMode}}},
+		}
+	}
+	mockGetProperty := func(client *papi.Mock, propertyID, groupID string, latestVersion int) {
+		client.On("GetProperty", testutils.MockContext, papi.GetPropertyRequest{
+			PropertyID: propertyID,
+			ContractID: "ctr_1",
+			GroupID:    groupID,
+		}).Return(&papi.GetPropertyResponse{Property: &papi.Property{
+			ContractID:    "ctr_1",
+			GroupID:       groupID,
+			PropertyID:    propertyID,
+			LatestVersion: latestVersion,
+		}}, nil).Once()
+	}
+	mockGetRuleTree := func(client *papi.Mock, propertyID, groupID string, version int, rules papi.Rules) {
+		client.On("GetRuleTree", testutils.MockContext, papi.GetRuleTreeRequest{
+			PropertyID:      propertyID,
+			GroupID:         groupID,
+			ContractID:      "ctr_1",
+			PropertyVersion: version,
+		}).Return(&papi.GetRuleTreeResponse{
+			Response:   papi.Response{ContractID: "ctr_1", GroupID: groupID},
+			RuleFormat: "v2024-01-09",
+			Comments:   "previous notes",
+			Rules:      rules,
+		}, nil).Once()
+	}
+	mockPatch := func(client *papi.Mock, propertyID, groupID string, version int, rules papi.Rules) {
+		client.On("CreatePropertyVersion", testutils.MockContext, papi.CreatePropertyVersionRequest{
+			PropertyID: propertyID,
+			ContractID: "ctr_1",
+			GroupID:    groupID,
+			Version:    papi.PropertyVersionCreate{CreateFromVersion: version},
+		}).Return(&papi.CreatePropertyVersionResponse{PropertyVersion: version + 1}, nil).Once()
+		client.On("UpdateRuleTree", testutils.MockContext, papi.UpdateRulesRequest{
+			PropertyID:      propertyID,
+			GroupID:         groupID,
+			ContractID:      "ctr_1",
+			PropertyVersion: version + 1,
+			Rules:           papi.RulesUpdate{Comments: "Use custom origin certificate verification", Rules: rules},
+			ValidateRules:   true,
+		}).Return(&papi.UpdateRulesResponse{PropertyID: propertyID, PropertyVersion: version + 1, Rules: rules}, nil).Once()
+	}
+
+	t.Run("patch matched properties", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockGetProperty(client, "prp_1", "grp_1", 3)
+		mockGetRuleTree(client, "prp_1", "grp_1", 3, originRules("PLATFORM_SETTINGS", staticRule("THIRD_PARTY")))
+		mockPatch(client, "prp_1", "grp_1", 3, originRules("CUSTOM", staticRule("CUSTOM")))
+		// prp_2 already uses the value, so no version is created
+		mockGetProperty(client, "prp_2", "grp_1", 1)
+		mockGetRuleTree(client, "prp_2", "grp_1", 1, originRules("CUSTOM"))
+		mockGetProperty(client, "prp_3", "grp_2", 2)
+		mockGetRuleTree(client, "prp_3", "grp_2", 2, originRules("PLATFORM_SETTINGS"))
+		mockPatch(client, "prp_3", "grp_2", 2, originRules("CUSTOM"))
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyBulkPatch/01_patch.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "id", "ctr_1:d81d40ea7ccf189b"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.#", "2"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.property_id", "prp_1"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.base_version", "3"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.version", "4"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.rules_change_summary.#", "2"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.rules_change_summary.0",
+								"default: behavior origin.verificationMode PLATFORM_SETTINGS -> CUSTOM"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.rules_change_summary.1",
+								"default/Static content: behavior origin.verificationMode THIRD_PARTY -> CUSTOM"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.1.property_id", "prp_2"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.1.base_version", "1"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.1.version", "1"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.1.rules_change_summary.#", "0"),
+						),
+					},
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyBulkPatch/02_add_property.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.#", "3"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.version", "4"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.2.property_id", "prp_3"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.2.base_version", "2"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.2.version", "3"),
+						),
+					},
+					{
+						// the search now finds the patched versions, which are not patched again
+						Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyBulkPatch/03_patched_versions.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "property.0.property_version", "4"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.#", "3"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.version", "4"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.2.version", "3"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("property changed after search is retried", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockGetProperty(client, "prp_1", "grp_1", 5)
+		mockGetProperty(client, "prp_2", "grp_1", 1)
+		mockGetRuleTree(client, "prp_2", "grp_1", 1, originRules("PLATFORM_SETTINGS"))
+		mockPatch(client, "prp_2", "grp_1", 1, originRules("CUSTOM"))
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyBulkPatch/01_patch.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "property.#", "2"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "failed_properties.#", "1"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "failed_properties.0.property_id", "prp_1"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "failed_properties.0.property_version", "3"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "failed_properties.0.error",
+								"version 3 is not the latest version 5 of the property, search the properties again"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.#", "1"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.property_id", "prp_2"),
+							resource.TestCheckResourceAttr("akamai_property_bulk_patch.test", "results.0.version", "2"),
+						),
+						// the patch of prp_1 is planned again
+						ExpectNonEmptyPlan: true,
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("operation without value", func(t *testing.T) {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestResPropertyBulkPatch/missing_value.tf"),
+					ExpectError: regexp.MustCompile(`operation 0: value is required by the 'replace' operation`),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,472 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/str"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/meta"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &CloneResource{}
+	_ resource.ResourceWithConfigure = &CloneResource{}
+)
+
+// CloneResource represents akamai_property_clone resource
+type CloneResource struct {
+	meta meta.Meta
+}
+
+// CloneResourceModel is a model for akamai_property_clone resource
+type CloneResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	GroupID               types.String `tfsdk:"group_id"`
+	ContractID            types.String `tfsdk:"contract_id"`
+	ProductID             types.String `tfsdk:"product_id"`
+	SourcePropertyID      types.String `tfsdk:"source_property_id"`
+	SourceVersion         types.Int64  `tfsdk:"source_version"`
+	CopyHostnames         types.Bool   `tfsdk:"copy_hostnames"`
+	HostnameSubstitutions types.Map    `tfsdk:"hostname_substitutions"`
+	OriginSubstitutions   types.Map    `tfsdk:"origin_substitutions"`
+	LatestVersion         types.Int64  `tfsdk:"latest_version"`
+	RuleFormat            types.String `tfsdk:"rule_format"`
+	Rules                 types.String `tfsdk:"rules"`
+}
+
+// originHostnames matches the hostnames of the origin behaviors, to which the origin substitutions apply
+const originHostnames = "$..behaviors[?(@.name == 'origin')].options.hostname"
+
+// NewCloneResource returns new property clone resource
+func NewCloneResource() resource.Resource {
+	return &CloneResource{}
+}
+
+// Metadata implements resource.Resource.
+func (r *CloneResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "akamai_property_clone"
+}
+
+// Schema implements resource's Schema
+func (r *CloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name to give to the new Property (must be unique)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(85),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9.\-_]+$`),
+						"a name must only contain letters, numbers, and these characters: . _ -"),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Group ID to be assigned to the new Property",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contract_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Contract ID to be assigned to the new Property",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Product ID to be assigned to the new Property. Defaults to the product of the source version",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_property_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the Property to clone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_version": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the source Property to clone. Defaults to its latest version",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"copy_hostnames": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the hostnames of the source version are copied to the new Property",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname_substitutions": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Hostnames of the source Property mapped to the hostnames replacing them in the rule tree " +
+					"and in the copied hostnames of the new Property",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"origin_substitutions": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Origin hostnames of the source Property mapped to the hostnames replacing them in the " +
+					"origin behaviors of the rule tree",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the new Property",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"latest_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Latest version of the new Property",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Rule format of the cloned rule tree",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rules": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cloned rule tree of the new Property in JSON, after the substitutions",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *CloneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		// ProviderData is nil when Configure is run first time as part of ValidateDataSourceConfig in framework provider
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Resource Configure Type",
+				fmt.Sprintf("Expected meta.Meta, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			)
+		}
+	}()
+
+	r.meta = meta.Must(req.ProviderData)
+}
+
+// Create implements resource's Create method
+func (r *CloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating Property Clone Resource")
+
+	var data *CloneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hostnames, origins map[string]string
+	resp.Diagnostics.Append(data.HostnameSubstitutions.ElementsAs(ctx, &hostnames, false)...)
+	resp.Diagnostics.Append(data.OriginSubstitutions.ElementsAs(ctx, &origins, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contractID := str.AddPrefix(data.ContractID.ValueString(), "ctr_")
+	groupID := str.AddPrefix(data.GroupID.ValueString(), "grp_")
+	sourceID := str.AddPrefix(data.SourcePropertyID.ValueString(), "prp_")
+
+	client := Client(r.meta)
+	source, err := fetchLatestProperty(ctx, client, sourceID, "", "")
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("could not fetch source property %s", sourceID), err.Error())
+		return
+	}
+	sourceVersion := source.LatestVersion
+	if !data.SourceVersion.IsUnknown() && !data.SourceVersion.IsNull() {
+		sourceVersion = int(data.SourceVersion.ValueInt64())
+	}
+
+	productID := str.AddPrefix(data.ProductID.ValueString(), "prd_")
+	if data.ProductID.IsUnknown() || data.ProductID.IsNull() {
+		res, err := fetchPropertyVersion(ctx, client, source.PropertyID, source.GroupID, source.ContractID, sourceVersion)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("could not fetch version %d of source property %s", sourceVersion, sourceID), err.Error())
+			return
+		}
+		productID = res.Version.ProductID
+	}
+
+	propertyID, err := createProperty(ctx, client, papi.CreatePropertyRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+		Property: papi.PropertyCreate{
+			ProductID:    productID,
+			PropertyName: data.Name.ValueString(),
+			CloneFrom: &papi.PropertyCloneFrom{
+				PropertyID:    source.PropertyID,
+				Version:       sourceVersion,
+				CopyHostnames: data.CopyHostnames.ValueBool(),
+			},
+		},
+	})
+	if err != nil {
+		if interpretedErr := interpretCreatePropertyErrorFramework(ctx, err, client, groupID, contractID, productID); interpretedErr != nil {
+			err = interpretedErr
+		}
+		resp.Diagnostics.AddError(err.Error(), "")
+		return
+	}
+
+	property, diags := substituteClone(ctx, client, propertyID, groupID, contractID, data.CopyHostnames.ValueBool(), hostnames, origins)
+	if diags.HasError() {
+		// the clone is not stored in the state, so it is removed to allow the next apply to create it again
+		if err := removeProperty(ctx, client, propertyID, groupID, contractID); err != nil {
+			diags.AddError(fmt.Sprintf("could not remove incomplete clone %s", propertyID), err.Error())
+		}
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	data.ID = types.StringValue(propertyID)
+	data.ProductID = types.StringValue(productID)
+	data.SourceVersion = types.Int64Value(int64(sourceVersion))
+	data.LatestVersion = types.Int64Value(int64(property.version))
+	data.RuleFormat = types.StringValue(property.ruleFormat)
+	data.Rules = types.StringValue(property.rules)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// clonedProperty describes the new property after the substitutions
+type clonedProperty struct {
+	version    int
+	ruleFormat string
+	rules      string
+}
+
+// substituteClone applies the hostname and origin substitutions to the rule tree and the copied hostnames
+// of the first version of the clone
+func substituteClone(ctx context.Context, client papi.PAPI, propertyID, groupID, contractID string, copyHostnames bool,
+	hostnames, origins map[string]string) (*clonedProperty, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	property, err := fetchLatestProperty(ctx, client, propertyID, groupID, contractID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("could not fetch cloned property %s", propertyID), err.Error())
+		return nil, diags
+	}
+	rules, ruleFormat, err := fetchRulesWithoutValidation(ctx, client, *property, property.LatestVersion)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("could not fetch rules of cloned property %s", propertyID), err.Error())
+		return nil, diags
+	}
+
+	substituted, changed, err := substituteRules(rules, hostnames, origins)
+	if err != nil {
+		diags.AddError("could not substitute hostnames in cloned rules", err.Error())
+		return nil, diags
+	}
+	if changed {
+		if err := updatePropertyRules(ctx, client, *property, substituted, ruleFormat); err != nil {
+			diags.AddError(fmt.Sprintf("could not update rules of cloned property %s", propertyID), err.Error())
+			return nil, diags
+		}
+	}
+
+	if copyHostnames && len(hostnames) > 0 {
+		copied, err := fetchPropertyVersionHostnames(ctx, client, *property, property.LatestVersion)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("could not fetch hostnames of cloned property %s", propertyID), err.Error())
+			return nil, diags
+		}
+		if substituteHostnames(copied, hostnames) {
+			if err := updatePropertyHostnames(ctx, client, *property, copied); err != nil {
+				diags.AddError(fmt.Sprintf("could not update hostnames of cloned property %s", propertyID), err.Error())
+				return nil, diags
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(substituted)
+	if err != nil {
+		diags.AddError("could not encode cloned rules", err.Error())
+		return nil, diags
+	}
+	return &clonedProperty{
+		version:    property.LatestVersion,
+		ruleFormat: ruleFormat,
+		rules:      string(encoded),
+	}, diags
+}
+
+// substituteRules replaces every string value of the rule tree equal to a key of the hostname substitutions,
+// and the hostnames of origin behaviors equal to a key of the origin substitutions, which take precedence
+func substituteRules(rules papi.RulesUpdate, hostnames, origins map[string]string) (papi.RulesUpdate, bool, error) {
+	if len(hostnames) == 0 && len(origins) == 0 {
+		return rules, false, nil
+	}
+	document, err := decodeRuleTree(rules)
+	if err != nil {
+		return papi.RulesUpdate{}, false, err
+	}
+	originPath, err := parseJSONPath(originHostnames)
+	if err != nil {
+		return papi.RulesUpdate{}, false, err
+	}
+	originPointers := map[string]bool{}
+	for _, node := range originPath.evaluate(document) {
+		originPointers[node.pointer] = true
+	}
+
+	var operations []jsonPatchOperation
+	walkJSON(jsonNode{value: document}, func(node jsonNode) {
+		value, ok := node.value.(string)
+		if !ok {
+			return
+		}
+		if origin, ok := origins[value]; ok && originPointers[node.pointer] {
+			operations = append(operations, jsonPatchOperation{Op: "replace", Path: node.pointer, Value: origin})
+		} else if hostname, ok := hostnames[value]; ok {
+			operations = append(operations, jsonPatchOperation{Op: "replace", Path: node.pointer, Value: hostname})
+		}
+	})
+	if len(operations) == 0 {
+		return rules, false, nil
+	}
+
+	if document, err = applyJSONPatch(document, operations); err != nil {
+		return papi.RulesUpdate{}, false, err
+	}
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return papi.RulesUpdate{}, false, err
+	}
+	var substituted papi.RulesUpdate
+	if err := json.Unmarshal(encoded, &substituted); err != nil {
+		return papi.RulesUpdate{}, false, err
+	}
+	return substituted, true, nil
+}
+
+// substituteHostnames replaces the copied hostnames in place and reports whether any of them was replaced.
+// The certificate status of a replaced hostname belongs to the source hostname, so it is not sent back.
+func substituteHostnames(copied []papi.Hostname, hostnames map[string]string) bool {
+	var changed bool
+	for i, hostname := range copied {
+		copied[i].CertStatus = papi.CertStatusItem{}
+		copied[i].CCMCertStatus = nil
+		if substitute, ok := hostnames[hostname.CnameFrom]; ok {
+			copied[i].CnameFrom = substitute
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Read implements resource's Read method
+func (r *CloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading Property Clone Resource")
+
+	var data *CloneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	propertyID := data.ID.ValueString()
+	contractID := str.AddPrefix(data.ContractID.ValueString(), "ctr_")
+	groupID := str.AddPrefix(data.GroupID.ValueString(), "grp_")
+
+	client := Client(r.meta)
+	prop, err := fetchLatestProperty(ctx, client, propertyID, groupID, contractID)
+	if errors.Is(err, papi.ErrNotFound) {
+		tflog.Warn(ctx, fmt.Sprintf("property %q removed on server. Removing from local state", propertyID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "")
+		return
+	}
+
+	data.LatestVersion = types.Int64Value(int64(prop.LatestVersion))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes of the configuration, as all of them result in resource replacement.
+func (r *CloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CloneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource's Delete method
+func (r *CloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting Property Clone")
+
+	var data *CloneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	propertyID := data.ID.ValueString()
+	contractID := str.AddPrefix(data.ContractID.ValueString(), "ctr_")
+	groupID := str.AddPrefix(data.GroupID.ValueString(), "grp_")
+
+	client := Client(r.meta)
+	if err := removeProperty(ctx, client, propertyID, groupID, contractID); err != nil {
+		resp.Diagnostics.AddError("removeProperty:", err.Error())
+	}
+}
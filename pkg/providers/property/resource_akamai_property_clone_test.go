@@ -0,0 +1,236 @@
+package property
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/test"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResPropertyClone(t *testing.T) {
+	sourceRules := func(www, origin string) papi.Rules {
+		return papi.Rules{
+			Name: "default",
+			Behaviors: []papi.RuleBehavior{
+				{Name: "origin", Options: papi.RuleOptionsMap{"hostname": origin, "forwardHostHeader": "REQUEST_HOST_HEADER"}},
+				{Name: "cpCode", Options: papi.RuleOptionsMap{"value": map[string]interface{}{"id": float64(123)}}},
+			},
+			Children: []papi.Rules{{
+				Name: "Website",
+				Criteria: []papi.RuleBehavior{
+					{Name: "hostname", Options: papi.RuleOptionsMap{"matchOperator": "IS_ONE_OF", "values": []interface{}{www, "static.example.com"}}},
+				},
+				Behaviors: []papi.RuleBehavior{
+					{Name: "origin", Options: papi.RuleOptionsMap{"hostname": www}},
+				},
+			}},
+		}
+	}
+	newProperty := &papi.Property{
+		PropertyID:    "prp_2",
+		PropertyName:  "new-site",
+		GroupID:       "grp_2",
+		ContractID:    "ctr_1",
+		LatestVersion: 1,
+	}
+	mockSource := func(client *papi.Mock) {
+		client.On("GetProperty", testutils.MockContext, papi.GetPropertyRequest{PropertyID: "prp_1"}).
+			Return(&papi.GetPropertyResponse{Property: &papi.Property{
+				PropertyID:    "prp_1",
+				GroupID:       "grp_1",
+				ContractID:    "ctr_1",
+				LatestVersion: 3,
+			}}, nil).Once()
+	}
+	mockCreate := func(client *papi.Mock, productID string, version int, copyHostnames bool) {
+		client.On("CreateProperty", testutils.MockContext, papi.CreatePropertyRequest{
+			ContractID: "ctr_1",
+			GroupID:    "grp_2",
+			Property: papi.PropertyCreate{
+				ProductID:    productID,
+				PropertyName: "new-site",
+				CloneFrom: &papi.PropertyCloneFrom{
+					PropertyID:    "prp_1",
+					Version:       version,
+					CopyHostnames: copyHostnames,
+				},
+			},
+		}).Return(&papi.CreatePropertyResponse{PropertyID: "prp_2"}, nil).Once()
+	}
+	mockClonedProperty := func(client *papi.Mock) {
+		client.On("GetProperty", testutils.MockContext, papi.GetPropertyRequest{
+			PropertyID: "prp_2",
+			GroupID:    "grp_2",
+			ContractID: "ctr_1",
+		}).Return(&papi.GetPropertyResponse{Property: newProperty}, nil)
+		client.On("GetRuleTree", testutils.MockContext, papi.GetRuleTreeRequest{
+			PropertyID:      "prp_2",
+			GroupID:         "grp_2",
+			ContractID:      "ctr_1",
+			PropertyVersion: 1,
+		}).Return(&papi.GetRuleTreeResponse{
+			RuleFormat: "v2024-01-09",
+			Rules:      sourceRules("www.example.com", "origin.example.com"),
+		}, nil).Once()
+	}
+	mockUpdateRules := func(client *papi.Mock, err error) {
+		call := client.On("UpdateRuleTree", testutils.MockContext, papi.UpdateRulesRequest{
+			PropertyID:      "prp_2",
+			GroupID:         "grp_2",
+			ContractID:      "ctr_1",
+			PropertyVersion: 1,
+			Rules:           papi.RulesUpdate{Rules: sourceRules("www.new-site.com", "origin.new-site.com")},
+			ValidateRules:   true,
+		}).Once()
+		if err != nil {
+			call.Return(nil, err)
+			return
+		}
+		call.Return(&papi.UpdateRulesResponse{}, nil)
+	}
+	mockRemove := func(client *papi.Mock) {
+		client.On("RemoveProperty", testutils.MockContext, papi.RemovePropertyRequest{
+			PropertyID: "prp_2",
+			GroupID:    "grp_2",
+			ContractID: "ctr_1",
+		}).Return(&papi.RemovePropertyResponse{}, nil).Once()
+	}
+
+	t.Run("clone latest version with substitutions", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockSource(client)
+		client.On("GetPropertyVersion", testutils.MockContext, papi.GetPropertyVersionRequest{
+			PropertyID:      "prp_1",
+			GroupID:         "grp_1",
+			ContractID:      "ctr_1",
+			PropertyVersion: 3,
+		}).Return(&papi.GetPropertyVersionsResponse{Version: papi.PropertyVersionGetItem{ProductID: "prd_SPM"}}, nil).Once()
+		mockCreate(client, "prd_SPM", 3, true)
+		mockClonedProperty(client)
+		mockUpdateRules(client, nil)
+		client.On("GetPropertyVersionHostnames", testutils.MockContext, papi.GetPropertyVersionHostnamesRequest{
+			PropertyID:        "prp_2",
+			GroupID:           "grp_2",
+			ContractID:        "ctr_1",
+			PropertyVersion:   1,
+			IncludeCertStatus: true,
+		}).Return(&papi.GetPropertyVersionHostnamesResponse{Hostnames: papi.HostnameResponseItems{Items: []papi.Hostname{
+			{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "www.example.com", CnameTo: "www.example.com.edgekey.net", CertProvisioningType: "CPS_MANAGED",
+				CertStatus: papi.CertStatusItem{Production: []papi.StatusItem{{Status: "DEPLOYED"}}}},
+			{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "static.example.com", CnameTo: "static.example.com.edgesuite.net", CertProvisioningType: "CPS_MANAGED"},
+		}}}, nil).Once()
+		client.On("UpdatePropertyVersionHostnames", testutils.MockContext, papi.UpdatePropertyVersionHostnamesRequest{
+			PropertyID:      "prp_2",
+			GroupID:         "grp_2",
+			ContractID:      "ctr_1",
+			PropertyVersion: 1,
+			Hostnames: []papi.Hostname{
+				{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "www.new-site.com", CnameTo: "www.example.com.edgekey.net", CertProvisioningType: "CPS_MANAGED"},
+				{CnameType: papi.HostnameCnameTypeEdgeHostname, CnameFrom: "static.example.com", CnameTo: "static.example.com.edgesuite.net", CertProvisioningType: "CPS_MANAGED"},
+			},
+		}).Return(&papi.UpdatePropertyVersionHostnamesResponse{}, nil).Once()
+		mockRemove(client)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyClone/clone.tf"),
+					Check: test.NewStateChecker("akamai_property_clone.test").
+						CheckEqual("id", "prp_2").
+						CheckEqual("product_id", "prd_SPM").
+						CheckEqual("source_version", "3").
+						CheckEqual("copy_hostnames", "true").
+						CheckEqual("latest_version", "1").
+						CheckEqual("rule_format", "v2024-01-09").
+						CheckEqual("rules", `{"rules":{"behaviors":[{"name":"origin","options":{"forwardHostHeader":"REQUEST_HOST_HEADER","hostname":"origin.new-site.com"}},{"name":"cpCode","options":{"value":{"id":123}}}],"children":[{"behaviors":[{"name":"origin","options":{"hostname":"www.new-site.com"}}],"criteria":[{"name":"hostname","options":{"matchOperator":"IS_ONE_OF","values":["www.new-site.com","static.example.com"]}}],"name":"Website","options":{}}],"name":"default","options":{}}}`).
+						Build(),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("clone given version without substitutions", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockSource(client)
+		mockCreate(client, "prd_Fresca", 2, false)
+		mockClonedProperty(client)
+		mockRemove(client)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config: testutils.LoadFixtureString(t, "testdata/TestResPropertyClone/clone_version.tf"),
+					Check: test.NewStateChecker("akamai_property_clone.test").
+						CheckEqual("id", "prp_2").
+						CheckEqual("product_id", "prd_Fresca").
+						CheckEqual("source_version", "2").
+						CheckEqual("copy_hostnames", "false").
+						CheckEqual("latest_version", "1").
+						Build(),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("incomplete clone is removed", func(t *testing.T) {
+		client := &papi.Mock{}
+		mockSource(client)
+		client.On("GetPropertyVersion", testutils.MockContext, papi.GetPropertyVersionRequest{
+			PropertyID:      "prp_1",
+			GroupID:         "grp_1",
+			ContractID:      "ctr_1",
+			PropertyVersion: 3,
+		}).Return(&papi.GetPropertyVersionsResponse{Version: papi.PropertyVersionGetItem{ProductID: "prd_SPM"}}, nil).Once()
+		mockCreate(client, "prd_SPM", 3, true)
+		mockClonedProperty(client)
+		mockUpdateRules(client, errors.New("invalid origin"))
+		mockRemove(client)
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				IsUnitTest:               true,
+				Steps: []resource.TestStep{{
+					Config:      testutils.LoadFixtureString(t, "testdata/TestResPropertyClone/clone.tf"),
+					ExpectError: regexp.MustCompile("could not update rules of cloned property prp_2"),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
+
+func TestSubstituteRules(t *testing.T) {
+	rules := papi.RulesUpdate{Rules: papi.Rules{
+		Name: "default",
+		Behaviors: []papi.RuleBehavior{
+			{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "example.com"}},
+			{Name: "siteShield", Options: papi.RuleOptionsMap{"hostname": "example.com"}},
+		},
+	}}
+
+	substituted, changed, err := substituteRules(rules, map[string]string{"example.com": "www.example.org"}, map[string]string{"example.com": "origin.example.org"})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "origin.example.org", substituted.Rules.Behaviors[0].Options["hostname"])
+	assert.Equal(t, "www.example.org", substituted.Rules.Behaviors[1].Options["hostname"])
+
+	_, changed, err = substituteRules(rules, map[string]string{"example.net": "example.org"}, nil)
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
@@ -16,7 +16,7 @@ func getGroup(ctx context.Context, client papi.PAPI, groupID string) (*papi.Grou
 	log := log.FromContext(ctx)
 	log.Debugf("Fetching groups")
 
-	res, err := client.GetGroups(ctx)
+	res, err := getCachedGroups(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrFetchingGroups, err.Error())
 	}
@@ -42,7 +42,7 @@ func getContract(ctx context.Context, client papi.PAPI, contractID string) (*pap
 	log := log.FromContext(ctx)
 	log.Debugf("Fetching contract")
 
-	res, err := client.GetContracts(ctx)
+	res, err := getCachedContracts(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrFetchingContracts, err.Error())
 	}
@@ -71,7 +71,7 @@ func getProduct(ctx context.Context, client papi.PAPI, productID, contractID str
 	log := log.FromContext(ctx)
 	log.Debugf("Fetching product")
 
-	res, err := client.GetProducts(ctx, papi.GetProductsRequest{ContractID: contractID})
+	res, err := getCachedProducts(ctx, client, contractID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrProductFetch, err.Error())
 	}
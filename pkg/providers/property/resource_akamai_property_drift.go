@@ -0,0 +1,234 @@
+package property
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/log"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type (
+	// propertyDrift is a version of the property newer than the version last written by Terraform,
+	// which means it was created, and possibly activated, outside of Terraform.
+	propertyDrift struct {
+		version       int
+		detectedIn    []string
+		updatedByUser string
+		updatedDate   string
+		ruleChanges   []string
+	}
+
+	// propertyVersionDetails holds a version of the property already fetched by the read.
+	propertyVersionDetails struct {
+		version papi.PropertyVersionGetItem
+		rules   papi.RulesUpdate
+	}
+)
+
+var propertyDriftSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"version": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The property version changed outside of Terraform.",
+		},
+		"detected_in": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "Attributes holding the version: `latest_version`, `staging_version` or `production_version`. " +
+				"The latter two mean that the version was activated outside of Terraform.",
+		},
+		"updated_by_user": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The user who last updated the version.",
+		},
+		"updated_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date of the last update of the version.",
+		},
+		"rules_change_summary": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Description: "Changes of the rule tree of the version compared to `managed_version`, in the format " +
+				"of the `rules_change_summary` attribute of the property.",
+		},
+	},
+}
+
+// findDriftedVersions returns the latest, staging and production versions of the property which are newer
+// than managedVersion, in ascending order, along with the attributes each version was found in.
+func findDriftedVersions(property papi.Property, managedVersion int) ([]int, map[int][]string) {
+	detectedIn := map[int][]string{}
+	add := func(attribute string, version *int) {
+		if version != nil && *version > managedVersion {
+			detectedIn[*version] = append(detectedIn[*version], attribute)
+		}
+	}
+	add("latest_version", &property.LatestVersion)
+	add("staging_version", property.StagingVersion)
+	add("production_version", property.ProductionVersion)
+
+	versions := make([]int, 0, len(detectedIn))
+	for version := range detectedIn {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions, detectedIn
+}
+
+// detectPropertyDrift compares the latest, staging and production versions of the property with the version last
+// written by Terraform. For every newer version, it reports who updated it and how its rules differ.
+// The version fetched by the read is reused, other versions are fetched only when drift is found.
+func detectPropertyDrift(ctx context.Context, client papi.PAPI, property papi.Property, managedVersion int,
+	read propertyVersionDetails) ([]propertyDrift, error) {
+	versions, detectedIn := findDriftedVersions(property, managedVersion)
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	managedRules, _, err := fetchRulesWithoutValidation(ctx, client, property, managedVersion)
+	if err != nil {
+		return nil, err
+	}
+	drift := make([]propertyDrift, 0, len(versions))
+	for _, version := range versions {
+		details := read
+		if version != read.version.PropertyVersion {
+			if details, err = fetchPropertyVersionDetails(ctx, client, property, version); err != nil {
+				return nil, err
+			}
+		}
+		oldRules, newRules := managedRules, details.rules
+		normalizeFields(&oldRules, &newRules)
+		drift = append(drift, propertyDrift{
+			version:       version,
+			detectedIn:    detectedIn[version],
+			updatedByUser: details.version.UpdatedByUser,
+			updatedDate:   details.version.UpdatedDate,
+			ruleChanges:   diffRules(&oldRules.Rules, &newRules.Rules),
+		})
+	}
+	return drift, nil
+}
+
+func fetchPropertyVersionDetails(ctx context.Context, client papi.PAPI, property papi.Property, version int) (propertyVersionDetails, error) {
+	res, err := fetchPropertyVersion(ctx, client, property.PropertyID, property.GroupID, property.ContractID, version)
+	if err != nil {
+		return propertyVersionDetails{}, err
+	}
+	rules, _, err := fetchRulesWithoutValidation(ctx, client, property, version)
+	if err != nil {
+		return propertyVersionDetails{}, err
+	}
+	return propertyVersionDetails{version: res.Version, rules: rules}, nil
+}
+
+// fetchRulesWithoutValidation fetches the rule tree and the rule format of a version whose rules are not going
+// to be reported, so their validation is not needed.
+func fetchRulesWithoutValidation(ctx context.Context, client papi.PAPI, property papi.Property, version int) (papi.RulesUpdate, string, error) {
+	req := papi.GetRuleTreeRequest{
+		PropertyID:      property.PropertyID,
+		GroupID:         property.GroupID,
+		ContractID:      property.ContractID,
+		PropertyVersion: version,
+	}
+	logger := log.FromContext(ctx).With("request", logFields(req))
+
+	logger.Debug("fetching property rules")
+	res, err := client.GetRuleTree(ctx, req)
+	if err != nil {
+		logger.Error("could not fetch property rules", "error", err)
+		return papi.RulesUpdate{}, "", fmt.Errorf("could not fetch rules of property version %d: %w", version, err)
+	}
+	return papi.RulesUpdate{Rules: res.Rules, Comments: res.Comments}, res.RuleFormat, nil
+}
+
+// diagnostic describes the drift as a warning, which is shown when the property is refreshed.
+func (p propertyDrift) diagnostic(propertyID string, managedVersion int) diag.Diagnostic {
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "Version %d (%s) was last updated by %s on %s, while Terraform last wrote version %d.",
+		p.version, strings.Join(p.detectedIn, ", "), p.updatedByUser, p.updatedDate, managedVersion)
+	if len(p.ruleChanges) == 0 {
+		fmt.Fprintf(&detail, " Its rules do not differ from version %d.", managedVersion)
+	} else {
+		fmt.Fprintf(&detail, " Its rules differ from version %d by:\n  %s", managedVersion, strings.Join(p.ruleChanges, "\n  "))
+	}
+	detail.WriteString("\nThe next update of the property overwrites these changes unless they are added to the configuration.")
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("version %d of property %s was changed outside of Terraform", p.version, propertyID),
+		Detail:   detail.String(),
+	}
+}
+
+func flattenPropertyDrift(drift []propertyDrift) []interface{} {
+	result := make([]interface{}, 0, len(drift))
+	for _, p := range drift {
+		result = append(result, map[string]interface{}{
+			"version":              p.version,
+			"detected_in":          p.detectedIn,
+			"updated_by_user":      p.updatedByUser,
+			"updated_date":         p.updatedDate,
+			"rules_change_summary": p.ruleChanges,
+		})
+	}
+	return result
+}
+
+// propertyDriftCustomDiff guards changes made to the property outside of Terraform, which are recorded as drift by the read.
+// If the property is going to be updated, the plan fails when fail_on_drift is set, as the update would overwrite them.
+// Otherwise, the configuration already matches the drifted versions, so they are adopted as managed_version.
+func propertyDriftCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	drift := diff.Get("drift").([]interface{})
+
+	updated, err := canTriggerNewPropertyVersion(diff, tf.NewRawConfig(diff))
+	if err != nil {
+		return err
+	}
+	if updated {
+		if len(drift) > 0 && diff.Get("fail_on_drift").(bool) {
+			versions := make([]string, 0, len(drift))
+			for _, d := range drift {
+				versions = append(versions, fmt.Sprint(d.(map[string]interface{})["version"]))
+			}
+			return fmt.Errorf("property %s was changed outside of Terraform in version(s) %s after version %d written by Terraform: "+
+				"add the changes to the configuration or unset fail_on_drift to overwrite them",
+				diff.Id(), strings.Join(versions, ", "), diff.Get("managed_version").(int))
+		}
+		for _, attr := range []string{"managed_version", "drift"} {
+			if err := diff.SetNewComputed(attr); err != nil {
+				return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
+			}
+		}
+		return nil
+	}
+
+	if len(drift) == 0 {
+		return nil
+	}
+	latest := diff.Get("managed_version").(int)
+	for _, d := range drift {
+		latest = max(latest, d.(map[string]interface{})["version"].(int))
+	}
+	if err := diff.SetNew("managed_version", latest); err != nil {
+		return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
+	}
+	if err := diff.SetNew("drift", nil); err != nil {
+		return fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error())
+	}
+	return nil
+}
@@ -473,6 +473,25 @@ func (p *mockProperty) mockGetRuleTreeActivation() *mock.Call {
 	return p.papiMock.On("GetRuleTree", testutils.MockContext, req).Return(&resp, nil).Once()
 }
 
+// mockGetRuleTreeDrift mocks the GetRuleTree call fetching rules of a version to which the drift of the property is compared.
+func (p *mockProperty) mockGetRuleTreeDrift(version int, rules papi.Rules) *mock.Call {
+	req := papi.GetRuleTreeRequest{
+		PropertyID:      p.propertyID,
+		GroupID:         p.groupID,
+		ContractID:      p.contractID,
+		PropertyVersion: version,
+	}
+
+	resp := papi.GetRuleTreeResponse{
+		PropertyID:      p.propertyID,
+		PropertyVersion: version,
+		RuleFormat:      p.ruleTree.ruleFormat,
+		Rules:           rules,
+	}
+
+	return p.papiMock.On("GetRuleTree", testutils.MockContext, req).Return(&resp, nil).Once()
+}
+
 func (p *mockProperty) mockGetPropertyVersion() *mock.Call {
 	req := papi.GetPropertyVersionRequest{
 		PropertyID:      p.propertyID,
@@ -489,6 +508,8 @@ func (p *mockProperty) mockGetPropertyVersion() *mock.Call {
 			Note:             p.versions.Items[0].Note,
 			PropertyVersion:  p.versions.Items[0].PropertyVersion,
 			ProductID:        p.productID,
+			UpdatedByUser:    p.versions.Items[0].UpdatedByUser,
+			UpdatedDate:      p.versions.Items[0].UpdatedDate,
 		}
 	}
 
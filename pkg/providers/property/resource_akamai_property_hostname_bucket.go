@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/errgroup"
 )
 
 var (
@@ -52,6 +56,8 @@ type HostnameBucketResourceModel struct {
 	ActivationID         types.String `tfsdk:"activation_id"`
 	ID                   types.String `tfsdk:"id"`
 	Hostnames            types.Map    `tfsdk:"hostnames"`
+	HostnamesFile        types.String `tfsdk:"hostnames_file"`
+	CheckConflicts       types.Bool   `tfsdk:"check_hostname_conflicts"`
 	TimeoutForActivation types.Int64  `tfsdk:"timeout_for_activation"`
 	HostnameCount        types.Int64  `tfsdk:"hostname_count"`
 	PendingDefaultCerts  types.Int64  `tfsdk:"pending_default_certs"`
@@ -177,6 +183,8 @@ var (
 const (
 	// maxHostnamesNumber is the maximum amount of hostnames that can be configured for a hostname bucket.
 	maxHostnamesNumber int = 99999
+	// maxConcurrentHostnameSearches is the maximum amount of added hostnames that are searched for in other properties at once.
+	maxConcurrentHostnameSearches = 10
 	// activationTimeout is the default timeout value for the hostname activation.
 	activationTimeout int64 = 120
 )
@@ -298,7 +306,8 @@ func (h *HostnameBucketResource) Schema(_ context.Context, _ resource.SchemaRequ
 					"during the plan phase about the number of hostnames that will be active after making the changes.",
 			},
 			"hostnames": schema.MapNestedAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cert_provisioning_type": schema.StringAttribute{
@@ -329,9 +338,23 @@ func (h *HostnameBucketResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 				Validators: []validator.Map{
 					mapvalidator.SizeBetween(1, maxHostnamesNumber),
+					mapvalidator.ExactlyOneOf(path.MatchRoot("hostnames_file")),
 				},
 				Description: "The hostnames mapping. The key represents 'cname_from' and the value contains hostnames details, " +
-					"consisting of certificate provisioning type and edge hostname.",
+					"consisting of certificate provisioning type and edge hostname. Either 'hostnames' or 'hostnames_file' must be provided.",
+			},
+			"hostnames_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a CSV or JSON file with the hostnames, used instead of the 'hostnames' mapping. " +
+					"A CSV file starts with a header naming the 'cname_from', 'edge_hostname_id' and 'cert_provisioning_type' columns, " +
+					"a JSON file contains an array of objects with these members.",
+			},
+			"check_hostname_conflicts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether the hostnames added to the bucket are searched for in other properties of the account " +
+					"during the plan. Hostnames active in other properties on the same network fail the plan. Defaults to false.",
 			},
 			"pending_default_certs": schema.Int64Attribute{
 				Computed:    true,
@@ -343,17 +366,42 @@ func (h *HostnameBucketResource) Schema(_ context.Context, _ resource.SchemaRequ
 
 // ModifyPlan performs plan modification on a resource level.
 func (h *HostnameBucketResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if request.Plan.Raw.IsNull() || request.State.Raw.IsNull() {
+	if request.Plan.Raw.IsNull() {
 		return
 	}
 
 	var state, plan *HostnameBucketResourceModel
-	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
-	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
-	if response.Diagnostics.HasError() {
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Plan the hostnames read from the file, as if they were configured in the `hostnames` attribute.
+	if !plan.HostnamesFile.IsNull() && !plan.HostnamesFile.IsUnknown() {
+		response.Diagnostics.Append(plan.setFileHostnames(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("hostnames"), plan.Hostnames)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.CheckConflicts.ValueBool() {
+		response.Diagnostics.Append(checkHostnameConflicts(ctx, Client(h.meta), plan, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state == nil {
 		return
 	}
 
@@ -427,7 +475,7 @@ func (m hostnamesPlanModifier) MarkdownDescription(ctx context.Context) string {
 }
 
 func (m hostnamesPlanModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
-	if req.StateValue.IsNull() || req.PlanValue.IsNull() {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
 		return
 	}
 
@@ -443,6 +491,18 @@ func (m hostnamesPlanModifier) PlanModifyMap(ctx context.Context, req planmodifi
 		return
 	}
 
+	useStateCnameTo(planHostnames, stateHostnames)
+
+	planHostnamesValue, diags := types.MapValueFrom(ctx, hostnameObjectType, planHostnames)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = planHostnamesValue
+}
+
+// useStateCnameTo sets the `cname_to` attribute of the planned hostnames, which do not change, to its state value.
+func useStateCnameTo(planHostnames, stateHostnames map[string]Hostname) {
 	for cnameFrom, planHostname := range planHostnames {
 		if stateHostname, ok := stateHostnames[cnameFrom]; ok {
 			if planHostname.equal(stateHostname) {
@@ -451,13 +511,116 @@ func (m hostnamesPlanModifier) PlanModifyMap(ctx context.Context, req planmodifi
 			}
 		}
 	}
+}
 
-	planHostnamesValue, diags := types.MapValueFrom(ctx, hostnameObjectType, planHostnames)
-	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
-		return
+// setFileHostnames sets the planned hostnames to the ones read from the `hostnames_file`.
+func (m *HostnameBucketResourceModel) setFileHostnames(ctx context.Context, state *HostnameBucketResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planHostnames, err := readHostnamesFile(m.HostnamesFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("hostnames_file"), "Invalid hostnames file", err.Error())
+		return diags
+	}
+	if state != nil && !state.Hostnames.IsNull() {
+		var stateHostnames map[string]Hostname
+		diags.Append(state.Hostnames.ElementsAs(ctx, &stateHostnames, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		useStateCnameTo(planHostnames, stateHostnames)
 	}
 
-	resp.PlanValue = planHostnamesValue
+	hostnames, d := types.MapValueFrom(ctx, hostnameObjectType, planHostnames)
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+	m.Hostnames = hostnames
+	return diags
+}
+
+// checkHostnameConflicts searches for the hostnames, which are added to the bucket, in the other properties of the account.
+// Hostnames active on the network of the bucket in another property cannot be added, so they are reported as errors,
+// the hostnames used only by versions of other properties, which are not active on the network, as warnings.
+func checkHostnameConflicts(ctx context.Context, client papi.PAPI, plan, state *HostnameBucketResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Hostnames.IsUnknown() || plan.Hostnames.IsNull() {
+		return diags
+	}
+
+	var planHostnames, stateHostnames map[string]Hostname
+	diags.Append(plan.Hostnames.ElementsAs(ctx, &planHostnames, false)...)
+	if state != nil && !state.Hostnames.IsNull() {
+		diags.Append(state.Hostnames.ElementsAs(ctx, &stateHostnames, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// hostnames are case-insensitive, so each of them is searched once
+	added := make(map[string]struct{})
+	for cnameFrom := range planHostnames {
+		if _, ok := stateHostnames[cnameFrom]; !ok {
+			added[strings.ToLower(cnameFrom)] = struct{}{}
+		}
+	}
+	for cnameFrom := range stateHostnames {
+		delete(added, strings.ToLower(cnameFrom))
+	}
+	searched := slices.Sorted(maps.Keys(added))
+
+	// results are not cached, as other properties may start using the hostnames at any time
+	results := make([]*papi.SearchResponse, len(searched))
+	g, groupCtx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentHostnameSearches)
+	for i, cnameFrom := range searched {
+		g.Go(func() error {
+			tflog.Debug(groupCtx, "searching for hostname in other properties", map[string]any{"cname_from": cnameFrom})
+			res, err := client.SearchProperties(groupCtx, papi.SearchRequest{Key: papi.SearchKeyHostname, Value: cnameFrom})
+			if err != nil {
+				return fmt.Errorf("could not search for hostname %s: %s", cnameFrom, err)
+			}
+			results[i] = res
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		diags.AddError("Hostname conflicts check error", err.Error())
+		return diags
+	}
+
+	propertyID := str.AddPrefix(plan.PropertyID.ValueString(), "prp_")
+	network := plan.Network.ValueString()
+	var active, inactive []string
+	for i, cnameFrom := range searched {
+		for _, item := range results[i].Versions.Items {
+			if item.PropertyID == propertyID {
+				continue
+			}
+			status := item.StagingStatus
+			if network == string(papi.ActivationNetworkProduction) {
+				status = item.ProductionStatus
+			}
+			usage := fmt.Sprintf("%s: %s (%s) version %d", cnameFrom, item.PropertyName, item.PropertyID, item.PropertyVersion)
+			if status == string(papi.VersionStatusActive) {
+				active = append(active, usage)
+			} else {
+				inactive = append(inactive, usage)
+			}
+		}
+	}
+
+	if len(active) > 0 {
+		diags.AddAttributeError(path.Root("hostnames"), "Hostnames already attached to other properties",
+			fmt.Sprintf("The following hostnames are active on the %s network in other properties, "+
+				"remove them from these properties first:\n  %s", network, strings.Join(active, "\n  ")))
+	}
+	if len(inactive) > 0 {
+		diags.AddAttributeWarning(path.Root("hostnames"), "Hostnames used by other properties",
+			fmt.Sprintf("The following hostnames are used by versions of other properties, which are not active on the %s network. "+
+				"The activation of the bucket is not affected, unless these versions are activated first:\n  %s", network, strings.Join(inactive, "\n  ")))
+	}
+	return diags
 }
 
 // Create implements resource's Create method.
@@ -731,6 +894,8 @@ func (h *HostnameBucketResource) ImportState(ctx context.Context, req resource.I
 		NotifyEmails:         types.ListUnknown(types.StringType),
 		ID:                   types.StringValue(fmt.Sprintf("%s:%s", prpID, net)),
 		Hostnames:            types.MapNull(hostnameObjectType),
+		HostnamesFile:        types.StringNull(),
+		CheckConflicts:       types.BoolValue(false),
 		TimeoutForActivation: types.Int64Value(activationTimeout),
 		PendingDefaultCerts:  types.Int64Unknown(),
 	}
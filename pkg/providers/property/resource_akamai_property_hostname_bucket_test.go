@@ -85,6 +85,80 @@ func TestHostnameBucketResource_Create(t *testing.T) {
 				Build(),
 			configFile: "1000.tf",
 		},
+		"create with 1000 hostnames and conflicts check, all hostnames are searched": {
+			init: func(p *mockProperty) {
+				p.hostnameBucket = hostnameBucket{
+					plan:         generateHostnames(1000, "CPS_MANAGED", "ehn_444"),
+					network:      "STAGING",
+					notifyEmails: []string{"nomail@akamai.com"},
+					note:         "   ",
+					state:        map[string]Hostname{},
+				}
+				p.propertyID = "prp_111"
+				p.contractID = "ctr_222"
+				p.groupID = "grp_333"
+				// Plan
+				for hostname := range p.hostnameBucket.plan {
+					mockSearchHostname(p, hostname)
+				}
+				// Create
+				mockResourceHostnameBucketUpsert(p)
+				// Read
+				mockResourceHostnameBucketRead(p)
+				// Delete
+				mockResourceHostnameBucketDelete(p)
+			},
+			checksForCreate: basicChecker.
+				CheckEqual("hostname_count", "1000").
+				CheckEqual("check_hostname_conflicts", "true").
+				Build(),
+			configFile: "1000_with_conflicts_check.tf",
+		},
+		"create with 1 hostname from CSV file on STAGING": {
+			init: func(p *mockProperty) {
+				// Set up initial data for the property and hostname bucket
+				setUpInitialData(p, true)
+				// Create
+				mockResourceHostnameBucketUpsert(p)
+				// Read
+				mockResourceHostnameBucketRead(p)
+				// Delete
+				mockResourceHostnameBucketDelete(p)
+			},
+			checksForCreate: basicChecker.
+				CheckEqual("hostnames_file", "testdata/TestResPropertyHostnameBucket/create/hostnames.csv").
+				CheckEqual("hostnames.www.test.hostname.0.com.edgesuite.net.cname_to", "www.test.hostname.0.cnameTo.com.edgesuite.net").
+				Build(),
+			configFile: "1_from_file.tf",
+		},
+		"create with 1 hostname and conflicts check, hostname used by inactive version of other property": {
+			init: func(p *mockProperty) {
+				// Set up initial data for the property and hostname bucket
+				setUpInitialData(p, true)
+				// Plan
+				mockSearchHostname(p, "www.test.hostname.0.com.edgesuite.net",
+					papi.SearchItem{PropertyID: "prp_111", PropertyName: "bucket", PropertyVersion: 1, StagingStatus: "ACTIVE"},
+					papi.SearchItem{PropertyID: "prp_999", PropertyName: "other", PropertyVersion: 3, StagingStatus: "INACTIVE", ProductionStatus: "ACTIVE"})
+				// Create
+				mockResourceHostnameBucketUpsert(p)
+				// Read
+				mockResourceHostnameBucketRead(p)
+				// Delete
+				mockResourceHostnameBucketDelete(p)
+			},
+			checksForCreate: basicChecker.
+				CheckEqual("check_hostname_conflicts", "true").
+				Build(),
+			configFile: "1_with_conflicts_check.tf",
+		},
+		"expect error - conflicts check, hostname active in other property": {
+			init: func(p *mockProperty) {
+				mockSearchHostname(p, "www.test.hostname.0.com.edgesuite.net",
+					papi.SearchItem{PropertyID: "prp_999", PropertyName: "other", PropertyVersion: 3, StagingStatus: "ACTIVE"})
+			},
+			expectError: regexp.MustCompile(`(?s)The following hostnames are active on the STAGING network in other\s+properties.+www.test.hostname.0.com.edgesuite.net: other \(prp_999\) version 3`),
+			configFile:  "1_with_conflicts_check.tf",
+		},
 		"create with 1 hostname without prefixes on STAGING": {
 			init: func(p *mockProperty) {
 				// Set up initial data for the property and hostname bucket
@@ -655,6 +729,39 @@ func TestHostnameBucketResource_Update(t *testing.T) {
 			createConfig: "1.tf",
 			updateConfig: "add_3.tf",
 		},
+		"create 1, update by adding 1 hostname from JSON file": {
+			init: func(p *mockProperty) {
+				// Set up initial data for the property and hostname bucket
+				setUpInitialData(p, true)
+				// Create
+				mockResourceHostnameBucketUpsert(p)
+				// Read x2
+				mockResourceHostnameBucketRead(p, 2)
+				// Update
+				p.hostnameBucket.plan["www.test.hostname.1.com.edgesuite.net"] = Hostname{
+					CertProvisioningType: types.StringValue("DEFAULT"),
+					EdgeHostnameID:       types.StringValue("ehn_555"),
+					CnameTo:              types.StringValue("www.test.hostname.1.cnameTo.com.edgesuite.net"),
+				}
+				mockResourceHostnameBucketUpsert(p)
+				// Read
+				mockResourceHostnameBucketRead(p)
+				// Delete
+				mockResourceHostnameBucketDelete(p)
+			},
+			checksForCreate: basicChecker.Build(),
+			checksForUpdate: basicChecker.
+				CheckEqual("hostnames_file", "testdata/TestResPropertyHostnameBucket/update/hostnames.json").
+				CheckEqual("hostnames.www.test.hostname.1.com.edgesuite.net.edge_hostname_id", "ehn_555").
+				CheckEqual("hostnames.www.test.hostname.1.com.edgesuite.net.cert_provisioning_type", "DEFAULT").
+				CheckEqual("hostnames.www.test.hostname.1.com.edgesuite.net.cname_to", "www.test.hostname.1.cnameTo.com.edgesuite.net").
+				CheckEqual("activation_id", "act_0_update").
+				CheckEqual("hostname_count", "2").
+				CheckEqual("pending_default_certs", "1").
+				Build(),
+			createConfig: "1.tf",
+			updateConfig: "hostnames_file.tf",
+		},
 		"create 1, update by adding 3 hostnames without group_id and contract_id, but receive the values from the API": {
 			init: func(p *mockProperty) {
 				p.hostnameBucket = hostnameBucket{
@@ -1416,7 +1523,25 @@ func TestHostnameBucketResource_ValidationErrors(t *testing.T) {
 			steps: []resource.TestStep{
 				{
 					Config:      testutils.LoadFixtureStringf(t, "testdata/TestResPropertyHostnameBucket/validation/no_hostnames.tf"),
-					ExpectError: regexp.MustCompile(`The argument "hostnames" is required, but no definition was found.`),
+					ExpectError: regexp.MustCompile(`No attribute specified when one \(and only one\) of \[hostnames_file\] is`),
+				},
+			},
+		},
+		"validation error - create with hostnames and hostnames_file": {
+			init: func(_ *mockProperty) {},
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureStringf(t, "testdata/TestResPropertyHostnameBucket/validation/hostnames_and_file.tf"),
+					ExpectError: regexp.MustCompile(`2 attributes specified when one \(and only one\) of \[hostnames_file\] is`),
+				},
+			},
+		},
+		"validation error - create with invalid hostnames_file": {
+			init: func(_ *mockProperty) {},
+			steps: []resource.TestStep{
+				{
+					Config:      testutils.LoadFixtureStringf(t, "testdata/TestResPropertyHostnameBucket/validation/invalid_hostnames_file.tf"),
+					ExpectError: regexp.MustCompile(`must have\s+either the .csv or the .json extension`),
 				},
 			},
 		},
@@ -2106,6 +2231,13 @@ func generateHostnames(n int, certType, ehn string) map[string]Hostname {
 	return hostnames
 }
 
+func mockSearchHostname(p *mockProperty, hostname string, items ...papi.SearchItem) {
+	p.papiMock.On("SearchProperties", testutils.MockContext, papi.SearchRequest{
+		Key:   papi.SearchKeyHostname,
+		Value: hostname,
+	}).Return(&papi.SearchResponse{Versions: papi.SearchItems{Items: items}}, nil)
+}
+
 func createDefaultPatchPropertyHostnameBucketRequest() papi.PatchPropertyHostnameBucketRequest {
 	return papi.PatchPropertyHostnameBucketRequest{
 		PropertyID: "prp_111",
@@ -35,6 +35,7 @@ func resourcePropertyIncludeActivation() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePropertyIncludeActivationImport,
 		},
+		CustomizeDiff: parentActivationsCustomDiff,
 		Schema: map[string]*schema.Schema{
 			"include_id": {
 				Type:        schema.TypeString,
@@ -99,6 +100,19 @@ func resourcePropertyIncludeActivation() *schema.Resource {
 				Computed:    true,
 				Description: "The validation information in JSON format",
 			},
+			"parent_versions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "The versions of parent properties which use the include, by property ID, activated after the include. " +
+					"Rule trees of these versions are validated against the include version before anything is activated",
+			},
+			"parent_activations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the parent properties activated along with the include",
+				Elem:        includeParentActivationSchema,
+			},
 			"compliance_record": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -327,6 +341,18 @@ func resourcePropertyIncludeActivationUpsert(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	var parents []includeParent
+	if len(activationResourceData.parentVersions) > 0 {
+		logger.Debug("validating parents of the include")
+		var err error
+		if parents, err = findIncludeParents(ctx, client, activationResourceData); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = validateIncludeParents(ctx, client, activationResourceData, parents); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	logger.Debug("waiting for pending activations")
 	if diagErr := waitUntilNoPendingActivationInNetwork(ctx, client, activationResourceData); diagErr != nil {
 		return diagErr
@@ -338,25 +364,34 @@ func resourcePropertyIncludeActivationUpsert(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 	if expectedIsActive {
-		// we are done here
 		logger.Debug("include version already active")
-		d.SetId(fmt.Sprintf("%s:%s:%s:%s", activationResourceData.contractID, activationResourceData.groupID, activationResourceData.includeID, activationResourceData.network))
-		return nil
-	}
+	} else {
+		logger.Debug("creating new activation")
+		diagErr := createNewActivation(ctx, client, activationResourceData)
+		if diagErr != nil {
+			return diagErr
+		}
 
-	logger.Debug("creating new activation")
-	diagErr := createNewActivation(ctx, client, activationResourceData)
-	if diagErr != nil {
-		return diagErr
+		logger.Debug("waiting for pending activations")
+		if diagErr := waitUntilNoPendingActivationInNetwork(ctx, client, activationResourceData); err != nil {
+			return diagErr
+		}
 	}
 
-	logger.Debug("waiting for pending activations")
-	if diagErr := waitUntilNoPendingActivationInNetwork(ctx, client, activationResourceData); err != nil {
-		return diagErr
+	if len(parents) == 0 {
+		d.SetId(activationResourceData.id())
+		if err := d.Set("parent_activations", nil); err != nil {
+			return diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))
+		}
+		return nil
 	}
-
-	d.SetId(fmt.Sprintf("%s:%s:%s:%s", activationResourceData.contractID, activationResourceData.groupID, activationResourceData.includeID, activationResourceData.network))
-	return nil
+	diags := activateIncludeParents(ctx, client, d, activationResourceData, parents)
+	// a resource created with failed parent activations would be tainted and replaced, which deactivates the include,
+	// so it is not stored; the next apply finds the include active and activates the parents again
+	if !diags.HasError() || d.Id() != "" {
+		d.SetId(activationResourceData.id())
+	}
+	return diags
 }
 
 type propertyIncludeActivationData struct {
@@ -369,6 +404,12 @@ type propertyIncludeActivationData struct {
 	note             string
 	acknowledgement  bool
 	complianceRecord []any
+	parentVersions   map[string]int
+}
+
+// id returns the ID of the resource
+func (p *propertyIncludeActivationData) id() string {
+	return fmt.Sprintf("%s:%s:%s:%s", p.contractID, p.groupID, p.includeID, p.network)
 }
 
 func (p *propertyIncludeActivationData) populateFromResource(d *schema.ResourceData) error {
@@ -413,6 +454,14 @@ func (p *propertyIncludeActivationData) populateFromResource(d *schema.ResourceD
 	if err != nil && !errors.Is(err, tf.ErrNotFound) {
 		return err
 	}
+	parentVersions, err := tf.GetMapValue("parent_versions", d)
+	if err != nil && !errors.Is(err, tf.ErrNotFound) {
+		return err
+	}
+	p.parentVersions = make(map[string]int, len(parentVersions))
+	for propertyID, version := range parentVersions {
+		p.parentVersions[str.AddPrefix(propertyID, "prp_")] = version.(int)
+	}
 	return nil
 }
 
@@ -0,0 +1,295 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	parentActivationStatusActivated     = "ACTIVATED"
+	parentActivationStatusAlreadyActive = "ALREADY_ACTIVE"
+	parentActivationStatusFailed        = "FAILED"
+)
+
+var (
+	includeParentActivationSchema = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"property_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the parent property",
+			},
+			"property_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the parent property",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the parent property activated along with the include",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "'ACTIVATED', 'ALREADY_ACTIVE' when the version was active on the network already, or 'FAILED'",
+			},
+			"activation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the activation of the parent version",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason of the failed activation",
+			},
+		},
+	}
+
+	userVariableReference = regexp.MustCompile(`\{\{user\.(PMUSER_[A-Z0-9_]+)\}\}|"variableName":"(PMUSER_[A-Z0-9_]+)"`)
+)
+
+// includeParent is a version of a parent property which uses the include
+type includeParent struct {
+	property papi.Property
+	version  int
+	active   bool
+}
+
+// findIncludeParents returns the parent versions configured in parent_versions. Each of them has to be a version
+// of a parent property returned by the parents API, which uses the include. Their activation depends on
+// the include, so they are activated after it.
+func findIncludeParents(ctx context.Context, client papi.PAPI, data propertyIncludeActivationData) ([]includeParent, error) {
+	logger := log.FromContext(ctx)
+
+	resp, err := client.ListIncludeParents(ctx, papi.ListIncludeParentsRequest{
+		ContractID: data.contractID,
+		GroupID:    data.groupID,
+		IncludeID:  data.includeID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list parents of include %s: %w", data.includeID, err)
+	}
+	items := make(map[string]papi.ParentProperty, len(resp.Properties.Items))
+	for _, item := range resp.Properties.Items {
+		items[item.PropertyID] = item
+	}
+
+	var parents []includeParent
+	for _, propertyID := range slices.Sorted(maps.Keys(data.parentVersions)) {
+		version := data.parentVersions[propertyID]
+		item, ok := items[propertyID]
+		if !ok {
+			return nil, fmt.Errorf("property %s is not a parent of include %s", propertyID, data.includeID)
+		}
+		property, err := fetchLatestProperty(ctx, client, item.PropertyID, item.GroupID, item.ContractID)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch parent property %s: %w", item.PropertyID, err)
+		}
+		used, err := isIncPresentInReferencedIncludes(ctx, client, papi.ListReferencedIncludesRequest{
+			PropertyID:      property.PropertyID,
+			PropertyVersion: version,
+			ContractID:      item.ContractID,
+			GroupID:         item.GroupID,
+		}, data.includeID)
+		if err != nil {
+			return nil, fmt.Errorf("could not list includes of parent property %s: %w", item.PropertyID, err)
+		}
+		if !used {
+			return nil, fmt.Errorf("version %d of parent property %s does not use include %s", version, item.PropertyID, data.includeID)
+		}
+
+		activeVersion := item.StagingVersion
+		if data.network == string(papi.ActivationNetworkProduction) {
+			activeVersion = item.ProductionVersion
+		}
+		active := activeVersion != nil && *activeVersion == version
+		if active {
+			logger.Debugf("version %d of parent property %s is already active", version, item.PropertyID)
+		}
+		parents = append(parents, includeParent{
+			property: *property,
+			version:  version,
+			active:   active,
+		})
+	}
+	return parents, nil
+}
+
+// validateIncludeParents checks the rule trees of the parents against the activated include version: the parents must
+// not have rule errors, must use the rule format of the include and must define the user variables the include refers
+// to, but does not define itself. All problems are reported before anything is activated.
+func validateIncludeParents(ctx context.Context, client papi.PAPI, data propertyIncludeActivationData, parents []includeParent) error {
+	include, err := client.GetIncludeRuleTree(ctx, papi.GetIncludeRuleTreeRequest{
+		ContractID:     data.contractID,
+		GroupID:        data.groupID,
+		IncludeID:      data.includeID,
+		IncludeVersion: data.version,
+	})
+	if err != nil {
+		return fmt.Errorf("could not fetch rules of include %s version %d: %w", data.includeID, data.version, err)
+	}
+	includeVariables := ruleTreeVariables(include.Rules)
+	references, err := referencedUserVariables(include.Rules)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	for _, parent := range parents {
+		rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
+			PropertyID:      parent.property.PropertyID,
+			PropertyVersion: parent.version,
+			ContractID:      parent.property.ContractID,
+			GroupID:         parent.property.GroupID,
+			ValidateMode:    papi.RuleValidateModeFull,
+			ValidateRules:   true,
+		})
+		if err != nil {
+			return fmt.Errorf("could not fetch rules of parent property %s: %w", parent.property.PropertyID, err)
+		}
+
+		problem := func(format string, args ...any) {
+			problems = append(problems, fmt.Sprintf("%s (%s) version %d: ", parent.property.PropertyName,
+				parent.property.PropertyID, parent.version)+fmt.Sprintf(format, args...))
+		}
+		for _, ruleError := range rules.Errors {
+			problem("rule error at %s: %s", ruleError.ErrorLocation, ruleError.Detail)
+		}
+		if include.RuleFormat != rules.RuleFormat && include.RuleFormat != "latest" && rules.RuleFormat != "latest" {
+			problem("uses rule format %s, but the include uses %s", rules.RuleFormat, include.RuleFormat)
+		}
+		parentVariables := ruleTreeVariables(rules.Rules)
+		for _, name := range references {
+			if !includeVariables[name] && !parentVariables[name] {
+				problem("does not define variable %s used by the include", name)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("include %s version %d cannot be activated with its parents:\n  %s",
+			data.includeID, data.version, strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// ruleTreeVariables returns the names of the variables defined in the rule and its children
+func ruleTreeVariables(rules papi.Rules) map[string]bool {
+	variables := map[string]bool{}
+	var collect func(rule papi.Rules)
+	collect = func(rule papi.Rules) {
+		for _, variable := range rule.Variables {
+			variables[variable.Name] = true
+		}
+		for _, child := range rule.Children {
+			collect(child)
+		}
+	}
+	collect(rules)
+	return variables
+}
+
+// referencedUserVariables returns the sorted names of the user variables referred to by option values of the rules
+func referencedUserVariables(rules papi.Rules) ([]string, error) {
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, match := range userVariableReference.FindAllStringSubmatch(string(encoded), -1) {
+		names = append(names, match[1]+match[2])
+	}
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+// activateIncludeParents activates the parent versions on the network of the include activation, and records
+// the status of every parent in the parent_activations attribute. Failed activations fail the apply, and are
+// retried on the next one.
+func activateIncludeParents(ctx context.Context, client papi.PAPI, d *schema.ResourceData, data propertyIncludeActivationData, parents []includeParent) diag.Diagnostics {
+	logger := log.FromContext(ctx)
+
+	var diags diag.Diagnostics
+	var activations []interface{}
+	for _, parent := range parents {
+		activation := map[string]interface{}{
+			"property_id":   parent.property.PropertyID,
+			"property_name": parent.property.PropertyName,
+			"version":       parent.version,
+			"status":        parentActivationStatusAlreadyActive,
+		}
+		activations = append(activations, activation)
+		if parent.active {
+			continue
+		}
+
+		request := papi.CreateActivationRequest{
+			PropertyID: parent.property.PropertyID,
+			ContractID: parent.property.ContractID,
+			GroupID:    parent.property.GroupID,
+			Activation: papi.Activation{
+				ActivationType:         papi.ActivationTypeActivate,
+				Network:                papi.ActivationNetwork(data.network),
+				PropertyVersion:        parent.version,
+				NotifyEmails:           data.notifyEmails,
+				AcknowledgeAllWarnings: data.acknowledgement,
+				Note:                   data.note,
+			},
+		}
+		if request.Activation.Network == papi.ActivationNetworkProduction {
+			request = addPropertyComplianceRecord(data.complianceRecord, request)
+		}
+
+		logger.Infof("activating version %d of parent property %s", parent.version, parent.property.PropertyID)
+		result, activateDiags := activatePropertyVersion(ctx, client, request)
+		if result != nil {
+			activation["activation_id"] = result.ActivationID
+		}
+		if activateDiags.HasError() {
+			var reasons []string
+			for _, d := range activateDiags {
+				reasons = append(reasons, d.Summary)
+			}
+			activation["status"] = parentActivationStatusFailed
+			activation["error"] = strings.Join(reasons, ", ")
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("activation of parent property %s version %d failed", parent.property.PropertyID, parent.version),
+				Detail:   fmt.Sprintf("%s. The include is active, the activation of the parent is retried on the next apply.", activation["error"]),
+			})
+			continue
+		}
+		activation["status"] = parentActivationStatusActivated
+	}
+
+	if err := d.Set("parent_activations", activations); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("%w: %s", tf.ErrValueSet, err.Error()))...)
+	}
+	return diags
+}
+
+// parentActivationsCustomDiff plans the activation of the parents again, when some of them failed to activate
+func parentActivationsCustomDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || len(d.Get("parent_versions").(map[string]interface{})) == 0 {
+		return nil
+	}
+	for _, activation := range d.Get("parent_activations").([]interface{}) {
+		if activation.(map[string]interface{})["status"] == parentActivationStatusFailed {
+			return d.SetNewComputed("parent_activations")
+		}
+	}
+	return nil
+}
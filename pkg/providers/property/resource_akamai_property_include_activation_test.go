@@ -7,10 +7,12 @@ import (
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/ptr"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/testutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
@@ -1418,6 +1420,233 @@ func TestResourcePropertyIncludeActivation(t *testing.T) {
 
 		client.AssertExpectations(t)
 	})
+
+	parentVersions := map[string]int{"prp_1": 2, "prp_2": 4}
+
+	var (
+		expectFindParents = func(client *papi.Mock, parentVersions map[string]int) {
+			client.On("ListIncludeParents", testutils.MockContext, papi.ListIncludeParentsRequest{
+				ContractID: contractID,
+				GroupID:    groupID,
+				IncludeID:  includeID,
+			}).Return(&papi.ListIncludeParentsResponse{Properties: papi.ParentPropertyItems{Items: []papi.ParentProperty{
+				{PropertyID: "prp_1", PropertyName: "parent1", ContractID: contractID, GroupID: groupID, StagingVersion: ptr.To(1)},
+				{PropertyID: "prp_2", PropertyName: "parent2", ContractID: contractID, GroupID: groupID, StagingVersion: ptr.To(4)},
+				{PropertyID: "prp_3", PropertyName: "parent3", ContractID: contractID, GroupID: groupID},
+			}}}, nil).Once()
+
+			// the latest version of prp_1 is not the one activated with the include
+			latestVersions := map[string]int{"prp_1": 3, "prp_2": 4, "prp_3": 7}
+			for propertyID, version := range parentVersions {
+				client.On("GetProperty", testutils.MockContext, papi.GetPropertyRequest{
+					PropertyID: propertyID,
+					ContractID: contractID,
+					GroupID:    groupID,
+				}).Return(&papi.GetPropertyResponse{Property: &papi.Property{
+					PropertyID:    propertyID,
+					PropertyName:  strings.Replace(propertyID, "prp_", "parent", 1),
+					ContractID:    contractID,
+					GroupID:       groupID,
+					LatestVersion: latestVersions[propertyID],
+				}}, nil).Once()
+
+				// version 7 of prp_3 does not use the include anymore
+				referencedInclude := includeID
+				if propertyID == "prp_3" {
+					referencedInclude = "inc_other"
+				}
+				client.On("ListReferencedIncludes", testutils.MockContext, papi.ListReferencedIncludesRequest{
+					PropertyID:      propertyID,
+					PropertyVersion: version,
+					ContractID:      contractID,
+					GroupID:         groupID,
+				}).Return(&papi.ListReferencedIncludesResponse{Includes: papi.IncludeItems{Items: []papi.Include{
+					{IncludeID: referencedInclude},
+				}}}, nil).Once()
+			}
+		}
+
+		expectValidateParents = func(client *papi.Mock, parent1 *papi.GetRuleTreeResponse) {
+			client.On("GetIncludeRuleTree", testutils.MockContext, papi.GetIncludeRuleTreeRequest{
+				ContractID:     contractID,
+				GroupID:        groupID,
+				IncludeID:      includeID,
+				IncludeVersion: 3,
+			}).Return(&papi.GetIncludeRuleTreeResponse{
+				IncludeID:  includeID,
+				RuleFormat: "v2024-01-09",
+				Rules: papi.Rules{
+					Name: "default",
+					Behaviors: []papi.RuleBehavior{
+						{Name: "origin", Options: papi.RuleOptionsMap{"hostname": "{{user.PMUSER_ORIGIN}}"}},
+						{Name: "setVariable", Options: papi.RuleOptionsMap{"variableName": "PMUSER_PATH"}},
+					},
+					Variables: []papi.RuleVariable{{Name: "PMUSER_PATH"}},
+				},
+			}, nil).Once()
+
+			parent := &papi.GetRuleTreeResponse{
+				RuleFormat: "v2024-01-09",
+				Rules:      papi.Rules{Name: "default", Variables: []papi.RuleVariable{{Name: "PMUSER_ORIGIN"}}},
+			}
+			for propertyID, rules := range map[string]*papi.GetRuleTreeResponse{"prp_1": parent1, "prp_2": parent} {
+				client.On("GetRuleTree", testutils.MockContext, papi.GetRuleTreeRequest{
+					PropertyID:      propertyID,
+					PropertyVersion: parentVersions[propertyID],
+					ContractID:      contractID,
+					GroupID:         groupID,
+					ValidateMode:    papi.RuleValidateModeFull,
+					ValidateRules:   true,
+				}).Return(rules, nil).Once()
+			}
+		}
+
+		parentActivationReq = papi.CreateActivationRequest{
+			PropertyID: "prp_1",
+			ContractID: contractID,
+			GroupID:    groupID,
+			Activation: papi.Activation{
+				ActivationType:  papi.ActivationTypeActivate,
+				Network:         papi.ActivationNetworkStaging,
+				PropertyVersion: 2,
+				NotifyEmails:    []string{email},
+				Note:            note,
+			},
+		}
+	)
+
+	t.Run("activate include with its parents", func(t *testing.T) {
+		client := new(papi.Mock)
+		state := State{}
+
+		expectFindParents(client, parentVersions)
+		expectValidateParents(client, &papi.GetRuleTreeResponse{
+			RuleFormat: "v2024-01-09",
+			Rules:      papi.Rules{Name: "default", Variables: []papi.RuleVariable{{Name: "PMUSER_ORIGIN"}}},
+		})
+		state = expectCreate(client, state, activateIncludeReq("STAGING", false))
+
+		// only the parent whose version is not active yet is activated, after the include
+		client.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+			Return(&papi.GetActivationsResponse{}, nil).Once()
+		client.On("CreateActivation", testutils.MockContext, parentActivationReq).
+			Return(&papi.CreateActivationResponse{ActivationID: "atv_parent"}, nil).Once()
+		client.On("GetActivation", testutils.MockContext, papi.GetActivationRequest{PropertyID: "prp_1", ActivationID: "atv_parent"}).
+			Return(&papi.GetActivationResponse{Activation: &papi.Activation{
+				ActivationID:    "atv_parent",
+				ActivationType:  papi.ActivationTypeActivate,
+				Network:         papi.ActivationNetworkStaging,
+				PropertyVersion: 2,
+				Status:          papi.ActivationStatusActive,
+			}}, nil).Once()
+
+		expectRead(client, state, papi.ActivationNetworkStaging)
+		expectRead(client, state, papi.ActivationNetworkStaging)
+		_ = expectDelete(client, state, deactivateIncludeReq("STAGING", false))
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureStringf(t, "%s/property_include_activation_parents.tf", testDir),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_versions.%", "2"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_versions.prp_1", "2"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.#", "2"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.0.property_id", "prp_1"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.0.property_name", "parent1"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.0.version", "2"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.0.status", "ACTIVATED"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.0.activation_id", "atv_parent"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.1.property_id", "prp_2"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.1.version", "4"),
+							resource.TestCheckResourceAttr("akamai_property_include_activation.activation", "parent_activations.1.status", "ALREADY_ACTIVE"),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("failed parent activation fails the apply", func(t *testing.T) {
+		client := new(papi.Mock)
+		state := State{}
+
+		expectFindParents(client, parentVersions)
+		expectValidateParents(client, &papi.GetRuleTreeResponse{
+			RuleFormat: "latest",
+			Rules:      papi.Rules{Name: "default", Variables: []papi.RuleVariable{{Name: "PMUSER_ORIGIN"}}},
+		})
+		_ = expectCreate(client, state, activateIncludeReq("STAGING", false))
+
+		client.On("GetActivations", testutils.MockContext, papi.GetActivationsRequest{PropertyID: "prp_1"}).
+			Return(&papi.GetActivationsResponse{}, nil).Once()
+		client.On("CreateActivation", testutils.MockContext, parentActivationReq).
+			Return(nil, &papi.Error{StatusCode: 400, Detail: "hostname is not valid"}).Once()
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureStringf(t, "%s/property_include_activation_parents.tf", testDir),
+						ExpectError: regexp.MustCompile("activation of parent property prp_1 version 2 failed"),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("parent version which does not use the include", func(t *testing.T) {
+		client := new(papi.Mock)
+
+		expectFindParents(client, map[string]int{"prp_3": 7})
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config:      testutils.LoadFixtureStringf(t, "%s/property_include_activation_parents_unused.tf", testDir),
+						ExpectError: regexp.MustCompile("version 7 of parent property prp_3 does not use include inc_12345"),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("parent validation fails before activation", func(t *testing.T) {
+		client := new(papi.Mock)
+
+		expectFindParents(client, parentVersions)
+		expectValidateParents(client, &papi.GetRuleTreeResponse{
+			Response: papi.Response{Errors: []*papi.Error{
+				{ErrorLocation: "#/rules/behaviors/0", Detail: "The include is not compatible with the product"},
+			}},
+			RuleFormat: "v2023-01-05",
+			Rules:      papi.Rules{Name: "default"},
+		})
+
+		useClient(client, nil, func() {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+				Steps: []resource.TestStep{
+					{
+						Config: testutils.LoadFixtureStringf(t, "%s/property_include_activation_parents.tf", testDir),
+						ExpectError: regexp.MustCompile(`(?s)include inc_12345 version 3 cannot be activated with its parents:` +
+							`\s+parent1 \(prp_1\) version 2: rule error at #/rules/behaviors/0: The include is not compatible with the product` +
+							`\s+parent1 \(prp_1\) version 2: uses rule format v2023-01-05, but the include uses v2024-01-09` +
+							`\s+parent1 \(prp_1\) version 2: does not define variable PMUSER_ORIGIN used by the include`),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+	})
 }
 
 func TestReadTimeoutFromEnvOrDefault(t *testing.T) {
@@ -1308,8 +1308,9 @@ func TestPropertyLifecycle(t *testing.T) {
 				},
 			},
 		}
-		// read x1 - remote, updated state
+		// read x1 - remote, updated state, reporting version 2 as drift
 		mockResourcePropertyRead(mp)
+		mp.mockGetRuleTreeDrift(1, mp.ruleTree.rules)
 		// update
 		mp.mockGetPropertyVersion()
 		// such drift should invoke update function, which should use value from config which should replace the remote value.
@@ -1343,6 +1344,8 @@ func TestPropertyLifecycle(t *testing.T) {
 						Config: testutils.LoadFixtureString(t, "testdata/TestResProperty/Lifecycle/new version changed on server/step0.tf"),
 						Check: defaultChecker.
 							CheckEqual("latest_version", "2").
+							CheckEqual("managed_version", "2").
+							CheckEqual("drift.#", "0").
 							Build(),
 					},
 				},
@@ -2440,6 +2443,7 @@ func TestSchemaConfiguration(t *testing.T) {
 	t.Run("Schema Configuration Error: invalid json rules", assertConfigError("invalid json rules", `rules are not valid JSON`))
 	t.Run("Schema Configuration Error: invalid name given", assertConfigError("invalid name given", `a name must only contain letters, numbers, and these characters: . _ -`))
 	t.Run("Schema Configuration Error: name given too long", assertConfigError("name given too long", `a name must be longer than 0 characters and shorter than 86 characters`))
+	t.Run("Schema Configuration Error: rules not valid for rule format", assertConfigError("rules not valid for rule format", `(?s)rules are not valid for rule format v2025-10-16:.*default: behavior gzipResponse.behavior: expected behavior to be one of`))
 }
 
 func TestPropertyResource_VersionNotesLifecycle(t *testing.T) {
@@ -2565,6 +2569,8 @@ func TestPropertyResource_VersionNotesLifecycle(t *testing.T) {
 					Check: checker.
 						CheckEqual("version_notes", "updatedNotes2").
 						CheckEqual("rules", testutils.LoadFixtureStringf(t, "%s/03_expected_rules.json", testdataDir)).
+						CheckEqual("rules_change_summary.#", "1").
+						CheckEqual("rules_change_summary.0", "default: behavior caching.ttl 12d -> 10d").
 						Build(),
 				},
 				{
@@ -2572,6 +2578,7 @@ func TestPropertyResource_VersionNotesLifecycle(t *testing.T) {
 					Check: checker.
 						CheckEqual("version_notes", "Rules_04").
 						CheckEqual("rules", testutils.LoadFixtureStringf(t, "%s/04_expected_rules.json", testdataDir)).
+						CheckEqual("rules_change_summary.#", "0").
 						Build(),
 				},
 				{
@@ -2583,6 +2590,218 @@ func TestPropertyResource_VersionNotesLifecycle(t *testing.T) {
 	})
 }
 
+func TestPropertyResource_RuleFragmentsLifecycle(t *testing.T) {
+	testdataDir := "testdata/TestResProperty/Lifecycle/ruleFragments"
+
+	var rules1And2 papi.RulesUpdate
+	err := json.Unmarshal(testutils.LoadFixtureBytes(t, path.Join(testdataDir, "01_02_rules.json")), &rules1And2)
+	require.NoError(t, err)
+	var rules3 papi.RulesUpdate
+	err = json.Unmarshal(testutils.LoadFixtureBytes(t, path.Join(testdataDir, "03_rules.json")), &rules3)
+	require.NoError(t, err)
+
+	checker := test.NewStateChecker("akamai_property.test").
+		CheckEqual("id", "prp_123").
+		CheckEqual("latest_version", "1").
+		CheckEqual("rule_fragments.#", "1").
+		CheckEqual("rule_fragments.0.name", "static-content")
+
+	papiMock := &papi.Mock{}
+	prp := &mockProperty{
+		mockPropertyData: mockPropertyData{
+			propertyName:  "test_property",
+			groupID:       "grp_123",
+			contractID:    "ctr_123",
+			productID:     "prd_123",
+			propertyID:    "prp_123",
+			latestVersion: 1,
+			versions: papi.PropertyVersionItems{
+				Items: []papi.PropertyVersionGetItem{
+					{
+						StagingStatus:    papi.VersionStatusInactive,
+						ProductionStatus: papi.VersionStatusInactive,
+						PropertyVersion:  1,
+					},
+				},
+			},
+			ruleTree: mockRuleTreeData{
+				rules:      rules1And2.Rules,
+				ruleFormat: "v2023-01-05",
+			},
+		},
+		papiMock: papiMock,
+	}
+
+	// --- step 1 ---
+	// create
+	prp.mockCreateProperty()
+	prp.mockUpdateRuleTree()
+	// read x2
+	mockResourcePropertyRead(prp, 2)
+
+	// --- step 2 --- same config, no diff
+	// refresh x2
+	mockResourcePropertyRead(prp, 2)
+
+	// --- step 3 ---
+	// update with new version of the fragment
+	prp.ruleTree.rules = rules3.Rules
+	mockResourcePropertyRead(prp)
+	prp.mockGetPropertyVersion()
+	prp.mockUpdateRuleTree()
+	// read x2
+	mockResourcePropertyRead(prp, 2)
+
+	// delete
+	prp.mockRemoveProperty()
+
+	useClient(papiMock, nil, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureStringf(t, "%s/01_02_fragment_1_0_0.tf", testdataDir),
+					Check: checker.
+						CheckEqual("rule_fragments.0.version", "1.0.0").
+						Build(),
+				},
+				{
+					Config:   testutils.LoadFixtureStringf(t, "%s/01_02_fragment_1_0_0.tf", testdataDir),
+					PlanOnly: true,
+				},
+				{
+					Config: testutils.LoadFixtureStringf(t, "%s/03_fragment_1_1_0.tf", testdataDir),
+					Check: checker.
+						CheckEqual("rule_fragments.0.version", "1.1.0").
+						CheckEqual("rules_change_summary.0", "default/Static Content: behavior caching.ttl 7d -> 30d").
+						Build(),
+				},
+			},
+		})
+	})
+}
+
+func TestPropertyResource_DriftLifecycle(t *testing.T) {
+	testdataDir := "testdata/TestResProperty/Lifecycle/drift"
+
+	var rules1 papi.RulesUpdate
+	err := json.Unmarshal(testutils.LoadFixtureBytes(t, path.Join(testdataDir, "01_rules.json")), &rules1)
+	require.NoError(t, err)
+	var rules2 papi.RulesUpdate
+	err = json.Unmarshal(testutils.LoadFixtureBytes(t, path.Join(testdataDir, "02_rules.json")), &rules2)
+	require.NoError(t, err)
+
+	checker := test.NewStateChecker("akamai_property.test").
+		CheckEqual("id", "prp_123")
+
+	papiMock := &papi.Mock{}
+	prp := &mockProperty{
+		mockPropertyData: mockPropertyData{
+			propertyName:  "test_property",
+			groupID:       "grp_123",
+			contractID:    "ctr_123",
+			productID:     "prd_123",
+			propertyID:    "prp_123",
+			latestVersion: 1,
+			versions: papi.PropertyVersionItems{
+				Items: []papi.PropertyVersionGetItem{
+					{
+						StagingStatus:    papi.VersionStatusInactive,
+						ProductionStatus: papi.VersionStatusInactive,
+						PropertyVersion:  1,
+					},
+				},
+			},
+			ruleTree: mockRuleTreeData{
+				rules:      rules1.Rules,
+				ruleFormat: "v2023-01-05",
+			},
+		},
+		papiMock: papiMock,
+	}
+
+	// --- step 1 ---
+	// create
+	prp.mockCreateProperty()
+	prp.mockUpdateRuleTree()
+	// read x2
+	mockResourcePropertyRead(prp, 2)
+
+	// --- step 2 --- version 2 is created and activated on staging outside of terraform
+	prp.latestVersion = 2
+	prp.ruleTree.rules = rules2.Rules
+	prp.versions.Items[0] = papi.PropertyVersionGetItem{
+		StagingStatus:    papi.VersionStatusActive,
+		ProductionStatus: papi.VersionStatusInactive,
+		PropertyVersion:  2,
+		UpdatedByUser:    "jsmith",
+		UpdatedDate:      "2025-10-28T15:04:05Z",
+	}
+	// refresh x2
+	mockResourcePropertyRead(prp, 2)
+	prp.mockGetRuleTreeDrift(1, rules1.Rules).Twice()
+
+	// --- step 3 --- update of the rules fails on drift
+	mockResourcePropertyRead(prp)
+	prp.mockGetRuleTreeDrift(1, rules1.Rules)
+
+	// --- step 4 --- rules of version 2 in configuration, the drift is adopted
+	mockResourcePropertyRead(prp)
+	prp.mockGetRuleTreeDrift(1, rules1.Rules)
+	// the update only records managed_version, read x1 without drift
+	mockResourcePropertyRead(prp)
+
+	// delete
+	prp.mockRemoveProperty()
+
+	useClient(papiMock, nil, func() {
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.NewProtoV6ProviderFactory(NewSubprovider()),
+			Steps: []resource.TestStep{
+				{
+					Config: testutils.LoadFixtureStringf(t, "%s/01_02_rules.tf", testdataDir),
+					Check: checker.
+						CheckEqual("managed_version", "1").
+						CheckEqual("drift.#", "0").
+						Build(),
+				},
+				{
+					RefreshState: true,
+					Check: checker.
+						CheckEqual("latest_version", "2").
+						CheckEqual("staging_version", "2").
+						CheckEqual("managed_version", "1").
+						CheckEqual("drift.#", "1").
+						CheckEqual("drift.0.version", "2").
+						CheckEqual("drift.0.detected_in.#", "2").
+						CheckEqual("drift.0.detected_in.0", "latest_version").
+						CheckEqual("drift.0.detected_in.1", "staging_version").
+						CheckEqual("drift.0.updated_by_user", "jsmith").
+						CheckEqual("drift.0.updated_date", "2025-10-28T15:04:05Z").
+						CheckEqual("drift.0.rules_change_summary.#", "1").
+						CheckEqual("drift.0.rules_change_summary.0", "default: behavior caching.ttl 12d -> 30d").
+						Build(),
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config:      testutils.LoadFixtureStringf(t, "%s/03_fail_on_drift.tf", testdataDir),
+					ExpectError: regexp.MustCompile(`property prp_123 was changed outside of Terraform in version\(s\) 2 after\s+version 1 written by Terraform`),
+				},
+				{
+					Config: testutils.LoadFixtureStringf(t, "%s/04_adopt_drift.tf", testdataDir),
+					Check: checker.
+						CheckEqual("fail_on_drift", "true").
+						CheckEqual("latest_version", "2").
+						CheckEqual("managed_version", "2").
+						CheckEqual("drift.#", "0").
+						Build(),
+				},
+			},
+		})
+	})
+	papiMock.AssertExpectations(t)
+}
+
 func TestValidatePropertyName(t *testing.T) {
 	invalidNameCharacters := diag.Errorf("a name must only contain letters, numbers, and these characters: . _ -")
 	invalidNameLength := diag.Errorf("a name must be longer than 0 characters and shorter than 86 characters")
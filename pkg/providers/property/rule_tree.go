@@ -0,0 +1,220 @@
+package property
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+)
+
+// rulePathSeparator separates rule names in a rule path, e.g. "default/Performance/Compression"
+const rulePathSeparator = "/"
+
+var (
+	// ErrRuleNotFound is returned when no rule matches a given rule path
+	ErrRuleNotFound = errors.New("rule not found")
+	// ErrBehaviorNotFound is returned when a rule does not contain a behavior with a given name
+	ErrBehaviorNotFound = errors.New("behavior not found")
+)
+
+// ruleTree is a PAPI rule tree decoded from JSON, which remembers whether it was provided
+// as a rules envelope ({"rules": {...}}) or as a bare rule, so it can be encoded back in the same shape
+type ruleTree struct {
+	papi.RulesUpdate
+	bare bool
+}
+
+// parseRuleTree decodes the rule tree from JSON. Both the PAPI rules envelope and a bare rule are accepted
+func parseRuleTree(rulesJSON string) (*ruleTree, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(rulesJSON), &fields); err != nil {
+		return nil, fmt.Errorf("rules are not valid JSON: %w", err)
+	}
+
+	tree := ruleTree{}
+	if _, ok := fields["rules"]; ok {
+		if err := json.Unmarshal([]byte(rulesJSON), &tree.RulesUpdate); err != nil {
+			return nil, fmt.Errorf("rules are not a valid rule tree: %w", err)
+		}
+		return &tree, nil
+	}
+
+	tree.bare = true
+	if err := json.Unmarshal([]byte(rulesJSON), &tree.Rules); err != nil {
+		return nil, fmt.Errorf("rules are not a valid rule tree: %w", err)
+	}
+	return &tree, nil
+}
+
+// JSON encodes the rule tree back to JSON using the shape it was parsed from
+func (t *ruleTree) JSON() (string, error) {
+	var v any = t.RulesUpdate
+	if t.bare {
+		v = t.Rules
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// walkRules calls fn for the given rule and all of its descendants, depth-first, passing the path of each rule
+func walkRules(rule *papi.Rules, parentPath string, fn func(path string, rule *papi.Rules)) {
+	path := rule.Name
+	if parentPath != "" {
+		path = parentPath + rulePathSeparator + rule.Name
+	}
+	fn(path, rule)
+	for i := range rule.Children {
+		walkRules(&rule.Children[i], path, fn)
+	}
+}
+
+// findRule returns the rule located under given path, e.g. "default/Performance/Compression".
+// The first segment of the path has to match the name of the top-level rule
+func findRule(root *papi.Rules, path string) (*papi.Rules, error) {
+	names := strings.Split(strings.Trim(path, rulePathSeparator), rulePathSeparator)
+	if names[0] != root.Name {
+		return nil, fmt.Errorf("%w: '%s'", ErrRuleNotFound, path)
+	}
+
+	rule := root
+	for _, name := range names[1:] {
+		var child *papi.Rules
+		for i := range rule.Children {
+			if rule.Children[i].Name == name {
+				child = &rule.Children[i]
+				break
+			}
+		}
+		if child == nil {
+			return nil, fmt.Errorf("%w: '%s'", ErrRuleNotFound, path)
+		}
+		rule = child
+	}
+	return rule, nil
+}
+
+// mergeRules merges overlay rule into the base rule and returns the result. The base rule is not modified.
+//
+// Behaviors are matched by name and their options are merged, with overlay values taking precedence.
+// Children and variables are matched by name, children are merged recursively.
+// Behaviors, children and variables which do not exist in the base rule are appended.
+// Criteria of the overlay replace the criteria of the base rule when present.
+// Remaining fields are taken from the overlay when they are set.
+func mergeRules(base, overlay papi.Rules) papi.Rules {
+	merged := base
+	if overlay.Name != "" {
+		merged.Name = overlay.Name
+	}
+	if overlay.Comments != "" {
+		merged.Comments = overlay.Comments
+	}
+	if overlay.CriteriaMustSatisfy != "" {
+		merged.CriteriaMustSatisfy = overlay.CriteriaMustSatisfy
+	}
+	if overlay.AdvancedOverride != "" {
+		merged.AdvancedOverride = overlay.AdvancedOverride
+	}
+	if overlay.CustomOverride != nil {
+		merged.CustomOverride = overlay.CustomOverride
+	}
+	merged.CriteriaLocked = base.CriteriaLocked || overlay.CriteriaLocked
+	merged.Options.IsSecure = base.Options.IsSecure || overlay.Options.IsSecure
+
+	if len(overlay.Criteria) > 0 {
+		merged.Criteria = append([]papi.RuleBehavior{}, overlay.Criteria...)
+	}
+
+	merged.Behaviors = mergeBehaviors(base.Behaviors, overlay.Behaviors)
+	merged.Variables = mergeVariables(base.Variables, overlay.Variables)
+
+	merged.Children = append([]papi.Rules{}, base.Children...)
+	for _, overlayChild := range overlay.Children {
+		found := false
+		for i, child := range merged.Children {
+			if child.Name == overlayChild.Name {
+				merged.Children[i] = mergeRules(child, overlayChild)
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged.Children = append(merged.Children, overlayChild)
+		}
+	}
+	if len(merged.Children) == 0 {
+		merged.Children = nil
+	}
+
+	return merged
+}
+
+func mergeBehaviors(base, overlay []papi.RuleBehavior) []papi.RuleBehavior {
+	merged := make([]papi.RuleBehavior, 0, len(base)+len(overlay))
+	for _, behavior := range base {
+		behavior.Options = copyOptions(behavior.Options)
+		merged = append(merged, behavior)
+	}
+
+	for _, overlayBehavior := range overlay {
+		found := false
+		for i, behavior := range merged {
+			if behavior.Name != overlayBehavior.Name {
+				continue
+			}
+			if merged[i].Options == nil {
+				merged[i].Options = papi.RuleOptionsMap{}
+			}
+			for k, v := range overlayBehavior.Options {
+				merged[i].Options[k] = v
+			}
+			merged[i].Locked = merged[i].Locked || overlayBehavior.Locked
+			found = true
+		}
+		if !found {
+			merged = append(merged, overlayBehavior)
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func mergeVariables(base, overlay []papi.RuleVariable) []papi.RuleVariable {
+	merged := append([]papi.RuleVariable{}, base...)
+	for _, overlayVariable := range overlay {
+		found := false
+		for i, variable := range merged {
+			if variable.Name == overlayVariable.Name {
+				merged[i] = overlayVariable
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, overlayVariable)
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func copyOptions(options papi.RuleOptionsMap) papi.RuleOptionsMap {
+	if options == nil {
+		return nil
+	}
+	copied := make(papi.RuleOptionsMap, len(options))
+	for k, v := range options {
+		copied[k] = v
+	}
+	return copied
+}
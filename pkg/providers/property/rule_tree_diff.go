@@ -0,0 +1,272 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+)
+
+const (
+	unsetValue     = "(unset)"
+	sensitiveValue = "(sensitive)"
+)
+
+// rulesChangeSummary describes changes between the rule trees of two property rules JSONs
+func rulesChangeSummary(oldRulesJSON, newRulesJSON string) ([]string, error) {
+	var oldRules, newRules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(oldRulesJSON), &oldRules); err != nil {
+		return nil, fmt.Errorf("cannot parse rules JSON from state: %s", err)
+	}
+	if err := json.Unmarshal([]byte(newRulesJSON), &newRules); err != nil {
+		return nil, fmt.Errorf("cannot parse rules JSON from config: %s", err)
+	}
+	normalizeFields(&oldRules, &newRules)
+	return diffRules(&oldRules.Rules, &newRules.Rules), nil
+}
+
+// diffRules compares two rule trees and describes every added, removed or modified rule, behavior, criterion
+// and variable, prefixed with the path of the rule it belongs to, e.g.
+// "default/Performance/Compression: behavior gzipResponse.behavior ALWAYS -> ORIGIN_RESPONSE".
+// Rules and behaviors are matched by name, so reordering them is reported separately from their modifications.
+func diffRules(oldRules, newRules *papi.Rules) []string {
+	var changes []string
+	diffRule(oldRules.Name, oldRules, newRules, &changes)
+	return changes
+}
+
+func diffRule(path string, oldRule, newRule *papi.Rules, changes *[]string) {
+	add := func(format string, args ...any) {
+		*changes = append(*changes, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	if oldRule.Name != newRule.Name {
+		add("name %s -> %s", oldRule.Name, newRule.Name)
+	}
+	diffField := func(name string, oldValue, newValue any) {
+		if !reflect.DeepEqual(oldValue, newValue) {
+			add("%s %s -> %s", name, formatRuleValue(oldValue), formatRuleValue(newValue))
+		}
+	}
+	diffField("criteriaMustSatisfy", criteriaMustSatisfy(oldRule), criteriaMustSatisfy(newRule))
+	diffField("criteriaLocked", oldRule.CriteriaLocked, newRule.CriteriaLocked)
+	diffField("comments", oldRule.Comments, newRule.Comments)
+	diffField("is_secure", oldRule.Options.IsSecure, newRule.Options.IsSecure)
+	diffField("advancedOverride", oldRule.AdvancedOverride, newRule.AdvancedOverride)
+	diffField("customOverride", oldRule.CustomOverride, newRule.CustomOverride)
+
+	diffBehaviors("behavior", oldRule.Behaviors, newRule.Behaviors, add)
+	diffBehaviors("criterion", oldRule.Criteria, newRule.Criteria, add)
+	diffVariables(oldRule.Variables, newRule.Variables, add)
+	diffChildren(path, oldRule.Children, newRule.Children, changes)
+}
+
+// criteriaMustSatisfy returns the criteriaMustSatisfy of the rule, which defaults to "all" in PAPI
+func criteriaMustSatisfy(rule *papi.Rules) string {
+	if rule.CriteriaMustSatisfy == "" {
+		return string(papi.RuleCriteriaMustSatisfyAll)
+	}
+	return string(rule.CriteriaMustSatisfy)
+}
+
+// occurrenceKeys identifies items by their name and, when the name repeats, by the number of its occurrence,
+// e.g. "cpCode", "cpCode[2]"
+func occurrenceKeys(names []string) []string {
+	keys := make([]string, len(names))
+	seen := make(map[string]int, len(names))
+	for i, name := range names {
+		seen[name]++
+		keys[i] = name
+		if seen[name] > 1 {
+			keys[i] = fmt.Sprintf("%s[%d]", name, seen[name])
+		}
+	}
+	return keys
+}
+
+func behaviorKeys(behaviors []papi.RuleBehavior) []string {
+	names := make([]string, len(behaviors))
+	for i, b := range behaviors {
+		names[i] = b.Name
+	}
+	return occurrenceKeys(names)
+}
+
+func diffBehaviors(kind string, oldBehaviors, newBehaviors []papi.RuleBehavior, add func(string, ...any)) {
+	oldKeys, newKeys := behaviorKeys(oldBehaviors), behaviorKeys(newBehaviors)
+
+	for i, key := range newKeys {
+		j := slices.Index(oldKeys, key)
+		if j < 0 {
+			add("%s %s added", kind, key)
+			continue
+		}
+		oldBehavior, newBehavior := oldBehaviors[j], newBehaviors[i]
+		if oldBehavior.Locked != newBehavior.Locked {
+			add("%s %s locked %t -> %t", kind, key, oldBehavior.Locked, newBehavior.Locked)
+		}
+		diffOptions(kind+" "+key, "", oldBehavior.Options, newBehavior.Options, add)
+	}
+	for _, key := range oldKeys {
+		if !slices.Contains(newKeys, key) {
+			add("%s %s removed", kind, key)
+		}
+	}
+	if orderChanged(oldKeys, newKeys) {
+		add("order of %s changed", pluralKinds[kind])
+	}
+}
+
+var pluralKinds = map[string]string{"behavior": "behaviors", "criterion": "criteria", "rule": "child rules"}
+
+// diffOptions compares options of a behavior, descending into nested objects, so that every changed option
+// is reported with its own path, e.g. "behavior origin.customCertificateAuthorities"
+func diffOptions(prefix, parent string, oldOptions, newOptions map[string]any, add func(string, ...any)) {
+	names := make([]string, 0, len(oldOptions)+len(newOptions))
+	for name := range oldOptions {
+		names = append(names, name)
+	}
+	for name := range newOptions {
+		if _, ok := oldOptions[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		option := name
+		if parent != "" {
+			option = parent + "." + name
+		}
+		oldValue, oldOK := oldOptions[name]
+		newValue, newOK := newOptions[name]
+
+		oldMap, oldIsMap := oldValue.(map[string]any)
+		newMap, newIsMap := newValue.(map[string]any)
+		if oldIsMap && newIsMap {
+			diffOptions(prefix, option, oldMap, newMap, add)
+			continue
+		}
+		// PAPI returns options which are not set as null
+		if oldValue == nil && newValue == nil || reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		add("%s.%s %s -> %s", prefix, option, formatOption(oldValue, oldOK), formatOption(newValue, newOK))
+	}
+}
+
+func formatOption(value any, ok bool) string {
+	if !ok {
+		return unsetValue
+	}
+	return formatRuleValue(value)
+}
+
+func diffVariables(oldVariables, newVariables []papi.RuleVariable, add func(string, ...any)) {
+	oldByName := make(map[string]papi.RuleVariable, len(oldVariables))
+	for _, v := range oldVariables {
+		oldByName[v.Name] = v
+	}
+	newByName := make(map[string]papi.RuleVariable, len(newVariables))
+	for _, v := range newVariables {
+		newByName[v.Name] = v
+	}
+
+	for _, newVariable := range newVariables {
+		oldVariable, ok := oldByName[newVariable.Name]
+		if !ok {
+			add("variable %s added", newVariable.Name)
+			continue
+		}
+		if !reflect.DeepEqual(oldVariable.Value, newVariable.Value) {
+			if oldVariable.Sensitive || newVariable.Sensitive {
+				add("variable %s.value %s -> %s", newVariable.Name, sensitiveValue, sensitiveValue)
+			} else {
+				add("variable %s.value %s -> %s", newVariable.Name, formatRuleValue(oldVariable.Value), formatRuleValue(newVariable.Value))
+			}
+		}
+		if !reflect.DeepEqual(oldVariable.Description, newVariable.Description) {
+			add("variable %s.description %s -> %s", newVariable.Name, formatRuleValue(oldVariable.Description), formatRuleValue(newVariable.Description))
+		}
+		if oldVariable.Hidden != newVariable.Hidden {
+			add("variable %s.hidden %t -> %t", newVariable.Name, oldVariable.Hidden, newVariable.Hidden)
+		}
+		if oldVariable.Sensitive != newVariable.Sensitive {
+			add("variable %s.sensitive %t -> %t", newVariable.Name, oldVariable.Sensitive, newVariable.Sensitive)
+		}
+	}
+	for _, oldVariable := range oldVariables {
+		if _, ok := newByName[oldVariable.Name]; !ok {
+			add("variable %s removed", oldVariable.Name)
+		}
+	}
+}
+
+func diffChildren(path string, oldChildren, newChildren []papi.Rules, changes *[]string) {
+	childKeys := func(children []papi.Rules) []string {
+		names := make([]string, len(children))
+		for i, child := range children {
+			names[i] = child.Name
+		}
+		return occurrenceKeys(names)
+	}
+	oldKeys, newKeys := childKeys(oldChildren), childKeys(newChildren)
+
+	for i, key := range newKeys {
+		childPath := path + rulePathSeparator + key
+		j := slices.Index(oldKeys, key)
+		if j < 0 {
+			*changes = append(*changes, childPath+": rule added")
+			continue
+		}
+		diffRule(childPath, &oldChildren[j], &newChildren[i], changes)
+	}
+	for _, key := range oldKeys {
+		if !slices.Contains(newKeys, key) {
+			*changes = append(*changes, path+rulePathSeparator+key+": rule removed")
+		}
+	}
+	if orderChanged(oldKeys, newKeys) {
+		*changes = append(*changes, fmt.Sprintf("%s: order of %s changed", path, pluralKinds["rule"]))
+	}
+}
+
+// orderChanged reports whether the items present in both lists appear in a different order
+func orderChanged(oldKeys, newKeys []string) bool {
+	var oldCommon, newCommon []string
+	for _, key := range oldKeys {
+		if slices.Contains(newKeys, key) {
+			oldCommon = append(oldCommon, key)
+		}
+	}
+	for _, key := range newKeys {
+		if slices.Contains(oldKeys, key) {
+			newCommon = append(newCommon, key)
+		}
+	}
+	return !slices.Equal(oldCommon, newCommon)
+}
+
+// formatRuleValue formats strings as they are and other values as JSON
+func formatRuleValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return `""`
+		}
+		return v
+	case *string:
+		if v == nil {
+			return "null"
+		}
+		return formatRuleValue(*v)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
@@ -0,0 +1,93 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulesChangeSummary(t *testing.T) {
+	base := `{"rules": {"name": "default", "behaviors": [{"name": "cpCode", "options": {"value": {"id": 1}}}],
+		"variables": [{"name": "PMUSER_A", "value": "a", "description": null, "hidden": false, "sensitive": false}],
+		"children": [{"name": "Performance", "children": [
+			{"name": "Compression", "criteria": [{"name": "contentType", "options": {"values": ["text/*"]}}],
+				"behaviors": [{"name": "gzipResponse", "options": {"behavior": "ALWAYS"}}]}]},
+			{"name": "Offload", "behaviors": [{"name": "caching", "options": {"behavior": "MAX_AGE", "ttl": "1d"}}]}]}}`
+
+	tests := map[string]struct {
+		newRules string
+		expected []string
+	}{
+		"no changes": {
+			newRules: base,
+		},
+		"option of a nested rule modified": {
+			newRules: `{"rules": {"name": "default", "behaviors": [{"name": "cpCode", "options": {"value": {"id": 1}}}],
+				"variables": [{"name": "PMUSER_A", "value": "a", "description": null, "hidden": false, "sensitive": false}],
+				"children": [{"name": "Performance", "children": [
+					{"name": "Compression", "criteria": [{"name": "contentType", "options": {"values": ["text/*"]}}],
+						"behaviors": [{"name": "gzipResponse", "options": {"behavior": "ORIGIN_RESPONSE"}}]}]},
+					{"name": "Offload", "behaviors": [{"name": "caching", "options": {"behavior": "MAX_AGE", "ttl": "1d"}}]}]}}`,
+			expected: []string{"default/Performance/Compression: behavior gzipResponse.behavior ALWAYS -> ORIGIN_RESPONSE"},
+		},
+		"behaviors, criteria, variables and rules added, removed and modified": {
+			newRules: `{"rules": {"name": "default", "criteriaMustSatisfy": "any",
+				"behaviors": [{"name": "cpCode", "options": {"value": {"id": 2}}}, {"name": "origin", "options": {}}],
+				"variables": [{"name": "PMUSER_B", "value": "b", "description": null, "hidden": false, "sensitive": false}],
+				"children": [
+					{"name": "Offload", "behaviors": [{"name": "caching", "options": {"behavior": "MAX_AGE"}}]},
+					{"name": "Performance", "children": [
+						{"name": "Compression", "criteria": [{"name": "contentType", "options": {"values": ["text/*", "application/json"]}}]}]},
+					{"name": "Images"}]}}`,
+			expected: []string{
+				"default: criteriaMustSatisfy all -> any",
+				"default: behavior cpCode.value.id 1 -> 2",
+				"default: behavior origin added",
+				"default: variable PMUSER_B added",
+				"default: variable PMUSER_A removed",
+				"default/Offload: behavior caching.ttl 1d -> (unset)",
+				"default/Performance/Compression: behavior gzipResponse removed",
+				`default/Performance/Compression: criterion contentType.values ["text/*"] -> ["text/*","application/json"]`,
+				"default/Images: rule added",
+				"default: order of child rules changed",
+			},
+		},
+		"repeated behaviors and removed rules": {
+			newRules: `{"rules": {"name": "default",
+				"behaviors": [{"name": "cpCode", "options": {"value": {"id": 1}}}, {"name": "cpCode", "options": {"value": {"id": 3}}}],
+				"variables": [{"name": "PMUSER_A", "value": "a", "description": null, "hidden": false, "sensitive": false}],
+				"children": [{"name": "Offload", "behaviors": [{"name": "caching", "options": {"behavior": "MAX_AGE", "ttl": "1d", "mustRevalidate": null}}]}]}}`,
+			expected: []string{
+				"default: behavior cpCode[2] added",
+				"default/Performance: rule removed",
+			},
+		},
+		"sensitive variable value is not revealed": {
+			newRules: `{"rules": {"name": "default", "behaviors": [{"name": "cpCode", "options": {"value": {"id": 1}}}],
+				"variables": [{"name": "PMUSER_A", "value": "secret", "description": null, "hidden": true, "sensitive": true}],
+				"children": [{"name": "Performance", "children": [
+					{"name": "Compression", "criteria": [{"name": "contentType", "options": {"values": ["text/*"]}}],
+						"behaviors": [{"name": "gzipResponse", "options": {"behavior": "ALWAYS"}}]}]},
+					{"name": "Offload", "behaviors": [{"name": "caching", "options": {"behavior": "MAX_AGE", "ttl": "1d"}}]}]}}`,
+			expected: []string{
+				"default: variable PMUSER_A.value (sensitive) -> (sensitive)",
+				"default: variable PMUSER_A.hidden false -> true",
+				"default: variable PMUSER_A.sensitive false -> true",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			summary, err := rulesChangeSummary(base, test.newRules)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, summary)
+		})
+	}
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := rulesChangeSummary(base, "{")
+		assert.ErrorContains(t, err, "cannot parse rules JSON from config")
+	})
+}
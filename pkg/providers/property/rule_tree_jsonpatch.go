@@ -0,0 +1,246 @@
+package property
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrJSONPatch is returned when a JSON patch operation cannot be applied to a document
+var ErrJSONPatch = errors.New("cannot apply JSON patch")
+
+// jsonPatchOperations lists the operations defined by RFC 6902
+var jsonPatchOperations = []string{"add", "remove", "replace", "move", "copy", "test"}
+
+// jsonPatchOperation is a single RFC 6902 operation. Path and from are JSON pointers
+type jsonPatchOperation struct {
+	Op    string
+	Path  string
+	From  string
+	Value any
+}
+
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// applyJSONPatch applies the operations to a decoded JSON document in order and returns the patched document.
+// The document may be modified in place
+func applyJSONPatch(document any, operations []jsonPatchOperation) (any, error) {
+	var err error
+	for _, operation := range operations {
+		if document, err = applyJSONPatchOperation(document, operation); err != nil {
+			return nil, fmt.Errorf("%w: %s %q: %s", ErrJSONPatch, operation.Op, operation.Path, err)
+		}
+	}
+	return document, nil
+}
+
+func applyJSONPatchOperation(document any, operation jsonPatchOperation) (any, error) {
+	path, err := parseJSONPointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch operation.Op {
+	case "add":
+		return addJSONValue(document, path, copyJSONValue(operation.Value))
+	case "remove":
+		return removeJSONValue(document, path)
+	case "replace":
+		if _, err := getJSONValue(document, path); err != nil {
+			return nil, err
+		}
+		if len(path) == 0 {
+			return copyJSONValue(operation.Value), nil
+		}
+		if document, err = removeJSONValue(document, path); err != nil {
+			return nil, err
+		}
+		return addJSONValue(document, path, copyJSONValue(operation.Value))
+	case "move", "copy":
+		from, err := parseJSONPointer(operation.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getJSONValue(document, from)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Op == "copy" {
+			return addJSONValue(document, path, copyJSONValue(value))
+		}
+		if len(path) > len(from) && reflect.DeepEqual(path[:len(from)], from) {
+			return nil, fmt.Errorf("cannot move a value into its own child")
+		}
+		if document, err = removeJSONValue(document, from); err != nil {
+			return nil, err
+		}
+		return addJSONValue(document, path, value)
+	case "test":
+		value, err := getJSONValue(document, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, operation.Value) {
+			return nil, fmt.Errorf("value %s is not %s", formatJSONValue(value), formatJSONValue(operation.Value))
+		}
+		return document, nil
+	}
+	return nil, fmt.Errorf("unknown operation, expected one of: %s", strings.Join(jsonPatchOperations, ", "))
+}
+
+// parseJSONPointer splits a JSON pointer, e.g. "/rules/behaviors/0", into its unescaped reference tokens
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer %q has to start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = jsonPointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+func getJSONValue(document any, path []string) (any, error) {
+	value := document
+	for i, token := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			member, ok := v[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found at /%s", token, strings.Join(path[:i], "/"))
+			}
+			value = member
+		case []any:
+			index, err := jsonArrayIndex(token, len(v)-1)
+			if err != nil {
+				return nil, err
+			}
+			value = v[index]
+		default:
+			return nil, fmt.Errorf("value at /%s is not an object or an array", strings.Join(path[:i], "/"))
+		}
+	}
+	return value, nil
+}
+
+// updateJSONParent calls fn for the parent of the last token of the path and stores the value fn returns in its place,
+// as arrays cannot be grown or shrunk in place
+func updateJSONParent(document any, path []string, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return fn(document, path[0])
+	}
+	switch v := document.(type) {
+	case map[string]any:
+		member, ok := v[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", path[0])
+		}
+		updated, err := updateJSONParent(member, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		v[path[0]] = updated
+		return v, nil
+	case []any:
+		index, err := jsonArrayIndex(path[0], len(v)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := updateJSONParent(v[index], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		v[index] = updated
+		return v, nil
+	}
+	return nil, fmt.Errorf("value at %q is not an object or an array", path[0])
+}
+
+func addJSONValue(document any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return updateJSONParent(document, path, func(parent any, token string) (any, error) {
+		switch v := parent.(type) {
+		case map[string]any:
+			v[token] = value
+			return v, nil
+		case []any:
+			if token == "-" {
+				return append(v, value), nil
+			}
+			index, err := jsonArrayIndex(token, len(v))
+			if err != nil {
+				return nil, err
+			}
+			return append(v[:index], append([]any{value}, v[index:]...)...), nil
+		}
+		return nil, fmt.Errorf("cannot add %q to a value which is not an object or an array", token)
+	})
+}
+
+func removeJSONValue(document any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("cannot remove the whole document")
+	}
+	return updateJSONParent(document, path, func(parent any, token string) (any, error) {
+		switch v := parent.(type) {
+		case map[string]any:
+			if _, ok := v[token]; !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			delete(v, token)
+			return v, nil
+		case []any:
+			index, err := jsonArrayIndex(token, len(v)-1)
+			if err != nil {
+				return nil, err
+			}
+			return append(v[:index], v[index+1:]...), nil
+		}
+		return nil, fmt.Errorf("cannot remove %q from a value which is not an object or an array", token)
+	})
+}
+
+func jsonArrayIndex(token string, maxIndex int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("%q is not a valid array index", token)
+	}
+	if index > maxIndex {
+		return 0, fmt.Errorf("array index %d is out of bounds", index)
+	}
+	return index, nil
+}
+
+// copyJSONValue returns a deep copy of a decoded JSON value, so a value added in several locations is not shared
+func copyJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, member := range v {
+			copied[key] = copyJSONValue(member)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, element := range v {
+			copied[i] = copyJSONValue(element)
+		}
+		return copied
+	}
+	return value
+}
+
+func formatJSONValue(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
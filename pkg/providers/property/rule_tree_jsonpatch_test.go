@@ -0,0 +1,120 @@
+package property
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := map[string]struct {
+		document   string
+		operations []jsonPatchOperation
+		expected   string
+		withError  string
+	}{
+		"add member": {
+			document:   `{"options": {"hostname": "origin.example.com"}}`,
+			operations: []jsonPatchOperation{{Op: "add", Path: "/options/verificationMode", Value: "CUSTOM"}},
+			expected:   `{"options": {"hostname": "origin.example.com", "verificationMode": "CUSTOM"}}`,
+		},
+		"add array elements": {
+			document: `{"behaviors": [{"name": "origin"}]}`,
+			operations: []jsonPatchOperation{
+				{Op: "add", Path: "/behaviors/0", Value: map[string]any{"name": "cpCode"}},
+				{Op: "add", Path: "/behaviors/-", Value: map[string]any{"name": "caching"}},
+			},
+			expected: `{"behaviors": [{"name": "cpCode"}, {"name": "origin"}, {"name": "caching"}]}`,
+		},
+		"replace and remove": {
+			document: `{"verificationMode": "PLATFORM_SETTINGS", "customValidCnValues": ["a"], "ports": [80, 443]}`,
+			operations: []jsonPatchOperation{
+				{Op: "replace", Path: "/verificationMode", Value: "CUSTOM"},
+				{Op: "remove", Path: "/customValidCnValues"},
+				{Op: "remove", Path: "/ports/0"},
+			},
+			expected: `{"verificationMode": "CUSTOM", "ports": [443]}`,
+		},
+		"replace whole document": {
+			document:   `{"a": 1}`,
+			operations: []jsonPatchOperation{{Op: "replace", Path: "", Value: map[string]any{"b": 2.0}}},
+			expected:   `{"b": 2}`,
+		},
+		"move and copy": {
+			document: `{"a": {"x": 1}, "b": {}}`,
+			operations: []jsonPatchOperation{
+				{Op: "copy", From: "/a/x", Path: "/b/y"},
+				{Op: "move", From: "/a", Path: "/c"},
+			},
+			expected: `{"b": {"y": 1}, "c": {"x": 1}}`,
+		},
+		"escaped pointer": {
+			document:   `{"a/b": {"c~d": 1}}`,
+			operations: []jsonPatchOperation{{Op: "replace", Path: "/a~1b/c~0d", Value: 2.0}},
+			expected:   `{"a/b": {"c~d": 2}}`,
+		},
+		"successful test": {
+			document: `{"verificationMode": "PLATFORM_SETTINGS"}`,
+			operations: []jsonPatchOperation{
+				{Op: "test", Path: "/verificationMode", Value: "PLATFORM_SETTINGS"},
+				{Op: "replace", Path: "/verificationMode", Value: "CUSTOM"},
+			},
+			expected: `{"verificationMode": "CUSTOM"}`,
+		},
+		"failed test": {
+			document: `{"verificationMode": "CUSTOM"}`,
+			operations: []jsonPatchOperation{
+				{Op: "test", Path: "/verificationMode", Value: "PLATFORM_SETTINGS"},
+			},
+			withError: `test "/verificationMode": value "CUSTOM" is not "PLATFORM_SETTINGS"`,
+		},
+		"replace missing member": {
+			document:   `{"options": {}}`,
+			operations: []jsonPatchOperation{{Op: "replace", Path: "/options/ttl", Value: "1d"}},
+			withError:  `member "ttl" not found`,
+		},
+		"add to missing parent": {
+			document:   `{}`,
+			operations: []jsonPatchOperation{{Op: "add", Path: "/options/ttl", Value: "1d"}},
+			withError:  `member "options" not found`,
+		},
+		"array index out of bounds": {
+			document:   `{"ports": [80]}`,
+			operations: []jsonPatchOperation{{Op: "remove", Path: "/ports/1"}},
+			withError:  "array index 1 is out of bounds",
+		},
+		"invalid array index": {
+			document:   `{"ports": [80]}`,
+			operations: []jsonPatchOperation{{Op: "add", Path: "/ports/01", Value: 443.0}},
+			withError:  `"01" is not a valid array index`,
+		},
+		"move into own child": {
+			document:   `{"a": {"b": {}}}`,
+			operations: []jsonPatchOperation{{Op: "move", From: "/a", Path: "/a/b/c"}},
+			withError:  "cannot move a value into its own child",
+		},
+		"invalid pointer": {
+			document:   `{}`,
+			operations: []jsonPatchOperation{{Op: "remove", Path: "a"}},
+			withError:  `JSON pointer "a" has to start with '/'`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var document any
+			require.NoError(t, json.Unmarshal([]byte(test.document), &document))
+
+			patched, err := applyJSONPatch(document, test.operations)
+			if test.withError != "" {
+				assert.ErrorIs(t, err, ErrJSONPatch)
+				assert.ErrorContains(t, err, test.withError)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, formatJSONValue(patched))
+		})
+	}
+}
@@ -0,0 +1,533 @@
+package property
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrJSONPathSyntax is returned when a JSONPath expression cannot be parsed
+var ErrJSONPathSyntax = errors.New("invalid JSONPath expression")
+
+type (
+	// jsonNode is a value of a decoded JSON document along with its location as a JSON pointer, e.g. "/rules/behaviors/0"
+	jsonNode struct {
+		pointer string
+		value   any
+	}
+
+	// jsonPath is a parsed JSONPath expression. It supports the root `$`, child members `.name` and `['name']`,
+	// wildcards `*`, array indexes `[0]`, recursive descent `..` and filters `[?(@.name == 'origin')]`.
+	jsonPath []jsonPathSegment
+
+	jsonPathSegment struct {
+		recursive bool
+		selector  jsonPathSelector
+	}
+
+	jsonPathSelector interface {
+		selectNodes(node jsonNode) []jsonNode
+	}
+
+	jsonPathName     string
+	jsonPathWildcard struct{}
+	jsonPathIndex    int
+	jsonPathFilter   struct{ expression jsonPathExpression }
+
+	// jsonPathExpression is a filter expression evaluated for the current node `@`
+	jsonPathExpression interface {
+		evaluate(current any) (any, bool)
+	}
+
+	jsonPathLiteral  struct{ value any }
+	jsonPathRelative struct{ path []jsonPathSelector }
+	jsonPathRegexp   struct{ re *regexp.Regexp }
+	jsonPathBinary   struct {
+		operator    string
+		left, right jsonPathExpression
+	}
+
+	jsonPathParser struct {
+		expression string
+		pos        int
+	}
+)
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// parseJSONPath parses a JSONPath expression starting with `$`
+func parseJSONPath(expression string) (jsonPath, error) {
+	p := &jsonPathParser{expression: strings.TrimSpace(expression)}
+	if !p.consume("$") {
+		return nil, p.errorf("expression has to start with '$'")
+	}
+	var path jsonPath
+	for !p.done() {
+		segment := jsonPathSegment{}
+		switch {
+		case p.consume(".."):
+			segment.recursive = true
+			if p.peek() != '[' {
+				selector, err := p.parseDotSelector()
+				if err != nil {
+					return nil, err
+				}
+				segment.selector = selector
+			}
+		case p.consume("."):
+			selector, err := p.parseDotSelector()
+			if err != nil {
+				return nil, err
+			}
+			segment.selector = selector
+		case p.peek() != '[':
+			return nil, p.errorf("expected '.' or '['")
+		}
+		if segment.selector == nil {
+			selector, err := p.parseBracketSelector()
+			if err != nil {
+				return nil, err
+			}
+			segment.selector = selector
+		}
+		path = append(path, segment)
+	}
+	return path, nil
+}
+
+// evaluate returns all nodes of the document matched by the path, in document order
+func (jp jsonPath) evaluate(document any) []jsonNode {
+	nodes := []jsonNode{{pointer: "", value: document}}
+	for _, segment := range jp {
+		var selected []jsonNode
+		for _, node := range nodes {
+			if !segment.recursive {
+				selected = append(selected, segment.selector.selectNodes(node)...)
+				continue
+			}
+			walkJSON(node, func(descendant jsonNode) {
+				selected = append(selected, segment.selector.selectNodes(descendant)...)
+			})
+		}
+		nodes = selected
+	}
+	return nodes
+}
+
+// walkJSON calls fn for the node and all of its descendants, depth-first
+func walkJSON(node jsonNode, fn func(jsonNode)) {
+	fn(node)
+	for _, child := range jsonChildren(node) {
+		walkJSON(child, fn)
+	}
+}
+
+// jsonChildren returns members of an object, sorted by name, or elements of an array
+func jsonChildren(node jsonNode) []jsonNode {
+	var children []jsonNode
+	switch v := node.value.(type) {
+	case map[string]any:
+		for _, name := range slices.Sorted(maps.Keys(v)) {
+			children = append(children, jsonNode{pointer: node.pointer + "/" + jsonPointerEscaper.Replace(name), value: v[name]})
+		}
+	case []any:
+		for i, element := range v {
+			children = append(children, jsonNode{pointer: node.pointer + "/" + strconv.Itoa(i), value: element})
+		}
+	}
+	return children
+}
+
+func (n jsonPathName) selectNodes(node jsonNode) []jsonNode {
+	object, ok := node.value.(map[string]any)
+	if !ok {
+		return nil
+	}
+	value, ok := object[string(n)]
+	if !ok {
+		return nil
+	}
+	return []jsonNode{{pointer: node.pointer + "/" + jsonPointerEscaper.Replace(string(n)), value: value}}
+}
+
+func (jsonPathWildcard) selectNodes(node jsonNode) []jsonNode {
+	return jsonChildren(node)
+}
+
+func (i jsonPathIndex) selectNodes(node jsonNode) []jsonNode {
+	array, ok := node.value.([]any)
+	if !ok {
+		return nil
+	}
+	index := int(i)
+	if index < 0 {
+		index += len(array)
+	}
+	if index < 0 || index >= len(array) {
+		return nil
+	}
+	return []jsonNode{{pointer: node.pointer + "/" + strconv.Itoa(index), value: array[index]}}
+}
+
+func (f jsonPathFilter) selectNodes(node jsonNode) []jsonNode {
+	var selected []jsonNode
+	for _, child := range jsonChildren(node) {
+		if value, ok := f.expression.evaluate(child.value); ok && isTruthy(value) {
+			selected = append(selected, child)
+		}
+	}
+	return selected
+}
+
+func (l jsonPathLiteral) evaluate(_ any) (any, bool) {
+	return l.value, true
+}
+
+func (r jsonPathRelative) evaluate(current any) (any, bool) {
+	nodes := []jsonNode{{value: current}}
+	for _, selector := range r.path {
+		var selected []jsonNode
+		for _, node := range nodes {
+			selected = append(selected, selector.selectNodes(node)...)
+		}
+		nodes = selected
+	}
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	return nodes[0].value, true
+}
+
+func (r jsonPathRegexp) evaluate(_ any) (any, bool) {
+	return r.re, true
+}
+
+func (b jsonPathBinary) evaluate(current any) (any, bool) {
+	left, leftOK := b.left.evaluate(current)
+	switch b.operator {
+	case "&&":
+		right, rightOK := b.right.evaluate(current)
+		return leftOK && rightOK && isTruthy(left) && isTruthy(right), true
+	case "||":
+		right, rightOK := b.right.evaluate(current)
+		return (leftOK && isTruthy(left)) || (rightOK && isTruthy(right)), true
+	}
+	right, rightOK := b.right.evaluate(current)
+	if !leftOK || !rightOK {
+		return b.operator == "!=" && leftOK != rightOK, true
+	}
+	switch b.operator {
+	case "==":
+		return reflect.DeepEqual(left, right), true
+	case "!=":
+		return !reflect.DeepEqual(left, right), true
+	case "=~":
+		s, ok := left.(string)
+		re, isRegexp := right.(*regexp.Regexp)
+		return ok && isRegexp && re.MatchString(s), true
+	}
+	if l, ok := left.(float64); ok {
+		if r, ok := right.(float64); ok {
+			return compareOrdered(b.operator, l, r), true
+		}
+	}
+	if l, ok := left.(string); ok {
+		if r, ok := right.(string); ok {
+			return compareOrdered(b.operator, l, r), true
+		}
+	}
+	return false, true
+}
+
+func compareOrdered[T float64 | string](operator string, left, right T) bool {
+	switch operator {
+	case "<":
+		return left < right
+	case "<=":
+		return left <= right
+	case ">":
+		return left > right
+	case ">=":
+		return left >= right
+	}
+	return false
+}
+
+// isTruthy reports whether a filter value selects a node. Any existing value other than false and null does
+func isTruthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	}
+	return true
+}
+
+func (p *jsonPathParser) parseDotSelector() (jsonPathSelector, error) {
+	if p.consume("*") {
+		return jsonPathWildcard{}, nil
+	}
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.errorf("expected member name")
+	}
+	return jsonPathName(name), nil
+}
+
+func (p *jsonPathParser) parseBracketSelector() (jsonPathSelector, error) {
+	if !p.consume("[") {
+		return nil, p.errorf("expected '['")
+	}
+	var selector jsonPathSelector
+	p.skipSpaces()
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		selector = jsonPathWildcard{}
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		selector = jsonPathName(name)
+	case c == '?':
+		p.pos++
+		if !p.consume("(") {
+			return nil, p.errorf("expected '(' after '?'")
+		}
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(")") {
+			return nil, p.errorf("expected ')'")
+		}
+		selector = jsonPathFilter{expression: expression}
+	default:
+		index, err := p.parseInt()
+		if err != nil {
+			return nil, err
+		}
+		selector = jsonPathIndex(index)
+	}
+	p.skipSpaces()
+	if !p.consume("]") {
+		return nil, p.errorf("expected ']'")
+	}
+	return selector, nil
+}
+
+func (p *jsonPathParser) parseOr() (jsonPathExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpaces(); p.consume("||"); p.skipSpaces() {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = jsonPathBinary{operator: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) parseAnd() (jsonPathExpression, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpaces(); p.consume("&&"); p.skipSpaces() {
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = jsonPathBinary{operator: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) parseComparison() (jsonPathExpression, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	for _, operator := range []string{"==", "!=", "=~", "<=", ">=", "<", ">"} {
+		if !p.consume(operator) {
+			continue
+		}
+		p.skipSpaces()
+		var right jsonPathExpression
+		if operator == "=~" {
+			right, err = p.parseRegexp()
+		} else {
+			right, err = p.parseOperand()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathBinary{operator: operator, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) parseOperand() (jsonPathExpression, error) {
+	p.skipSpaces()
+	switch c := p.peek(); {
+	case c == '@':
+		p.pos++
+		var path []jsonPathSelector
+		for {
+			switch {
+			case p.consume("."):
+				selector, err := p.parseDotSelector()
+				if err != nil {
+					return nil, err
+				}
+				path = append(path, selector)
+			case p.peek() == '[':
+				selector, err := p.parseBracketSelector()
+				if err != nil {
+					return nil, err
+				}
+				path = append(path, selector)
+			default:
+				return jsonPathRelative{path: path}, nil
+			}
+		}
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathLiteral{value: s}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for !p.done() && strings.ContainsRune("0123456789.eE+-", rune(p.peek())) {
+			p.pos++
+		}
+		number, err := strconv.ParseFloat(p.expression[start:p.pos], 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", p.expression[start:p.pos])
+		}
+		return jsonPathLiteral{value: number}, nil
+	}
+	switch keyword := p.parseIdentifier(); keyword {
+	case "true":
+		return jsonPathLiteral{value: true}, nil
+	case "false":
+		return jsonPathLiteral{value: false}, nil
+	case "null":
+		return jsonPathLiteral{value: nil}, nil
+	default:
+		return nil, p.errorf("expected '@' or a literal value")
+	}
+}
+
+// parseRegexp parses a regular expression literal `/pattern/` with the optional case-insensitive flag `i`
+func (p *jsonPathParser) parseRegexp() (jsonPathExpression, error) {
+	if !p.consume("/") {
+		return nil, p.errorf("expected regular expression '/pattern/'")
+	}
+	var pattern strings.Builder
+	for ; !p.done() && p.peek() != '/'; p.pos++ {
+		if p.peek() == '\\' && p.pos+1 < len(p.expression) && p.expression[p.pos+1] == '/' {
+			p.pos++
+		}
+		pattern.WriteByte(p.peek())
+	}
+	if !p.consume("/") {
+		return nil, p.errorf("unterminated regular expression")
+	}
+	expression := pattern.String()
+	if p.consume("i") {
+		expression = "(?i)" + expression
+	}
+	re, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, p.errorf("invalid regular expression: %s", err)
+	}
+	return jsonPathRegexp{re: re}, nil
+}
+
+func (p *jsonPathParser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var s strings.Builder
+	for ; !p.done() && p.peek() != quote; p.pos++ {
+		if p.peek() == '\\' && p.pos+1 < len(p.expression) {
+			p.pos++
+		}
+		s.WriteByte(p.peek())
+	}
+	if p.done() {
+		return "", p.errorf("unterminated string")
+	}
+	p.pos++
+	return s.String(), nil
+}
+
+func (p *jsonPathParser) parseInt() (int, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for !p.done() && p.peek() >= '0' && p.peek() <= '9' {
+		p.pos++
+	}
+	index, err := strconv.Atoi(p.expression[start:p.pos])
+	if err != nil {
+		return 0, p.errorf("expected array index, '*', filter or quoted member name")
+	}
+	return index, nil
+}
+
+func (p *jsonPathParser) parseIdentifier() string {
+	start := p.pos
+	for !p.done() {
+		c := p.peek()
+		if c != '_' && c != '-' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.expression[start:p.pos]
+}
+
+func (p *jsonPathParser) consume(token string) bool {
+	if strings.HasPrefix(p.expression[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) skipSpaces() {
+	for !p.done() && p.peek() == ' ' {
+		p.pos++
+	}
+}
+
+func (p *jsonPathParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.expression[p.pos]
+}
+
+func (p *jsonPathParser) done() bool {
+	return p.pos >= len(p.expression)
+}
+
+func (p *jsonPathParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w %q at position %d: %s", ErrJSONPathSyntax, p.expression, p.pos, fmt.Sprintf(format, args...))
+}
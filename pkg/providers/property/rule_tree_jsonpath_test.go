@@ -0,0 +1,119 @@
+package property
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jsonPathTestDocument = `{
+  "rules": {
+    "name": "default",
+    "behaviors": [
+      {"name": "origin", "options": {"hostname": "origin.example.com", "verificationMode": "PLATFORM_SETTINGS", "httpsPort": 443}},
+      {"name": "caching", "options": {"behavior": "MAX_AGE", "ttl": "1d"}}
+    ],
+    "children": [
+      {
+        "name": "Static content",
+        "behaviors": [
+          {"name": "origin", "options": {"hostname": "static.example.com", "verificationMode": "CUSTOM", "httpsPort": 8443}}
+        ],
+        "children": []
+      }
+    ]
+  }
+}`
+
+func TestJSONPathEvaluate(t *testing.T) {
+	var document any
+	require.NoError(t, json.Unmarshal([]byte(jsonPathTestDocument), &document))
+
+	tests := map[string]struct {
+		expression string
+		expected   []string
+	}{
+		"root": {
+			expression: "$",
+			expected:   []string{""},
+		},
+		"child members": {
+			expression: "$.rules.behaviors[1].options.ttl",
+			expected:   []string{"/rules/behaviors/1/options/ttl"},
+		},
+		"bracket names and negative index": {
+			expression: "$['rules'][\"behaviors\"][-1]",
+			expected:   []string{"/rules/behaviors/1"},
+		},
+		"wildcard": {
+			expression: "$.rules.behaviors[*].name",
+			expected:   []string{"/rules/behaviors/0/name", "/rules/behaviors/1/name"},
+		},
+		"recursive descent with filter": {
+			expression: "$..behaviors[?(@.name == 'origin')].options",
+			expected:   []string{"/rules/behaviors/0/options", "/rules/children/0/behaviors/0/options"},
+		},
+		"filter with nested member and conjunction": {
+			expression: "$..behaviors[?(@.name == 'origin' && @.options.verificationMode != 'CUSTOM')]",
+			expected:   []string{"/rules/behaviors/0"},
+		},
+		"filter with number comparison": {
+			expression: "$..behaviors[?(@.options.httpsPort > 443)].options.hostname",
+			expected:   []string{"/rules/children/0/behaviors/0/options/hostname"},
+		},
+		"filter with regular expression": {
+			expression: "$..options[?(@ =~ /^STATIC\\./i)]",
+			expected:   []string{"/rules/children/0/behaviors/0/options/hostname"},
+		},
+		"filter with disjunction": {
+			expression: "$.rules.behaviors[?(@.name == 'caching' || @.options.httpsPort == 443)].name",
+			expected:   []string{"/rules/behaviors/0/name", "/rules/behaviors/1/name"},
+		},
+		"filter by existence": {
+			expression: "$.rules.behaviors[?(@.options.ttl)].name",
+			expected:   []string{"/rules/behaviors/1/name"},
+		},
+		"no match": {
+			expression: "$..behaviors[?(@.name == 'cpCode')]",
+		},
+		"index out of bounds": {
+			expression: "$.rules.behaviors[5]",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path, err := parseJSONPath(test.expression)
+			require.NoError(t, err)
+
+			var pointers []string
+			for _, node := range path.evaluate(document) {
+				pointers = append(pointers, node.pointer)
+			}
+			assert.Equal(t, test.expected, pointers)
+		})
+	}
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	tests := map[string]string{
+		"missing root":          "rules.behaviors",
+		"missing member name":   "$.rules.",
+		"unclosed bracket":      "$.rules[0",
+		"unclosed filter":       "$..behaviors[?(@.name == 'origin']",
+		"unterminated string":   "$['rules]",
+		"invalid regexp":        "$..name[?(@ =~ /[/)]",
+		"trailing characters":   "$.rules behaviors",
+		"filter without at":     "$..behaviors[?(name == 'origin')]",
+		"invalid bracket index": "$.rules.behaviors[one]",
+	}
+
+	for name, expression := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseJSONPath(expression)
+			assert.ErrorIs(t, err, ErrJSONPathSyntax)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeRulesLocked(t *testing.T) {
+	tests := map[string]struct {
+		baseLocked, overlayLocked bool
+		expectedLocked            bool
+	}{
+		"locked in base only": {
+			baseLocked:     true,
+			expectedLocked: true,
+		},
+		"locked in overlay only": {
+			overlayLocked:  true,
+			expectedLocked: true,
+		},
+		"locked in both": {
+			baseLocked:     true,
+			overlayLocked:  true,
+			expectedLocked: true,
+		},
+		"not locked": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			base := papi.Rules{
+				Name:           "default",
+				CriteriaLocked: test.baseLocked,
+				Behaviors: []papi.RuleBehavior{
+					{Name: "caching", Locked: test.baseLocked, Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "ttl": "1d"}},
+				},
+			}
+			overlay := papi.Rules{
+				CriteriaLocked: test.overlayLocked,
+				Behaviors: []papi.RuleBehavior{
+					{Name: "caching", Locked: test.overlayLocked, Options: papi.RuleOptionsMap{"ttl": "2d"}},
+				},
+			}
+
+			merged := mergeRules(base, overlay)
+
+			assert.Equal(t, test.expectedLocked, merged.CriteriaLocked)
+			assert.Equal(t, []papi.RuleBehavior{
+				{Name: "caching", Locked: test.expectedLocked, Options: papi.RuleOptionsMap{"behavior": "MAX_AGE", "ttl": "2d"}},
+			}, merged.Behaviors)
+			assert.Equal(t, test.baseLocked, base.Behaviors[0].Locked)
+			assert.Equal(t, "1d", base.Behaviors[0].Options["ttl"])
+		})
+	}
+}
@@ -26,13 +26,20 @@ type RulesBuilder struct {
 type RulesUpdate struct {
 	RuleFormat string `json:"_ruleFormat_"`
 	papi.RulesUpdate
+	// Fragment is set when the rule is a fragment shared by many rule trees
+	Fragment *Fragment `json:"_fragment_,omitempty"`
+	// Fragments lists the fragments used by the rule tree
+	Fragments []FragmentReference `json:"_fragments_,omitempty"`
 }
 
 const defaultRule = "default"
 
 // NewBuilder returns a new RulesBuilder that uses the provided schema.ResourceData to construct papi.Rules.
 func NewBuilder(d *schema.ResourceData) *RulesBuilder {
-	schemaReader := NewRulesSchemaReader(d)
+	return newBuilder(NewRulesSchemaReader(d))
+}
+
+func newBuilder(schemaReader *RulesSchemaReader) *RulesBuilder {
 	ruleFormat := schemaReader.GetRuleFormat()
 
 	return &RulesBuilder{
@@ -339,22 +346,92 @@ func (r RulesBuilder) ruleChildren() ([]papi.Rules, error) {
 		return nil, err
 	}
 
+	fragmentParameters, err := r.schemaReader.GetFragmentParameters()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	usedFragments := map[string]bool{}
+
 	children := make([]papi.Rules, 0, len(childrenList))
 	for _, childJSON := range childrenList {
-		var child RulesUpdate
-		err = json.Unmarshal([]byte(childJSON), &child)
+		child, err := r.parseChild(childJSON)
 		if err != nil {
 			return nil, err
 		}
-		if child.RuleFormat != "" && child.RuleFormat != r.schemaReader.ruleFormatKey {
-			return nil, fmt.Errorf("child rule is using different rule format (%s) than expected (%s)", child.RuleFormat, r.schemaReader.ruleFormatKey)
+		if child.Fragment != nil {
+			child.Rules, err = child.Fragment.instantiate(child.Rules, fragmentParameters[child.Fragment.Name])
+			if err != nil {
+				return nil, err
+			}
+			usedFragments[child.Fragment.Name] = true
 		}
 		children = append(children, child.Rules)
 	}
+	for name := range fragmentParameters {
+		if !usedFragments[name] {
+			return nil, fmt.Errorf("%w: fragment %s is not used by children", ErrFragmentParameter, name)
+		}
+	}
 
 	return children, nil
 }
 
+func (r RulesBuilder) parseChild(childJSON string) (RulesUpdate, error) {
+	var child RulesUpdate
+	if err := json.Unmarshal([]byte(childJSON), &child); err != nil {
+		return RulesUpdate{}, err
+	}
+	if child.RuleFormat != "" && child.RuleFormat != r.schemaReader.ruleFormatKey {
+		return RulesUpdate{}, fmt.Errorf("child rule is using different rule format (%s) than expected (%s)", child.RuleFormat, r.schemaReader.ruleFormatKey)
+	}
+	return child, nil
+}
+
+// Fragment returns the definition of the fragment, if the built rule is a fragment.
+func (r RulesBuilder) Fragment(rules *papi.Rules) (*Fragment, error) {
+	fragment, err := r.schemaReader.GetFragment()
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if rules.Name == defaultRule {
+		return nil, fmt.Errorf("%w: %s", ErrNotForDefault, "fragment")
+	}
+	if err := fragment.checkParameters(*rules); err != nil {
+		return nil, err
+	}
+	return fragment, nil
+}
+
+// Fragments returns the fragments used by the children of the built rule and by their children.
+func (r RulesBuilder) Fragments() ([]FragmentReference, error) {
+	childrenList, err := r.schemaReader.GetChildrenList()
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var fragments []FragmentReference
+	for _, childJSON := range childrenList {
+		child, err := r.parseChild(childJSON)
+		if err != nil {
+			return nil, err
+		}
+		if child.Fragment != nil {
+			fragments = append(fragments, child.Fragment.reference())
+		}
+		fragments = append(fragments, child.Fragments...)
+	}
+	if len(fragments) == 0 {
+		return nil, nil
+	}
+	return sortFragmentReferences(fragments), nil
+}
+
 func getFromMapAndDeleteOrDefault[T any](m map[string]any, key string, def T) T {
 	res, ok := m[key]
 	if !ok || res == nil {
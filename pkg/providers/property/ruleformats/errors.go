@@ -35,6 +35,8 @@ var (
 	ErrOnlyForDefault = errors.New("cannot be used outside 'default' rule")
 	// ErrNotForDefault is used when some fields cannot be used in "default" rules in data source
 	ErrNotForDefault = errors.New("cannot be used in 'default' rule")
+	// ErrFragmentParameter is used when parameters of a fragment are not used or given correctly
+	ErrFragmentParameter = errors.New("invalid fragment parameter")
 )
 
 // Error returns NotFoundError as a string.
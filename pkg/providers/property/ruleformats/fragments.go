@@ -0,0 +1,114 @@
+package ruleformats
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+)
+
+type (
+	// Fragment describes a rule, which is shared by rule trees of many properties. Its string option values can refer
+	// to parameters as {{fragment.<parameter>}}, which are replaced when the fragment is used as a child rule.
+	Fragment struct {
+		Name       string              `json:"name"`
+		Version    string              `json:"version"`
+		Parameters []FragmentParameter `json:"parameters,omitempty"`
+	}
+
+	// FragmentParameter is a parameter of a fragment, which is required unless it has a default value.
+	FragmentParameter struct {
+		Name    string  `json:"name"`
+		Default *string `json:"default,omitempty"`
+	}
+
+	// FragmentReference identifies the version of a fragment used by rules.
+	FragmentReference struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+)
+
+var fragmentPlaceholderRegexp = regexp.MustCompile(`{{fragment\.(\w+)}}`)
+
+// checkParameters verifies that the rules of the fragment refer only to its parameters.
+func (f *Fragment) checkParameters(rules papi.Rules) error {
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+	for _, match := range fragmentPlaceholderRegexp.FindAllStringSubmatch(string(encoded), -1) {
+		if f.parameter(match[1]) == nil {
+			return fmt.Errorf("%w: %s is not a parameter of fragment %s", ErrFragmentParameter, match[0], f.Name)
+		}
+	}
+	return nil
+}
+
+// instantiate returns the rules of the fragment with references to its parameters replaced by the given values
+// or by default values of the parameters.
+func (f *Fragment) instantiate(rules papi.Rules, values map[string]string) (papi.Rules, error) {
+	for name := range values {
+		if f.parameter(name) == nil {
+			return papi.Rules{}, fmt.Errorf("%w: fragment %s has no parameter %s", ErrFragmentParameter, f.Name, name)
+		}
+	}
+	resolved := make(map[string]string, len(f.Parameters))
+	for _, p := range f.Parameters {
+		if value, ok := values[p.Name]; ok {
+			resolved[p.Name] = value
+		} else if p.Default != nil {
+			resolved[p.Name] = *p.Default
+		} else {
+			return papi.Rules{}, fmt.Errorf("%w: value of parameter %s of fragment %s is required", ErrFragmentParameter, p.Name, f.Name)
+		}
+	}
+
+	encoded, err := json.Marshal(rules)
+	if err != nil {
+		return papi.Rules{}, err
+	}
+	// references can only be found in JSON strings, so the values are inserted escaped and without quotes
+	replaced := fragmentPlaceholderRegexp.ReplaceAllStringFunc(string(encoded), func(placeholder string) string {
+		value, _ := json.Marshal(resolved[fragmentPlaceholderRegexp.FindStringSubmatch(placeholder)[1]])
+		return string(value[1 : len(value)-1])
+	})
+
+	var result papi.Rules
+	if err := json.Unmarshal([]byte(replaced), &result); err != nil {
+		return papi.Rules{}, err
+	}
+	return result, nil
+}
+
+func (f *Fragment) parameter(name string) *FragmentParameter {
+	for i := range f.Parameters {
+		if f.Parameters[i].Name == name {
+			return &f.Parameters[i]
+		}
+	}
+	return nil
+}
+
+func (f *Fragment) reference() FragmentReference {
+	return FragmentReference{Name: f.Name, Version: f.Version}
+}
+
+// sortFragmentReferences sorts references by name and version and removes duplicates.
+func sortFragmentReferences(references []FragmentReference) []FragmentReference {
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].Name != references[j].Name {
+			return references[i].Name < references[j].Name
+		}
+		return references[i].Version < references[j].Version
+	})
+	result := make([]FragmentReference, 0, len(references))
+	for i, reference := range references {
+		if i == 0 || reference != references[i-1] {
+			result = append(result, reference)
+		}
+	}
+	return result
+}
@@ -0,0 +1,557 @@
+package ruleformats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/iancoleman/strcase"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const rulesBuilderDataSource = "akamai_property_rules_builder"
+
+// ruleAttributes lists attributes of a rule in the order in which they are written to HCL.
+// Children are written separately, as they refer to other data sources.
+var ruleAttributes = []string{
+	"name", "is_secure", "criteria_must_satisfy", "criteria_locked", "comments", "uuid", "template_uuid",
+	"template_link", "advanced_override", "custom_override", "variable", "criterion", "behavior",
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// RulesToHCL converts rules in PAPI JSON format into akamai_property_rules_builder data sources using the given frozen
+// rule format, e.g. "v2025-10-16". Every rule gets its own data source, named after the rule, and parent rules refer to
+// the JSON of their children. Every converted rule is built back with RulesBuilder and compared with the original one,
+// so an error is returned instead of HCL which would produce different rules.
+//
+// Null options and empty lists of objects are omitted, as they cannot be expressed with blocks.
+func RulesToHCL(ruleFormat string, rules papi.Rules) (string, error) {
+	rf, err := registeredRuleFormat(ruleFormat)
+	if err != nil {
+		return "", err
+	}
+
+	ruleErrors, err := ValidateRules(ruleFormat, rules)
+	if err != nil {
+		return "", err
+	}
+	if len(ruleErrors) > 0 {
+		errs := make([]error, 0, len(ruleErrors))
+		for _, e := range ruleErrors {
+			errs = append(errs, e)
+		}
+		return "", fmt.Errorf("rules are not valid for rule format %s:\n%w", ruleFormat, errors.Join(errs...))
+	}
+
+	c := hclConverter{
+		ruleFormat:  rf,
+		ruleSchemas: schemasRegistry.schemas()[rf.version].Elem.(*schema.Resource).Schema,
+		behaviors:   schemaKeys(rf.behaviorsSchemas, rf.nameMappings),
+		criteria:    schemaKeys(rf.criteriaSchemas, rf.nameMappings),
+		file:        hclwrite.NewEmptyFile(),
+		names:       map[string]bool{},
+	}
+	if _, err := c.convertRule(rules.Name, rules); err != nil {
+		return "", err
+	}
+	return string(hclwrite.Format(c.file.Bytes())), nil
+}
+
+type hclConverter struct {
+	ruleFormat  *RuleFormat
+	ruleSchemas map[string]*schema.Schema
+	behaviors   map[string]string
+	criteria    map[string]string
+	file        *hclwrite.File
+	names       map[string]bool
+}
+
+// schemaKeys maps names of behaviors or criteria used in PAPI JSON to their schema keys
+func schemaKeys(schemas map[string]*schema.Schema, nameMappings map[string]string) map[string]string {
+	keys := make(map[string]string, len(schemas))
+	for key := range schemas {
+		keys[jsonName(key, "", nameMappings)] = key
+	}
+	return keys
+}
+
+// convertRule writes the data source of the rule followed by data sources of its children, and returns its name
+func (c *hclConverter) convertRule(path string, rule papi.Rules) (string, error) {
+	name := c.dataSourceName(rule.Name)
+	if len(c.file.Body().Blocks()) > 0 {
+		c.file.Body().AppendNewline()
+	}
+	block := c.file.Body().AppendNewBlock("data", []string{rulesBuilderDataSource, name})
+	rulesBody := block.Body().AppendNewBlock(c.ruleFormat.version, nil).Body()
+
+	value, err := c.ruleValue(path, rule)
+	if err != nil {
+		return "", err
+	}
+	for _, attribute := range ruleAttributes {
+		if v, ok := value[attribute]; ok {
+			if err := writeValue(rulesBody, attribute, v, c.ruleSchemas[attribute]); err != nil {
+				return "", fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	// the builder removes some options from the value while reading them, so it is verified after writing
+	if err := c.verifyRule(path, rule, value); err != nil {
+		return "", err
+	}
+
+	children := make([]hclwrite.Tokens, 0, len(rule.Children))
+	for _, child := range rule.Children {
+		childName, err := c.convertRule(path+"/"+child.Name, child)
+		if err != nil {
+			return "", err
+		}
+		children = append(children, hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: "data"},
+			hcl.TraverseAttr{Name: rulesBuilderDataSource},
+			hcl.TraverseAttr{Name: childName},
+			hcl.TraverseAttr{Name: "json"},
+		}))
+	}
+	if len(children) > 0 {
+		rulesBody.SetAttributeRaw("children", multilineTuple(children))
+	}
+
+	return name, nil
+}
+
+// multilineTuple returns tokens of a tuple with every element in a separate line
+func multilineTuple(elems []hclwrite.Tokens) hclwrite.Tokens {
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	for _, elem := range elems {
+		tokens = append(tokens, elem...)
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+			&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		)
+	}
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+}
+
+// dataSourceName returns a unique name of the data source for the rule, e.g. "content_compression" for "Content Compression"
+func (c *hclConverter) dataSourceName(ruleName string) string {
+	base := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(ruleName), "_"), "_")
+	if base == "" || base[0] >= '0' && base[0] <= '9' {
+		base = strings.TrimSuffix("rule_"+base, "_")
+	}
+
+	name := base
+	for i := 2; c.names[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	c.names[name] = true
+	return name
+}
+
+// ruleValue converts the rule, without its children, into the shape in which RulesSchemaReader reads it from the configuration
+func (c *hclConverter) ruleValue(path string, rule papi.Rules) (map[string]any, error) {
+	value := map[string]any{"name": rule.Name}
+	isDefault := rule.Name == defaultRule
+	setString := func(key, s string) {
+		if s != "" {
+			value[key] = s
+		}
+	}
+
+	if isDefault {
+		value["is_secure"] = rule.Options.IsSecure
+	} else if rule.Options.IsSecure {
+		value["is_secure"] = true
+	}
+	// 'all' is the default, which cannot be set in the default rule
+	if !isDefault || rule.CriteriaMustSatisfy != papi.RuleCriteriaMustSatisfyAll {
+		setString("criteria_must_satisfy", string(rule.CriteriaMustSatisfy))
+	}
+	if rule.CriteriaLocked {
+		value["criteria_locked"] = true
+	}
+	setString("comments", rule.Comments)
+	setString("uuid", rule.UUID)
+	setString("template_uuid", rule.TemplateUuid)
+	setString("template_link", rule.TemplateLink)
+	setString("advanced_override", rule.AdvancedOverride)
+	if rule.CustomOverride != nil {
+		value["custom_override"] = []any{map[string]any{
+			"name":        rule.CustomOverride.Name,
+			"override_id": rule.CustomOverride.OverrideID,
+		}}
+	}
+
+	if len(rule.Variables) > 0 {
+		variables := make([]any, 0, len(rule.Variables))
+		for _, v := range rule.Variables {
+			var variableValue, description string
+			if v.Value != nil {
+				variableValue = *v.Value
+			}
+			if v.Description != nil {
+				description = *v.Description
+			}
+			variables = append(variables, map[string]any{
+				"name":        v.Name,
+				"value":       variableValue,
+				"description": description,
+				"hidden":      v.Hidden,
+				"sensitive":   v.Sensitive,
+			})
+		}
+		value["variable"] = variables
+	}
+
+	criteria, err := c.itemsValue(path, rule.Criteria, c.criteria, c.ruleFormat.criteriaSchemas)
+	if err != nil {
+		return nil, err
+	}
+	if len(criteria) > 0 {
+		value["criterion"] = criteria
+	}
+	behaviors, err := c.itemsValue(path, rule.Behaviors, c.behaviors, c.ruleFormat.behaviorsSchemas)
+	if err != nil {
+		return nil, err
+	}
+	if len(behaviors) > 0 {
+		value["behavior"] = behaviors
+	}
+
+	return value, nil
+}
+
+func (c *hclConverter) itemsValue(path string, items []papi.RuleBehavior, keys map[string]string, schemas map[string]*schema.Schema) ([]any, error) {
+	values := make([]any, 0, len(items))
+	for _, item := range items {
+		key := keys[item.Name]
+		options, err := c.optionsValue(item.Name, item.Options, schemas[key].Elem.(*schema.Resource).Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if item.Locked {
+			options["locked"] = true
+		}
+		if item.UUID != "" {
+			options["uuid"] = item.UUID
+		}
+		if item.TemplateUuid != "" {
+			options["template_uuid"] = item.TemplateUuid
+		}
+		values = append(values, map[string]any{key: []any{options}})
+	}
+	return values, nil
+}
+
+// optionsValue converts options of a behavior, criterion or a nested object. The option path is the same
+// which RulesBuilder uses to look up name mappings, type mappings and options to flatten, e.g. "origin.customCertificates".
+func (c *hclConverter) optionsValue(optionPath string, options map[string]any, schemas map[string]*schema.Schema) (map[string]any, error) {
+	type optionKey struct{ key, name string }
+	byName := make(map[string]optionKey, len(schemas))
+	for key := range schemas {
+		name := strcase.ToLowerCamel(key)
+		if mapped, ok := c.ruleFormat.nameMappings[name]; ok {
+			name = mapped
+		}
+		jsonName := name
+		if mapped, ok := c.ruleFormat.nameMappings[extractLastTwoSegments(optionPath+"."+name)]; ok {
+			jsonName = mapped
+		}
+		byName[jsonName] = optionKey{key: key, name: name}
+	}
+
+	value := make(map[string]any, len(options))
+	for name, option := range options {
+		if option == nil {
+			continue
+		}
+		k, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%s.%s: unknown option", optionPath, name)
+		}
+		v, err := c.optionValue(optionPath+"."+k.name, option, schemas[k.key])
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			value[k.key] = v
+		}
+	}
+	return value, nil
+}
+
+//nolint:gocyclo
+func (c *hclConverter) optionValue(optionPath string, option any, s *schema.Schema) (any, error) {
+	if str, ok := option.(string); ok && s.Type != schema.TypeString && variablePattern.MatchString(str) {
+		return nil, fmt.Errorf("%s: values referring to variables can be converted only for string options", optionPath)
+	}
+
+	switch s.Type {
+	case schema.TypeString:
+		switch v := option.(type) {
+		case string:
+			return v, nil
+		case float64, bool:
+			str := fmt.Sprintf("%v", v)
+			if f, ok := v.(float64); ok {
+				str = strconv.FormatFloat(f, 'f', -1, 64)
+			}
+			if _, ok := c.ruleFormat.typeMappings[optionPath+"."+str]; ok {
+				return str, nil
+			}
+		}
+	case schema.TypeBool:
+		if v, ok := option.(bool); ok {
+			return v, nil
+		}
+	case schema.TypeInt:
+		if v, ok := option.(float64); ok && v == float64(int64(v)) {
+			return int64(v), nil
+		}
+	case schema.TypeFloat:
+		if v, ok := option.(float64); ok {
+			return v, nil
+		}
+	case schema.TypeList, schema.TypeSet:
+		return c.listValue(optionPath, option, s)
+	}
+	return nil, fmt.Errorf("%s: unexpected value %v for option of type %s", optionPath, option, s.Type)
+}
+
+// listValue converts lists of values and objects, which are represented by blocks
+func (c *hclConverter) listValue(optionPath string, option any, s *schema.Schema) (any, error) {
+	resource, isObject := s.Elem.(*schema.Resource)
+	if object, ok := option.(map[string]any); ok && isObject {
+		v, err := c.optionsValue(optionPath, object, resource.Schema)
+		if err != nil {
+			return nil, err
+		}
+		return []any{v}, nil
+	}
+
+	items, ok := option.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected value %v for option of type %s", optionPath, option, s.Type)
+	}
+	if isObject && len(items) == 0 {
+		return nil, nil
+	}
+	values := make([]any, 0, len(items))
+	for _, item := range items {
+		var v any
+		var err error
+		if isObject {
+			object, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: unexpected value %v in list of objects", optionPath, item)
+			}
+			v, err = c.optionsValue(optionPath, object, resource.Schema)
+		} else {
+			v, err = c.optionValue(optionPath, item, s.Elem.(*schema.Schema))
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// verifyRule builds the converted rule with RulesBuilder and checks whether it is equal to the original one
+func (c *hclConverter) verifyRule(path string, rule papi.Rules, value map[string]any) error {
+	reader := &RulesSchemaReader{
+		data:          valueGetter{c.ruleFormat.version: []any{value}},
+		ruleFormatKey: c.ruleFormat.version,
+	}
+	built, err := newBuilder(reader).Build()
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	expected := rule
+	expected.Children, built.Children = nil, nil
+	expectedValue, err := comparableRule(expected)
+	if err != nil {
+		return err
+	}
+	builtValue, err := comparableRule(*built)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(expectedValue, builtValue) {
+		return fmt.Errorf("%s: rule cannot be converted without changes", path)
+	}
+	return nil
+}
+
+// comparableRule returns the JSON representation of the rule without nulls and empty values,
+// which PAPI and RulesBuilder represent differently
+func comparableRule(rule papi.Rules) (any, error) {
+	if rule.CriteriaMustSatisfy == "" {
+		rule.CriteriaMustSatisfy = papi.RuleCriteriaMustSatisfyAll
+	}
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return withoutEmpty(v), nil
+}
+
+func withoutEmpty(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			item = withoutEmpty(item)
+			if isEmpty(item) {
+				delete(val, k)
+				continue
+			}
+			val[k] = item
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = withoutEmpty(item)
+		}
+	}
+	return v
+}
+
+func isEmpty(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case map[string]any:
+		return len(val) == 0
+	case []any:
+		return len(val) == 0
+	}
+	return false
+}
+
+// writeValue writes the value of an attribute, or blocks of a list of objects, to the body
+func writeValue(body *hclwrite.Body, name string, value any, s *schema.Schema) error {
+	if resource, ok := s.Elem.(*schema.Resource); ok {
+		for _, item := range value.([]any) {
+			if err := writeBlock(body.AppendNewBlock(name, nil).Body(), item.(map[string]any), resource.Schema); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	v, err := ctyValue(value, s)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	body.SetAttributeValue(name, v)
+	return nil
+}
+
+// writeBlock writes attributes sorted by name, followed by nested blocks
+func writeBlock(body *hclwrite.Body, values map[string]any, schemas map[string]*schema.Schema) error {
+	var attributes, blocks []string
+	for name := range values {
+		if _, ok := schemas[name].Elem.(*schema.Resource); ok {
+			blocks = append(blocks, name)
+		} else {
+			attributes = append(attributes, name)
+		}
+	}
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	for _, name := range append(attributes, blocks...) {
+		if err := writeValue(body, name, values[name], schemas[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ctyValue(value any, s *schema.Schema) (cty.Value, error) {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case int64:
+		return cty.NumberIntVal(v), nil
+	case float64:
+		return cty.NumberFloatVal(v), nil
+	case []any:
+		elem, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected list of %T", s.Elem)
+		}
+		if len(v) == 0 {
+			return cty.ListValEmpty(ctyType(elem.Type)), nil
+		}
+		items := make([]cty.Value, 0, len(v))
+		for _, item := range v {
+			ctyItem, err := ctyValue(item, elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			items = append(items, ctyItem)
+		}
+		return cty.ListVal(items), nil
+	}
+	return cty.NilVal, fmt.Errorf("unexpected value %v", value)
+}
+
+func ctyType(t schema.ValueType) cty.Type {
+	switch t {
+	case schema.TypeBool:
+		return cty.Bool
+	case schema.TypeInt, schema.TypeFloat:
+		return cty.Number
+	}
+	return cty.String
+}
+
+// valueGetter reads values converted from PAPI JSON the same way tf.RawConfig reads them from the configuration
+type valueGetter map[string]any
+
+// GetOk returns the value under the key, e.g. "rules_v2025_10_16.0.behavior"
+func (g valueGetter) GetOk(key string) (any, bool) {
+	var current any = map[string]any(g)
+	for _, part := range strings.Split(key, ".") {
+		switch val := current.(type) {
+		case map[string]any:
+			v, ok := val[part]
+			if !ok {
+				return nil, false
+			}
+			current = v
+		case []any:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(val) {
+				return nil, false
+			}
+			current = val[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -249,3 +249,12 @@ func (r *registry) schemas() map[string]*schema.Schema {
 
 	return schemas
 }
+
+func (r *registry) ruleFormat(version string) *RuleFormat {
+	for i := range r.rules {
+		if r.rules[i].version == version {
+			return &r.rules[i]
+		}
+	}
+	return nil
+}
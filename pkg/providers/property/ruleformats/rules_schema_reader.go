@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/ptr"
 	"github.com/akamai/terraform-provider-akamai/v9/pkg/common/tf"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -114,6 +115,80 @@ func (r *RulesSchemaReader) GetChildrenList() ([]string, error) {
 	return children, nil
 }
 
+// GetFragment reads and returns the fragment definition of the rule.
+func (r *RulesSchemaReader) GetFragment() (*Fragment, error) {
+	rawVal, ok := r.data.GetOk(fragmentKey)
+	if !ok || rawVal == nil {
+		return nil, &NotFoundError{fragmentKey}
+	}
+	listVal, ok := rawVal.([]any)
+	if !ok {
+		return nil, &TypeAssertionError{"[]any", typeof(rawVal), fragmentKey}
+	}
+	if len(listVal) == 0 {
+		return nil, &NotFoundError{fragmentKey}
+	}
+	fragmentMap, ok := listVal[0].(map[string]any)
+	if !ok {
+		return nil, &TypeAssertionError{"map[string]any", typeof(listVal[0]), fragmentKey}
+	}
+
+	fragment := &Fragment{
+		Name:    fragmentMap["name"].(string),
+		Version: fragmentMap["version"].(string),
+	}
+	parameters, _ := fragmentMap["parameter"].([]any)
+	for _, val := range parameters {
+		parameter, ok := val.(map[string]any)
+		if !ok {
+			return nil, &TypeAssertionError{"map[string]any", typeof(val), fragmentKey}
+		}
+		p := FragmentParameter{Name: parameter["name"].(string)}
+		if defaultValue, ok := parameter["default"].(string); ok {
+			p.Default = ptr.To(defaultValue)
+		}
+		fragment.Parameters = append(fragment.Parameters, p)
+	}
+
+	return fragment, nil
+}
+
+// GetFragmentParameters reads and returns values of fragment parameters by fragment name.
+func (r *RulesSchemaReader) GetFragmentParameters() (map[string]map[string]string, error) {
+	rawVal, ok := r.data.GetOk(fragmentParametersKey)
+	if !ok || rawVal == nil {
+		return nil, &NotFoundError{fragmentParametersKey}
+	}
+	listVal, ok := rawVal.([]any)
+	if !ok {
+		return nil, &TypeAssertionError{"[]any", typeof(rawVal), fragmentParametersKey}
+	}
+
+	parameters := make(map[string]map[string]string, len(listVal))
+	for _, val := range listVal {
+		fragmentMap, ok := val.(map[string]any)
+		if !ok {
+			return nil, &TypeAssertionError{"map[string]any", typeof(val), fragmentParametersKey}
+		}
+		name := fragmentMap["name"].(string)
+		if _, ok := parameters[name]; ok {
+			return nil, fmt.Errorf("%w: values of fragment %s given more than once", ErrFragmentParameter, name)
+		}
+		values := map[string]string{}
+		valuesMap, _ := fragmentMap["values"].(map[string]any)
+		for k, v := range valuesMap {
+			value, ok := v.(string)
+			if !ok {
+				return nil, &TypeAssertionError{"string", typeof(v), fragmentParametersKey}
+			}
+			values[k] = value
+		}
+		parameters[name] = values
+	}
+
+	return parameters, nil
+}
+
 func (r *RulesSchemaReader) getString(key string) (string, error) {
 	rawVal, ok := r.data.GetOk(key)
 	if !ok || rawVal == nil {
@@ -274,6 +349,11 @@ func (r *RulesSchemaReader) findRuleItem(itemsMap map[string]any) (RuleItem, err
 	return ruleItems[0], nil
 }
 
+const (
+	fragmentKey           = "fragment"
+	fragmentParametersKey = "fragment_parameters"
+)
+
 func (r *RulesSchemaReader) behaviorsKey() string {
 	return fmt.Sprintf("%s.0.behavior", r.ruleFormatKey)
 }
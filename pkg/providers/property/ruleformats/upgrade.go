@@ -0,0 +1,345 @@
+package ruleformats
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RuleChange is a difference between rule formats which affects the rules upgraded by UpgradeRules
+type RuleChange struct {
+	// RulePath is the path of the rule, e.g. "default/Performance/Compression"
+	RulePath string
+	// Location is the element of the rule, e.g. "behavior mediaOriginFailover.originUnavailableBlacklistWindow"
+	Location string
+	// Message describes the change
+	Message string
+	// Resolved indicates whether the upgraded rules already account for the change,
+	// otherwise they need to be fixed before they can be used with the target rule format
+	Resolved bool
+}
+
+// UpgradeRules moves the rules from one frozen rule format to another by comparing their schemas. It renames behaviors,
+// criteria and options which are available under a different name in the target rule format, converts option values
+// whose type has changed, when it can be done without loss, and removes options which are no longer available.
+//
+// The rules are modified in place. Every change is reported, including the ones which could not be resolved,
+// e.g. behaviors missing in the target rule format or values which are not allowed by it.
+func UpgradeRules(sourceFormat, targetFormat string, rules *papi.Rules) ([]RuleChange, error) {
+	source, err := registeredRuleFormat(sourceFormat)
+	if err != nil {
+		return nil, err
+	}
+	target, err := registeredRuleFormat(targetFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	u := ruleUpgrader{
+		targetFormat:    targetFormat,
+		source:          source,
+		target:          target,
+		sourceBehaviors: itemSchemas(source.behaviorsSchemas, source.nameMappings),
+		targetBehaviors: itemSchemas(target.behaviorsSchemas, target.nameMappings),
+		sourceCriteria:  itemSchemas(source.criteriaSchemas, source.nameMappings),
+		targetCriteria:  itemSchemas(target.criteriaSchemas, target.nameMappings),
+		reported:        map[string]bool{},
+	}
+	u.upgradeRule(rules.Name, rules)
+
+	ruleErrors, err := ValidateRules(targetFormat, *rules)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range ruleErrors {
+		if !u.reported[e.RulePath+" "+e.Location] {
+			u.changes = append(u.changes, RuleChange{RulePath: e.RulePath, Location: e.Location, Message: e.Message})
+		}
+	}
+	return u.changes, nil
+}
+
+// registeredRuleFormat returns the frozen rule format with the given version, e.g. "v2025-10-16"
+func registeredRuleFormat(version string) (*RuleFormat, error) {
+	v, ok := FindRuleFormat(version)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRuleFormat, version)
+	}
+	return schemasRegistry.ruleFormat(v.SchemaKey()), nil
+}
+
+type ruleUpgrader struct {
+	targetFormat                     string
+	source, target                   *RuleFormat
+	sourceBehaviors, targetBehaviors map[string]*schema.Schema
+	sourceCriteria, targetCriteria   map[string]*schema.Schema
+	changes                          []RuleChange
+	reported                         map[string]bool
+}
+
+func (u *ruleUpgrader) addChange(rulePath, location string, resolved bool, format string, args ...any) {
+	u.changes = append(u.changes, RuleChange{
+		RulePath: rulePath,
+		Location: location,
+		Message:  fmt.Sprintf(format, args...),
+		Resolved: resolved,
+	})
+	u.reported[rulePath+" "+location] = true
+}
+
+func (u *ruleUpgrader) upgradeRule(path string, rule *papi.Rules) {
+	u.upgradeItems(path, "behavior", rule.Behaviors, u.sourceBehaviors, u.targetBehaviors)
+	u.upgradeItems(path, "criterion", rule.Criteria, u.sourceCriteria, u.targetCriteria)
+
+	for i := range rule.Children {
+		child := &rule.Children[i]
+		u.upgradeRule(path+"/"+child.Name, child)
+	}
+}
+
+func (u *ruleUpgrader) upgradeItems(path, kind string, items []papi.RuleBehavior, source, target map[string]*schema.Schema) {
+	for i := range items {
+		item := &items[i]
+		location := kind + " " + item.Name
+		sourceSchema, ok := source[item.Name]
+		if !ok {
+			// unknown items are reported by the validation of the upgraded rules
+			continue
+		}
+		sourceName := item.Name
+
+		targetSchema, ok := target[item.Name]
+		if !ok {
+			renamed := renamedItem(sourceSchema, source, target)
+			if renamed == "" {
+				u.addChange(path, location, false, "%s is not available in rule format %s", kind, u.targetFormat)
+				continue
+			}
+			u.addChange(path, location, true, "%s renamed to %s in rule format %s", kind, renamed, u.targetFormat)
+			item.Name = renamed
+			targetSchema = target[renamed]
+		}
+
+		u.upgradeOptions(path, location, optionScope{sourceName, item.Name}, item.Options,
+			sourceSchema.Elem.(*schema.Resource).Schema, targetSchema.Elem.(*schema.Resource).Schema)
+	}
+}
+
+// renamedItem returns the name of a behavior or criterion, which is available only in the target rule format
+// and has the same options as the given one, if there is exactly one
+func renamedItem(itemSchema *schema.Schema, source, target map[string]*schema.Schema) string {
+	options := sortedKeys(itemSchema.Elem.(*schema.Resource).Schema)
+	var candidates []string
+	for name, s := range target {
+		if _, ok := source[name]; ok {
+			continue
+		}
+		if strings.Join(sortedKeys(s.Elem.(*schema.Resource).Schema), ",") == strings.Join(options, ",") {
+			candidates = append(candidates, name)
+		}
+	}
+	if len(candidates) != 1 {
+		return ""
+	}
+	return candidates[0]
+}
+
+// optionScope holds the names of the behavior or the object containing options in both rule formats,
+// which are used to map option names
+type optionScope struct {
+	source, target string
+}
+
+func (u *ruleUpgrader) upgradeOptions(path, location string, scope optionScope, options map[string]any, source, target map[string]*schema.Schema) {
+	sourceKeys := make(map[string]string, len(source))
+	for key := range source {
+		sourceKeys[jsonName(key, scope.source, u.source.nameMappings)] = key
+	}
+	targetNames := make(map[string]string, len(target))
+	for key := range target {
+		targetNames[key] = jsonName(key, scope.target, u.target.nameMappings)
+	}
+
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := options[name]
+		optionLocation := location + "." + name
+		sourceKey, ok := sourceKeys[name]
+		if !ok {
+			continue
+		}
+		targetName := name
+		targetSchema, ok := target[sourceKey]
+		if !ok {
+			targetKey := renamedOption(sourceKey, source, target)
+			if targetKey == "" {
+				delete(options, name)
+				if value != nil {
+					u.addChange(path, optionLocation, true, "option removed, as it is not available in rule format %s", u.targetFormat)
+				}
+				continue
+			}
+			targetName, targetSchema = targetNames[targetKey], target[targetKey]
+			delete(options, name)
+			options[targetName] = value
+			u.addChange(path, optionLocation, true, "option renamed to %s in rule format %s", targetName, u.targetFormat)
+			optionLocation = location + "." + targetName
+		}
+		if str, ok := value.(string); value == nil || ok && variablePattern.MatchString(str) {
+			continue
+		}
+
+		sourceSchema := source[sourceKey]
+		if sourceSchema.Type != targetSchema.Type {
+			converted, ok := convertValue(value, targetSchema)
+			if ok {
+				options[targetName] = converted
+				u.addChange(path, optionLocation, true, "value converted from %s to %s in rule format %s",
+					typeName(sourceSchema.Type), typeName(targetSchema.Type), u.targetFormat)
+			} else {
+				u.addChange(path, optionLocation, false, "option type changed from %s to %s in rule format %s",
+					typeName(sourceSchema.Type), typeName(targetSchema.Type), u.targetFormat)
+			}
+			continue
+		}
+
+		sourceResource, sourceIsObject := sourceSchema.Elem.(*schema.Resource)
+		targetResource, targetIsObject := targetSchema.Elem.(*schema.Resource)
+		if !sourceIsObject || !targetIsObject {
+			continue
+		}
+		nestedScope := optionScope{name, targetName}
+		switch v := value.(type) {
+		case map[string]any:
+			u.upgradeOptions(path, optionLocation, nestedScope, v, sourceResource.Schema, targetResource.Schema)
+		case []any:
+			for i, item := range v {
+				if object, ok := item.(map[string]any); ok {
+					itemLocation := fmt.Sprintf("%s[%d]", optionLocation, i)
+					u.upgradeOptions(path, itemLocation, nestedScope, object, sourceResource.Schema, targetResource.Schema)
+				}
+			}
+		}
+	}
+}
+
+// renamedOption returns the key of an option, which is available only in the target rule format, has the same type
+// as the given one and its name differs by a single word, e.g. "origin_unavailable_blocklist_window" for
+// "origin_unavailable_blacklist_window", if there is exactly one
+func renamedOption(key string, source, target map[string]*schema.Schema) string {
+	words := strings.Split(key, "_")
+	var candidates []string
+	for candidate, s := range target {
+		if _, ok := source[candidate]; ok || s.Type != source[key].Type {
+			continue
+		}
+		candidateWords := strings.Split(candidate, "_")
+		if len(candidateWords) != len(words) {
+			continue
+		}
+		differences := 0
+		for i := range words {
+			if words[i] != candidateWords[i] {
+				differences++
+			}
+		}
+		if differences == 1 {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) != 1 {
+		return ""
+	}
+	return candidates[0]
+}
+
+// convertValue converts the value to the type of the target schema, if it can be done without loss,
+// e.g. "10" to 10 or a single value to a list
+//
+//nolint:gocyclo
+func convertValue(value any, target *schema.Schema) (any, bool) {
+	switch target.Type {
+	case schema.TypeString:
+		switch v := value.(type) {
+		case string:
+			return v, true
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		case bool:
+			return strconv.FormatBool(v), true
+		}
+	case schema.TypeInt:
+		if f, ok := value.(float64); ok && f == float64(int64(f)) {
+			return f, true
+		}
+		if s, ok := value.(string); ok {
+			if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return float64(i), true
+			}
+		}
+	case schema.TypeFloat:
+		if f, ok := value.(float64); ok {
+			return f, true
+		}
+		if s, ok := value.(string); ok {
+			if f, err := strconv.ParseFloat(s, 64); err == nil {
+				return f, true
+			}
+		}
+	case schema.TypeBool:
+		if b, ok := value.(bool); ok {
+			return b, true
+		}
+		if s, ok := value.(string); ok {
+			if b, err := strconv.ParseBool(s); err == nil {
+				return b, true
+			}
+		}
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := target.Elem.(*schema.Schema)
+		if !ok {
+			return nil, false
+		}
+		if _, isList := value.([]any); isList {
+			return nil, false
+		}
+		if converted, ok := convertValue(value, elem); ok {
+			return []any{converted}, true
+		}
+	}
+	return nil, false
+}
+
+func typeName(t schema.ValueType) string {
+	switch t {
+	case schema.TypeString:
+		return "string"
+	case schema.TypeBool:
+		return "boolean"
+	case schema.TypeInt:
+		return "integer"
+	case schema.TypeFloat:
+		return "number"
+	case schema.TypeList, schema.TypeSet:
+		return "array"
+	}
+	return t.String()
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
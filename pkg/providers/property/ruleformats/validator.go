@@ -0,0 +1,269 @@
+package ruleformats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v12/pkg/papi"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/iancoleman/strcase"
+)
+
+// ErrUnknownRuleFormat is returned when rules are validated against a rule format which is not frozen in the provider
+var ErrUnknownRuleFormat = errors.New("unknown rule format")
+
+// variablePattern matches values which refer to property variables, e.g. "{{user.PMUSER_ORIGIN}}"
+var variablePattern = regexp.MustCompile(`^{{.+}}$`)
+
+// RuleError is a problem found in a rule tree by ValidateRules
+type RuleError struct {
+	// RulePath is the path of the rule, e.g. "default/Performance/Compression"
+	RulePath string
+	// Location is the element of the rule, e.g. "behavior gzipResponse.behavior"
+	Location string
+	// Message describes the problem
+	Message string
+}
+
+// Error returns RuleError as a string.
+func (e RuleError) Error() string {
+	if e.Location == "" {
+		return fmt.Sprintf("%s: %s", e.RulePath, e.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.RulePath, e.Location, e.Message)
+}
+
+// FindRuleFormat returns the frozen rule format with the given version, e.g. "v2023-01-05" or "rules_v2023_01_05".
+func FindRuleFormat(version string) (RuleVersion, bool) {
+	for _, rf := range RulesFormats() {
+		if rf.Version() == version || rf.SchemaKey() == version {
+			return rf, true
+		}
+	}
+	return "", false
+}
+
+// ValidateRules checks the rule tree against the schema of the frozen rule format without calling PAPI.
+// It reports unknown behaviors, criteria and options, option values of a wrong type and values not allowed
+// by the rule format, e.g. ones not in the option's enum. Values referring to property variables are not checked.
+func ValidateRules(ruleFormat string, rules papi.Rules) ([]RuleError, error) {
+	rf, err := registeredRuleFormat(ruleFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	v := ruleValidator{
+		behaviors:    itemSchemas(rf.behaviorsSchemas, rf.nameMappings),
+		criteria:     itemSchemas(rf.criteriaSchemas, rf.nameMappings),
+		nameMappings: rf.nameMappings,
+		typeMappings: rf.typeMappings,
+	}
+	v.validateRule(rules.Name, &rules, true)
+	return v.errors, nil
+}
+
+type ruleValidator struct {
+	behaviors    map[string]*schema.Schema
+	criteria     map[string]*schema.Schema
+	nameMappings map[string]string
+	typeMappings map[string]any
+	errors       []RuleError
+}
+
+// itemSchemas indexes schemas of behaviors or criteria by their names used in PAPI JSON
+func itemSchemas(schemas map[string]*schema.Schema, nameMappings map[string]string) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(schemas))
+	for key, s := range schemas {
+		result[jsonName(key, "", nameMappings)] = s
+	}
+	return result
+}
+
+// jsonName returns the name used in PAPI JSON for the schema key, the same way RulesBuilder does
+func jsonName(key, parent string, nameMappings map[string]string) string {
+	name := strcase.ToLowerCamel(key)
+	if mapped, ok := nameMappings[name]; ok {
+		name = mapped
+	}
+	if mapped, ok := nameMappings[parent+"."+name]; ok && parent != "" {
+		name = mapped
+	}
+	return name
+}
+
+func (v *ruleValidator) addError(rulePath, location, format string, args ...any) {
+	v.errors = append(v.errors, RuleError{RulePath: rulePath, Location: location, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *ruleValidator) validateRule(path string, rule *papi.Rules, isDefault bool) {
+	switch rule.CriteriaMustSatisfy {
+	case "", papi.RuleCriteriaMustSatisfyAll, papi.RuleCriteriaMustSatisfyAny:
+	default:
+		v.addError(path, "criteriaMustSatisfy", "expected 'all' or 'any', got %q", rule.CriteriaMustSatisfy)
+	}
+	if !isDefault && len(rule.Variables) > 0 {
+		v.addError(path, "variables", "%s", ErrOnlyForDefault)
+	}
+
+	v.validateItems(path, "behavior", rule.Behaviors, v.behaviors)
+	v.validateItems(path, "criterion", rule.Criteria, v.criteria)
+
+	for i := range rule.Children {
+		child := &rule.Children[i]
+		v.validateRule(path+"/"+child.Name, child, false)
+	}
+}
+
+func (v *ruleValidator) validateItems(path, kind string, items []papi.RuleBehavior, schemas map[string]*schema.Schema) {
+	for _, item := range items {
+		location := kind + " " + item.Name
+		itemSchema, ok := schemas[item.Name]
+		if !ok {
+			v.addError(path, location, "unknown %s", kind)
+			continue
+		}
+		options := itemSchema.Elem.(*schema.Resource).Schema
+		v.validateOptions(path, location, item.Name, item.Options, options)
+	}
+}
+
+// validateOptions checks options of a behavior, criterion or a nested object. The parent is the name
+// of the behavior or the object, and it is used to map option names the same way RulesBuilder does.
+func (v *ruleValidator) validateOptions(path, location, parent string, options map[string]any, schemas map[string]*schema.Schema) {
+	byName := make(map[string]string, len(schemas))
+	for key := range schemas {
+		byName[jsonName(key, parent, v.nameMappings)] = key
+	}
+
+	names := make([]string, 0, len(options))
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		optionLocation := location + "." + name
+		key, ok := byName[name]
+		if !ok {
+			v.addError(path, optionLocation, "unknown option")
+			continue
+		}
+		v.validateValue(path, optionLocation, parent, name, options[name], schemas[key])
+	}
+}
+
+//nolint:gocyclo
+func (v *ruleValidator) validateValue(path, location, parent, name string, value any, s *schema.Schema) {
+	if value == nil {
+		return
+	}
+	if str, ok := value.(string); ok && s.Type != schema.TypeString && variablePattern.MatchString(str) {
+		return
+	}
+
+	var converted any
+	switch s.Type {
+	case schema.TypeString:
+		switch val := value.(type) {
+		case string:
+			converted = val
+		case float64, bool:
+			// some enums contain values of other types, which the builder maps from strings
+			if _, ok := v.typeMappings[fmt.Sprintf("%s.%s.%v", parent, name, val)]; !ok {
+				v.addError(path, location, "expected string, got %s", jsonType(value))
+				return
+			}
+			converted = fmt.Sprintf("%v", val)
+		default:
+			v.addError(path, location, "expected string, got %s", jsonType(value))
+			return
+		}
+	case schema.TypeBool:
+		if _, ok := value.(bool); !ok {
+			v.addError(path, location, "expected boolean, got %s", jsonType(value))
+			return
+		}
+		converted = value
+	case schema.TypeInt:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			v.addError(path, location, "expected integer, got %s", jsonType(value))
+			return
+		}
+		converted = int(number)
+	case schema.TypeFloat:
+		if _, ok := value.(float64); !ok {
+			v.addError(path, location, "expected number, got %s", jsonType(value))
+			return
+		}
+		converted = value
+	case schema.TypeList, schema.TypeSet:
+		v.validateList(path, location, name, value, s)
+		return
+	default:
+		return
+	}
+
+	if s.ValidateDiagFunc != nil {
+		for _, d := range s.ValidateDiagFunc(converted, cty.GetAttrPath(name)) {
+			v.addError(path, location, "%s", strings.TrimPrefix(d.Summary, "value "))
+		}
+	}
+}
+
+// validateList checks lists of values and nested objects. Objects are represented in the schema
+// by lists with a single element, and they can be provided either as JSON objects or as lists.
+func (v *ruleValidator) validateList(path, location, name string, value any, s *schema.Schema) {
+	resource, isObject := s.Elem.(*schema.Resource)
+
+	if object, ok := value.(map[string]any); ok {
+		if !isObject {
+			v.addError(path, location, "expected array, got object")
+			return
+		}
+		v.validateOptions(path, location, name, object, resource.Schema)
+		return
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		v.addError(path, location, "expected array, got %s", jsonType(value))
+		return
+	}
+	for i, item := range items {
+		itemLocation := fmt.Sprintf("%s[%d]", location, i)
+		if isObject {
+			object, ok := item.(map[string]any)
+			if !ok {
+				v.addError(path, itemLocation, "expected object, got %s", jsonType(item))
+				continue
+			}
+			v.validateOptions(path, itemLocation, name, object, resource.Schema)
+			continue
+		}
+		if elem, ok := s.Elem.(*schema.Schema); ok {
+			v.validateValue(path, itemLocation, name, name, item, elem)
+		}
+	}
+}
+
+func jsonType(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,258 @@
+package property
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Directives extend the templates of akamai_property_rules_template with conditionals and loops:
+//
+//	${if env.enabled} ... ${else if eq env.tier "gold"} ... ${else} ... ${end}
+//	${range $i, $origin := env.origins}${if $i},${end}{"name": "${$origin.name}"}${end}
+//
+// Expressions are text/template pipelines, in which env.NAME refers to a variable and $NAME to a loop variable.
+// Loop variables are referenced like other variables, e.g. "${$origin}" or "${$origin.hostname}".
+var (
+	directiveRegexp           = regexp.MustCompile(`\${(if|else if|else|range|end)(?:\s+([^}\n]*))?}`)
+	quotedLoopVariableRegexp  = regexp.MustCompile(`"\${(\$[A-Za-z_]\w*(?:\.\w+)*)}"`)
+	partialLoopVariableRegexp = regexp.MustCompile(`\${(\$[A-Za-z_]\w*(?:\.\w+)*)}`)
+	expressionVariableRegexp  = regexp.MustCompile(`\benv\.([\w.]*\w)(?:\s*\|\s*default\s+("(?:[^"\\]|\\.)*"|[^\s"]+))?`)
+
+	rootPlaceholderRegexp = regexp.MustCompile(regexp.QuoteMeta(leftDelim) + `\.`)
+	includeActionRegexp   = regexp.MustCompile(regexp.QuoteMeta(leftDelim) + `template "([^"]+)" ([.$])` + regexp.QuoteMeta(rightDelim))
+
+	templateFuncs = template.FuncMap{
+		"toJSON": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+)
+
+// translateDirectives converts the directives and references to loop variables in a template, which already had
+// its variables and includes converted by stringToTemplate, into template actions
+func translateDirectives(templateStr string, varsMap map[string]any) (string, error) {
+	var (
+		result     strings.Builder
+		blocks     []string
+		rangeDepth int
+		last       int
+	)
+	writeText := func(text string) {
+		text = quotedLoopVariableRegexp.ReplaceAllString(text, leftDelim+"toJSON $1"+rightDelim)
+		text = partialLoopVariableRegexp.ReplaceAllString(text, leftDelim+"$1"+rightDelim)
+		if rangeDepth > 0 {
+			// inside range, dot refers to the element, so variables and includes are evaluated against the root
+			text = rootPlaceholderRegexp.ReplaceAllString(text, leftDelim+"$$.")
+			text = includeActionRegexp.ReplaceAllString(text, leftDelim+`template "$1" $$`+rightDelim)
+		}
+		result.WriteString(text)
+	}
+
+	for _, match := range directiveRegexp.FindAllStringSubmatchIndex(templateStr, -1) {
+		writeText(templateStr[last:match[0]])
+		last = match[1]
+
+		keyword := templateStr[match[2]:match[3]]
+		var expression string
+		if match[4] >= 0 {
+			expression = strings.TrimSpace(templateStr[match[4]:match[5]])
+		}
+		switch keyword {
+		case "if", "range":
+			blocks = append(blocks, keyword)
+			if keyword == "range" {
+				rangeDepth++
+			}
+		case "end":
+			if len(blocks) > 0 {
+				if blocks[len(blocks)-1] == "range" {
+					rangeDepth--
+				}
+				blocks = blocks[:len(blocks)-1]
+			}
+		}
+		if (keyword == "else" || keyword == "end") && expression != "" {
+			return "", fmt.Errorf("%w: unexpected expression in ${%s %s}", ErrTemplateDirective, keyword, expression)
+		}
+		if keyword != "else" && keyword != "end" && expression == "" {
+			return "", fmt.Errorf("%w: missing expression in ${%s}", ErrTemplateDirective, keyword)
+		}
+
+		action := keyword
+		if expression != "" {
+			translated, err := translateExpression(expression, varsMap)
+			if err != nil {
+				return "", err
+			}
+			action += " " + translated
+		}
+		result.WriteString(leftDelim + action + rightDelim)
+	}
+	writeText(templateStr[last:])
+
+	return result.String(), nil
+}
+
+// translateExpression replaces references to variables in the directive expression, e.g. env.origin.hostname,
+// with fields of the template data, or with their default values, when the variables are not defined
+func translateExpression(expression string, varsMap map[string]any) (string, error) {
+	var err error
+	translated := expressionVariableRegexp.ReplaceAllStringFunc(expression, func(reference string) string {
+		submatch := expressionVariableRegexp.FindStringSubmatch(reference)
+		name, defaultValue := submatch[1], submatch[2]
+		if _, ok := lookupVariable(varsMap, name); ok || defaultValue == "" {
+			return "$." + name
+		}
+		var value any
+		if e := json.Unmarshal([]byte(defaultValue), &value); e != nil {
+			err = fmt.Errorf("%w: invalid default value of %q: %s", ErrTemplateDirective, name, defaultValue)
+			return reference
+		}
+		switch v := value.(type) {
+		case string:
+			return strconv.Quote(v)
+		case float64, bool:
+			return fmt.Sprint(v)
+		}
+		err = fmt.Errorf("%w: default value of %q must be a string, number or boolean: %s", ErrTemplateDirective, name, defaultValue)
+		return reference
+	})
+	return translated, err
+}
+
+// lookupVariable returns the formatted value of a variable or of a field of an object variable, e.g. origin.hostname
+func lookupVariable(varsMap map[string]any, name string) (any, bool) {
+	if value, ok := varsMap[name]; ok {
+		return value, true
+	}
+	head, fields, ok := strings.Cut(name, ".")
+	if !ok {
+		return nil, false
+	}
+	formatted, ok := varsMap[head]
+	if !ok {
+		return nil, false
+	}
+	value := typedValue(formatted)
+	for _, field := range strings.Split(fields, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[field]; !ok {
+			return nil, false
+		}
+	}
+	if value == nil {
+		return "null", true
+	}
+	formatted, err := formatValue(value)
+	if err != nil {
+		return nil, false
+	}
+	return formatted, true
+}
+
+// templateData returns the variables with their values decoded, so that directives can compare them,
+// iterate over lists and objects and access fields of objects
+func templateData(varsMap map[string]any) map[string]any {
+	data := make(map[string]any, len(varsMap))
+	for name, value := range varsMap {
+		data[name] = typedValue(value)
+	}
+	return data
+}
+
+// typedValue decodes the value of a variable, which is kept in varsMap in the form it is inserted into the template
+func typedValue(formatted any) any {
+	s, ok := formatted.(string)
+	if !ok {
+		return formatted
+	}
+	var value any
+	if err := json.Unmarshal([]byte(s), &value); err == nil {
+		return value
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(s, `"`), `"`)
+}
+
+// Positions in the executed template are traced back to the template files by markers placed before line breaks,
+// which hold the name of the template and the number of the next line. Templates included by a line are
+// surrounded with markers of their first line and of the including line.
+const (
+	markerDelim     = "\x1e"
+	markerLineDelim = "\x1f"
+)
+
+func sourceMarker(name string, line int) string {
+	return markerDelim + name + markerLineDelim + strconv.Itoa(line) + markerDelim
+}
+
+// markLines adds markers of template's source lines
+func markLines(templateStr, name string) string {
+	lines := strings.Split(templateStr, "\n")
+	for i, line := range lines {
+		lineMarker := sourceMarker(name, i+1)
+		line = includeActionRegexp.ReplaceAllStringFunc(line, func(action string) string {
+			included := includeActionRegexp.FindStringSubmatch(action)[1]
+			return sourceMarker(included, 1) + action + lineMarker
+		})
+		if i < len(lines)-1 {
+			line += sourceMarker(name, i+2)
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+type sourcePosition struct {
+	offset int
+	name   string
+	line   int
+}
+
+// sourcePositions maps offsets in the output of the template to the template lines they were produced by
+type sourcePositions []sourcePosition
+
+// stripMarkers removes the markers from the output of the template and returns positions of the template lines
+func stripMarkers(output []byte, main string) ([]byte, sourcePositions) {
+	positions := sourcePositions{{offset: 0, name: main, line: 1}}
+	var result bytes.Buffer
+	for {
+		start := bytes.Index(output, []byte(markerDelim))
+		if start < 0 {
+			result.Write(output)
+			return result.Bytes(), positions
+		}
+		end := bytes.Index(output[start+1:], []byte(markerDelim))
+		if end < 0 {
+			result.Write(output)
+			return result.Bytes(), positions
+		}
+		result.Write(output[:start])
+
+		marker := string(output[start+1 : start+1+end])
+		if name, line, ok := strings.Cut(marker, markerLineDelim); ok {
+			if lineNumber, err := strconv.Atoi(line); err == nil {
+				positions = append(positions, sourcePosition{offset: result.Len(), name: name, line: lineNumber})
+			}
+		}
+		output = output[start+end+2:]
+	}
+}
+
+// at returns the template and its line, which produced the output at the given offset
+func (p sourcePositions) at(offset int) (string, int) {
+	i := sort.Search(len(p), func(i int) bool { return p[i].offset > offset }) - 1
+	if i < 0 {
+		i = 0
+	}
+	return p[i].name, p[i].line
+}
@@ -0,0 +1,117 @@
+package property
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateDirectives(t *testing.T) {
+	tests := map[string]struct {
+		given     string
+		varsMap   map[string]interface{}
+		expected  string
+		withError error
+	}{
+		"conditional": {
+			given:    `[${if env.enabled}1${else if eq env.tier "gold"}2${else}3${end}]`,
+			varsMap:  map[string]interface{}{"enabled": true, "tier": `"gold"`},
+			expected: `[@+#if $.enabled#+@1@+#else if eq $.tier "gold"#+@2@+#else#+@3@+#end#+@]`,
+		},
+		"default value of undefined variable": {
+			given:    `${if env.enabled | default true}1${end}${if env.tier | default "gold"}2${end}`,
+			expected: `@+#if true#+@1@+#end#+@@+#if "gold"#+@2@+#end#+@`,
+		},
+		"default value of defined variable": {
+			given:    `${if env.enabled | default true}1${end}`,
+			varsMap:  map[string]interface{}{"enabled": false},
+			expected: `@+#if $.enabled#+@1@+#end#+@`,
+		},
+		"loop with variables and includes": {
+			given:    `[${range $i, $o := env.origins}"${$o}", "${$o.name}-${$i}", @+#.suffix#+@, @+#template "a.json" .#+@${end}] @+#.suffix#+@`,
+			expected: `[@+#range $i, $o := $.origins#+@@+#toJSON $o#+@, "@+#$o.name#+@-@+#$i#+@", @+#$.suffix#+@, @+#template "a.json" $#+@@+#end#+@] @+#.suffix#+@`,
+		},
+		"missing expression": {
+			given:     `${if}1${end}`,
+			withError: ErrTemplateDirective,
+		},
+		"unexpected expression": {
+			given:     `${if env.a}1${end env.a}`,
+			withError: ErrTemplateDirective,
+		},
+		"invalid default value": {
+			given:     `${if env.enabled | default yes}1${end}`,
+			withError: ErrTemplateDirective,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := translateDirectives(test.given, test.varsMap)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestLookupVariable(t *testing.T) {
+	varsMap := map[string]interface{}{
+		"name":   `"www"`,
+		"origin": `{"hostname":"origin.example.com","port":80,"tls":{"enabled":true},"cert":null}`,
+	}
+	tests := map[string]struct {
+		name     string
+		expected interface{}
+		found    bool
+	}{
+		"variable":            {name: "name", expected: `"www"`, found: true},
+		"string field":        {name: "origin.hostname", expected: `"origin.example.com"`, found: true},
+		"number field":        {name: "origin.port", expected: float64(80), found: true},
+		"nested field":        {name: "origin.tls.enabled", expected: true, found: true},
+		"null field":          {name: "origin.cert", expected: "null", found: true},
+		"missing field":       {name: "origin.path"},
+		"field of string":     {name: "name.length"},
+		"undefined variable":  {name: "other"},
+		"field of undefined":  {name: "other.hostname"},
+		"field of null field": {name: "origin.cert.name"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, found := lookupVariable(varsMap, test.name)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func TestStripMarkers(t *testing.T) {
+	templateStr := markLines("{\n  \"a\": [@+#template \"b.json\" .#+@],\n  \"c\": 1\n}\n", "main")
+	// simulates execution of the template including b.json
+	output := []byte(templateStr)
+	output = includeActionRegexp.ReplaceAll(output, []byte("{\n\"b\": 2"+sourceMarker("b.json", 2)+"\n}"))
+
+	result, positions := stripMarkers(output, "main")
+	assert.Equal(t, "{\n  \"a\": [{\n\"b\": 2\n}],\n  \"c\": 1\n}\n", string(result))
+
+	for offset, expected := range map[int]struct {
+		name string
+		line int
+	}{
+		0:  {"main", 1},
+		8:  {"main", 2},
+		10: {"b.json", 1},
+		12: {"b.json", 1},
+		19: {"b.json", 2},
+		20: {"main", 2},
+		25: {"main", 3},
+	} {
+		name, line := positions.at(offset)
+		assert.Equal(t, expected.name, name, "offset %d", offset)
+		assert.Equal(t, expected.line, line, "offset %d", offset)
+	}
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_edge_hostnames" "test" {
+  contract_id = "1"
+  group_id    = "grp_2"
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_edge_hostnames" "test" {
+  contract_id   = "ctr_1"
+  group_id      = "grp_2"
+  include_usage = false
+}
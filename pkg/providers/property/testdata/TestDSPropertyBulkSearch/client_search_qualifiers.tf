@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_bulk_search" "test" {
+  contract_id = "1"
+  group_ids   = ["grp_2"]
+  search_mode = "CLIENT"
+  match       = "$..behaviors[?(@.name == 'origin')].options"
+  qualifiers  = ["$..behaviors[?(@.name == 'caching' && @.options.ttl == '1d')]"]
+  version     = "PRODUCTION"
+}
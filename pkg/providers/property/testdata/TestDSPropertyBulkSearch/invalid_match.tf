@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_bulk_search" "test" {
+  contract_id = "ctr_1"
+  search_mode = "CLIENT"
+  match       = "$..behaviors[?(@.name == 'origin']"
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_bulk_search" "test" {
+  contract_id = "ctr_1"
+  match       = "$..behaviors[?(@.name == 'origin')].options.verificationMode"
+}
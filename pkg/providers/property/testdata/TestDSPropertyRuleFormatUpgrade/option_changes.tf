@@ -0,0 +1,31 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rule_format_upgrade" "test" {
+  source_rule_format = "v2024-10-21"
+  target_rule_format = "v2025-10-16"
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      behaviors = [
+        {
+          name    = "caching"
+          options = { behavior = "MAX_AGE", mustRevalidate = false, ttl = "1d" }
+        },
+        {
+          name    = "adaptiveImageCompression"
+          options = { compressMobile = true }
+        },
+        {
+          name = "mediaOriginFailover"
+          options = {
+            detectOriginUnavailable            = true
+            originUnavailableBlacklistOriginIp = true
+            originUnavailableBlacklistWindow   = "TEN_MINUTES"
+          }
+        }
+      ]
+    }
+  })
+}
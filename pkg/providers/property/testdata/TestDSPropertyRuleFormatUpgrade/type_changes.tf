@@ -0,0 +1,36 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rule_format_upgrade" "test" {
+  source_rule_format = "v2023-01-05"
+  target_rule_format = "v2023-09-20"
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      behaviors = [
+        {
+          name    = "datastream"
+          options = { streamType = "LOG", logEnabled = true, logStreamName = 123, samplingPercentage = 100 }
+        }
+      ]
+      children = [
+        {
+          name = "Variables"
+          behaviors = [
+            {
+              name = "setVariable"
+              options = {
+                variableName = "PMUSER_A"
+                valueSource  = "EXPRESSION"
+                transform    = "SUBSTRING"
+                startIndex   = "1"
+                endIndex     = "{{user.PMUSER_END}}"
+              }
+            }
+          ]
+        }
+      ]
+    }
+  })
+}
@@ -0,0 +1,13 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rule_format_upgrade" "test" {
+  source_rule_format = "v2015-08-08"
+  target_rule_format = "v2025-10-16"
+  rules = jsonencode({
+    rules = {
+      name = "default"
+    }
+  })
+}
@@ -0,0 +1,20 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_builder" "default" {
+  rules_v2023_01_05 {
+    name      = "default"
+    is_secure = false
+    behavior {
+      caching {
+        behavior = "NO_STORE"
+      }
+    }
+  }
+
+  fragment {
+    name    = "base"
+    version = "1.0.0"
+  }
+}
@@ -0,0 +1,22 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_builder" "static_content" {
+  rules_v2023_01_05 {
+    name = "Static Content"
+    behavior {
+      cache_tag {
+        tag = "{{fragment.prefix}}-{{fragment.suffix}}"
+      }
+    }
+  }
+
+  fragment {
+    name    = "static-content"
+    version = "1.0.0"
+    parameter {
+      name = "prefix"
+    }
+  }
+}
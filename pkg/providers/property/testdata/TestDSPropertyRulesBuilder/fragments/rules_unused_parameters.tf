@@ -0,0 +1,62 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_builder" "default" {
+  rules_v2023_01_05 {
+    name      = "default"
+    is_secure = false
+    behavior {
+      caching {
+        behavior = "NO_STORE"
+      }
+    }
+    children = [
+      data.akamai_property_rules_builder.static_content.json,
+    ]
+  }
+
+  fragment_parameters {
+    name = "static-content"
+    values = {
+      prefix = "shop"
+    }
+  }
+  fragment_parameters {
+    name = "images"
+    values = {
+      quality = "80"
+    }
+  }
+}
+
+data "akamai_property_rules_builder" "static_content" {
+  rules_v2023_01_05 {
+    name                  = "Static Content"
+    criteria_must_satisfy = "all"
+    behavior {
+      cache_tag {
+        tag = "{{fragment.prefix}}-{{builtin.AK_FILENAME}}"
+      }
+    }
+    behavior {
+      caching {
+        behavior        = "MAX_AGE"
+        must_revalidate = false
+        ttl             = "{{fragment.ttl}}"
+      }
+    }
+  }
+
+  fragment {
+    name    = "static-content"
+    version = "1.2.0"
+    parameter {
+      name = "prefix"
+    }
+    parameter {
+      name    = "ttl"
+      default = "7d"
+    }
+  }
+}
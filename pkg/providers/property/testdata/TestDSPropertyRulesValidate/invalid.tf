@@ -0,0 +1,37 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_validate" "test" {
+  rule_format = "v2025-10-16"
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      behaviors = [
+        {
+          name    = "caching"
+          options = { behavior = "MAX_AGE", mustRevalidate = "no", ttl = "12d", unknownOption = true }
+        }
+      ]
+      children = [
+        {
+          name                = "Compression"
+          criteriaMustSatisfy = "some"
+          variables = [
+            { name = "PMUSER_A", value = "a", description = "", hidden = false, sensitive = false }
+          ]
+          behaviors = [
+            {
+              name    = "gzipResponse"
+              options = { behavior = "SOMETIMES" }
+            },
+            {
+              name    = "notABehavior"
+              options = {}
+            }
+          ]
+        }
+      ]
+    }
+  })
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_validate" "test" {
+  rule_format = "v2025-10-16"
+  rules       = "{"
+}
@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_validate" "test" {
+  rule_format = "v2015-08-08"
+  rules = jsonencode({
+    rules = {
+      name = "default"
+    }
+  })
+}
@@ -0,0 +1,35 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_validate" "test" {
+  rule_format = "v2025-10-16"
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      behaviors = [
+        {
+          name    = "caching"
+          options = { behavior = "MAX_AGE", mustRevalidate = false, ttl = "12d" }
+        }
+      ]
+      children = [
+        {
+          name = "Compression"
+          criteria = [
+            {
+              name    = "contentType"
+              options = { matchOperator = "IS_ONE_OF", matchWildcard = true, matchCaseSensitive = false, values = ["text/*"] }
+            }
+          ]
+          behaviors = [
+            {
+              name    = "gzipResponse"
+              options = { behavior = "ALWAYS" }
+            }
+          ]
+        }
+      ]
+    }
+  })
+}
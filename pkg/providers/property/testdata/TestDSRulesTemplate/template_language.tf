@@ -0,0 +1,25 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_template" "test" {
+  template_file = "testdata/TestDSRulesTemplate/template-language/main.json"
+  variables {
+    name  = "origin"
+    value = jsonencode({ hostname = "origin.example.com", port = 80 })
+    type  = "object"
+  }
+  variables {
+    name  = "caching"
+    value = jsonencode({ enabled = true })
+    type  = "object"
+  }
+  variables {
+    name = "paths"
+    value = jsonencode([
+      { name = "images", values = ["/images/*"], tier = "gold" },
+      { name = "api", values = ["/api/*"], tier = "standard" },
+    ])
+    type = "list"
+  }
+}
@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+data "akamai_property_rules_template" "test" {
+  template_file = "testdata/TestDSRulesTemplate/template-language-invalid/main.json"
+  variables {
+    name  = "paths"
+    value = jsonencode(["images"])
+    type  = "list"
+  }
+}
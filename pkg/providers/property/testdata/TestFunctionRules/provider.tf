@@ -0,0 +1,4 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
@@ -0,0 +1,31 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+locals {
+  caching = provider::akamai::rules_find_behavior(file("testdata/TestFunctionRules/rules.json"), "caching")
+}
+
+output "count" {
+  value = length(local.caching)
+}
+
+output "first_path" {
+  value = local.caching[0].path
+}
+
+output "first_ttl" {
+  value = jsondecode(local.caching[0].options).ttl
+}
+
+output "second_path" {
+  value = local.caching[1].path
+}
+
+output "second_ttl" {
+  value = jsondecode(local.caching[1].options).ttl
+}
+
+output "missing_count" {
+  value = length(provider::akamai::rules_find_behavior(file("testdata/TestFunctionRules/rules.json"), "missing"))
+}
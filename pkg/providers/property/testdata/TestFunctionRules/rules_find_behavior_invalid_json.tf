@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "behaviors" {
+  value = provider::akamai::rules_find_behavior("not a json", "caching")
+}
@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "merged" {
+  value = provider::akamai::rules_merge(
+    file("testdata/TestFunctionRules/rules.json"),
+    file("testdata/TestFunctionRules/overlay.json")
+  )
+}
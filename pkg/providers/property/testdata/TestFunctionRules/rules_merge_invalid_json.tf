@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "merged" {
+  value = provider::akamai::rules_merge("{", file("testdata/TestFunctionRules/overlay.json"))
+}
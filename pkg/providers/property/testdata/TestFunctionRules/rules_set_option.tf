@@ -0,0 +1,27 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+locals {
+  rules = provider::akamai::rules_set_option(
+    file("testdata/TestFunctionRules/rules.json"),
+    "default/Performance/Compression",
+    "gzipResponse",
+    "behavior",
+    "ORIGIN_RESPONSE"
+  )
+  with_port = provider::akamai::rules_set_option(local.rules, "default", "origin", "httpPort", 8080)
+  with_list = provider::akamai::rules_set_option(local.with_port, "default", "origin", "customValidCnValues", ["a", "b"])
+}
+
+output "gzip" {
+  value = jsondecode(provider::akamai::rules_find_behavior(local.with_list, "gzipResponse")[0].options).behavior
+}
+
+output "port" {
+  value = jsondecode(provider::akamai::rules_find_behavior(local.with_list, "origin")[0].options).httpPort
+}
+
+output "list" {
+  value = join(",", jsondecode(provider::akamai::rules_find_behavior(local.with_list, "origin")[0].options).customValidCnValues)
+}
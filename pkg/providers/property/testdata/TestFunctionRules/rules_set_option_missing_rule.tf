@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "rules" {
+  value = provider::akamai::rules_set_option(file("testdata/TestFunctionRules/rules.json"), "default/Missing", "origin", "httpPort", 8080)
+}
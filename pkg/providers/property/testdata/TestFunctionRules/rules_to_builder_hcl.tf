@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "hcl" {
+  value = provider::akamai::rules_to_builder_hcl(
+    file("testdata/TestDSPropertyRulesBuilder/ruleformat/v2025_10_16/dynamic_content.json"),
+    "v2025-10-16"
+  )
+}
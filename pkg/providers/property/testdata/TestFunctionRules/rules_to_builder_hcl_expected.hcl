@@ -0,0 +1,21 @@
+data "akamai_property_rules_builder" "dynamic_content" {
+  rules_v2025_10_16 {
+    name                  = "Dynamic Content"
+    criteria_must_satisfy = "all"
+    criterion {
+      cacheability {
+        match_operator = "IS_NOT"
+        value          = "CACHEABLE"
+      }
+    }
+    behavior {
+      downstream_cache {
+        behavior = "TUNNEL_ORIGIN"
+      }
+    }
+    behavior {
+      restrict_object_caching {
+      }
+    }
+  }
+}
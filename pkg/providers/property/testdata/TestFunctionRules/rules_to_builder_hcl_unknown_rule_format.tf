@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "hcl" {
+  value = provider::akamai::rules_to_builder_hcl(file("testdata/TestFunctionRules/rules.json"), "v2015-08-08")
+}
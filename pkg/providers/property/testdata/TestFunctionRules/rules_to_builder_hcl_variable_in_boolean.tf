@@ -0,0 +1,17 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+output "hcl" {
+  value = provider::akamai::rules_to_builder_hcl(jsonencode({
+    rules = {
+      name = "default"
+      behaviors = [
+        {
+          name    = "prefetch"
+          options = { enabled = "{{user.PMUSER_PREFETCH}}" }
+        }
+      ]
+    }
+  }), "v2025-10-16")
+}
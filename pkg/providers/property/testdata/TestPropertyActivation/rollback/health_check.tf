@@ -0,0 +1,24 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id                    = "test"
+  contact                        = ["user@example.com"]
+  version                        = 2
+  auto_acknowledge_rule_warnings = true
+  note                           = "property activation note"
+
+  rollback {
+    on_failure = false
+    health_check {
+      hostnames             = ["www.example.com"]
+      path                  = "/health"
+      scheme                = "http"
+      connect_to            = "%s"
+      expected_status_codes = [200]
+      attempts              = 2
+      interval              = "1ms"
+    }
+  }
+}
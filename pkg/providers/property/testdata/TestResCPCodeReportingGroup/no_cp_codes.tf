@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_cp_code_reporting_group" "test" {
+  name        = "web"
+  contract_id = "ctr_1-ABC"
+  group_id    = "grp_2"
+
+  contracts = [
+    {
+      contract_id = "ctr_1-ABC"
+      cp_code_ids = []
+    }
+  ]
+}
@@ -0,0 +1,23 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property" "test" {
+  name        = "test_property"
+  group_id    = "grp_2"
+  contract_id = "ctr_1"
+  product_id  = "prd_3"
+  rule_format = "v2025-10-16"
+
+  rules = jsonencode({
+    rules = {
+      name = "default"
+      behaviors = [
+        {
+          name    = "gzipResponse"
+          options = { behavior = "SOMETIMES" }
+        }
+      ]
+    }
+  })
+}
@@ -0,0 +1,50 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property" "test" {
+  name        = "test_property"
+  contract_id = "ctr_123"
+  group_id    = "grp_123"
+  product_id  = "prd_123"
+
+  rule_format = "v2023-01-05"
+  rules       = data.akamai_property_rules_builder.default.json
+}
+
+data "akamai_property_rules_builder" "default" {
+  rules_v2023_01_05 {
+    name      = "default"
+    is_secure = false
+    behavior {
+      caching {
+        behavior = "NO_STORE"
+      }
+    }
+    children = [
+      data.akamai_property_rules_builder.static_content.json,
+    ]
+  }
+}
+
+data "akamai_property_rules_builder" "static_content" {
+  rules_v2023_01_05 {
+    name = "Static Content"
+    behavior {
+      caching {
+        behavior        = "MAX_AGE"
+        must_revalidate = false
+        ttl             = "{{fragment.ttl}}"
+      }
+    }
+  }
+
+  fragment {
+    name    = "static-content"
+    version = "1.0.0"
+    parameter {
+      name    = "ttl"
+      default = "7d"
+    }
+  }
+}
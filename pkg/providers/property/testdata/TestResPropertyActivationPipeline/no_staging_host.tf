@@ -0,0 +1,29 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_activation_pipeline" "test" {
+  property_id                    = "test"
+  version                        = 1
+  contact                        = ["user@example.com"]
+  note                           = "pipeline note"
+  auto_acknowledge_rule_warnings = true
+
+  compliance_record {
+    noncompliance_reason_none {
+      customer_email   = "user@example.com"
+      peer_reviewed_by = "user1@example.com"
+      unit_tested      = true
+    }
+  }
+
+  verification_attempts = 2
+  verification_interval = "1ms"
+  verification {
+    url                   = "http://www.example.com:%s/health"
+    expected_status_codes = [200]
+    expected_headers = {
+      "X-Property-Version" = "1"
+    }
+  }
+}
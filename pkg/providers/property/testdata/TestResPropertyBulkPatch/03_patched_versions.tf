@@ -0,0 +1,37 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_bulk_patch" "test" {
+  contract_id   = "ctr_1"
+  version_notes = "Use custom origin certificate verification"
+
+  operation {
+    op    = "replace"
+    value = jsonencode("CUSTOM")
+  }
+
+  property {
+    property_id      = "prp_1"
+    group_id         = "grp_1"
+    property_version = 4
+    match_locations = [
+      "/rules/behaviors/0/options/verificationMode",
+      "/rules/children/0/behaviors/0/options/verificationMode",
+    ]
+  }
+
+  property {
+    property_id      = "prp_2"
+    group_id         = "grp_1"
+    property_version = 1
+    match_locations  = ["/rules/behaviors/0/options/verificationMode"]
+  }
+
+  property {
+    property_id      = "prp_3"
+    group_id         = "grp_2"
+    property_version = 3
+    match_locations  = ["/rules/behaviors/0/options/verificationMode"]
+  }
+}
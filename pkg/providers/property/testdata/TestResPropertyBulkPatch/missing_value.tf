@@ -0,0 +1,19 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_bulk_patch" "test" {
+  contract_id = "ctr_1"
+
+  operation {
+    op   = "replace"
+    path = "/verificationMode"
+  }
+
+  property {
+    property_id      = "prp_1"
+    group_id         = "grp_1"
+    property_version = 3
+    match_locations  = ["/rules/behaviors/0/options"]
+  }
+}
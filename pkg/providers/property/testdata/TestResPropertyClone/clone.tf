@@ -0,0 +1,18 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_clone" "test" {
+  name               = "new-site"
+  group_id           = "grp_2"
+  contract_id        = "ctr_1"
+  source_property_id = "prp_1"
+  copy_hostnames     = true
+
+  hostname_substitutions = {
+    "www.example.com" = "www.new-site.com"
+  }
+  origin_substitutions = {
+    "origin.example.com" = "origin.new-site.com"
+  }
+}
@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_clone" "test" {
+  name               = "new-site"
+  group_id           = "2"
+  contract_id        = "1"
+  product_id         = "prd_Fresca"
+  source_property_id = "1"
+  source_version     = 2
+}
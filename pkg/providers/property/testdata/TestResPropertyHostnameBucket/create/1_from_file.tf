@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "../../common/testutils/edgerc"
+}
+
+resource "akamai_property_hostname_bucket" "test" {
+  property_id    = "prp_111"
+  contract_id    = "ctr_222"
+  group_id       = "grp_333"
+  network        = "STAGING"
+  hostnames_file = "testdata/TestResPropertyHostnameBucket/create/hostnames.csv"
+}
@@ -16,6 +16,7 @@ import (
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/datastream"
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/dns"
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/edgeworkers"
+	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/fastpurge"
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/gtm"
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/iam"
 	_ "github.com/akamai/terraform-provider-akamai/v9/pkg/providers/imaging"
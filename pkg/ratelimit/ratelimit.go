@@ -0,0 +1,150 @@
+// Package ratelimit contains client-side rate limiting of API requests
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// HeaderLimit is the response header with the number of requests allowed in the rate limit window
+	HeaderLimit = "Akamai-RateLimit-Limit"
+	// HeaderRemaining is the response header with the number of requests left in the rate limit window
+	HeaderRemaining = "Akamai-RateLimit-Remaining"
+	// HeaderNext is the response header with the time at which the next request is allowed
+	HeaderNext = "Akamai-RateLimit-Next"
+)
+
+// Limit configures the rate of requests to an API
+type Limit struct {
+	// RequestsPerSecond is the rate at which requests are allowed. Zero means that requests
+	// are only paused when the server reports that no more requests are allowed
+	RequestsPerSecond int
+	// Burst is the number of requests which can be sent at once. It defaults to RequestsPerSecond
+	Burst int
+}
+
+// Limiter is a token bucket limiting the rate of requests, which also pauses requests
+// when the server reports that no more requests are allowed in the current rate limit window
+type Limiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// NewLimiter returns a limiter allowing requests at the given rate
+func NewLimiter(limit Limit) *Limiter {
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = limit.RequestsPerSecond
+	}
+	return &Limiter{
+		rate:   float64(limit.RequestsPerSecond),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until the next request is allowed or the context is done
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Observe adjusts the limiter to the rate limit state reported in the response headers.
+// When no requests remain, requests are paused until the time given in the Akamai-RateLimit-Next header.
+// With a configured rate, the Akamai-RateLimit-Limit header also caps the size of the bucket and the remaining
+// number of requests caps the tokens available. The headers do not state the rate limit window, so without
+// a configured rate no refill rate is derived from them.
+func (l *Limiter) Observe(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get(HeaderRemaining))
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.refill(time.Now())
+		if limit, err := strconv.Atoi(header.Get(HeaderLimit)); err == nil && limit > 0 {
+			l.burst = math.Min(l.burst, float64(limit))
+			l.tokens = math.Min(l.tokens, l.burst)
+		}
+		if float64(remaining) < l.tokens {
+			l.tokens = math.Max(float64(remaining), 0)
+		}
+	}
+	if remaining > 0 {
+		return
+	}
+	if wait, ok := nextRequestDelay(header); ok {
+		if until := time.Now().Add(wait); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+}
+
+// reserve takes a token if one is available, or returns the time after which it may be available
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate == 0 {
+		return 0
+	}
+
+	l.refill(now)
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// refill adds the tokens accumulated since the last refill
+func (l *Limiter) refill(now time.Time) {
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+}
+
+// nextRequestDelay returns the time until the next request is allowed. It is calculated relative
+// to the Date header, if present, so that it is not affected by the difference between clocks.
+func nextRequestDelay(header http.Header) (time.Duration, bool) {
+	next, err := time.Parse(time.RFC3339Nano, header.Get(HeaderNext))
+	if err != nil {
+		return 0, false
+	}
+	now := time.Now()
+	if date, err := time.Parse(time.RFC1123, header.Get("Date")); err == nil {
+		now = date
+	}
+	if next.Before(now) {
+		return 0, false
+	}
+	return next.Sub(now), true
+}
@@ -0,0 +1,110 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	header := func(values map[string]string) http.Header {
+		h := http.Header{}
+		for k, v := range values {
+			h.Set(k, v)
+		}
+		return h
+	}
+
+	t.Run("requests within burst are not delayed", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 10, Burst: 3})
+		for range 3 {
+			assert.Zero(t, l.reserve())
+		}
+		assert.InDelta(t, 100*time.Millisecond, l.reserve(), float64(10*time.Millisecond))
+	})
+
+	t.Run("burst defaults to rate", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 2})
+		assert.Zero(t, l.reserve())
+		assert.Zero(t, l.reserve())
+		assert.Positive(t, l.reserve())
+	})
+
+	t.Run("no limit", func(t *testing.T) {
+		l := NewLimiter(Limit{})
+		for range 100 {
+			assert.Zero(t, l.reserve())
+		}
+	})
+
+	t.Run("wait takes tokens at the given rate", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 100, Burst: 1})
+		start := time.Now()
+		for range 5 {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+	})
+
+	t.Run("wait is interrupted by context", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 1, Burst: 1})
+		require.NoError(t, l.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("remaining requests cap tokens", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 10, Burst: 10})
+		l.Observe(header(map[string]string{HeaderLimit: "100", HeaderRemaining: "1"}))
+		assert.Zero(t, l.reserve())
+		assert.Positive(t, l.reserve())
+	})
+
+	t.Run("limit caps burst", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 10, Burst: 10})
+		l.Observe(header(map[string]string{HeaderLimit: "2", HeaderRemaining: "2"}))
+		assert.Zero(t, l.reserve())
+		assert.Zero(t, l.reserve())
+		assert.Positive(t, l.reserve())
+	})
+
+	t.Run("remaining requests do not delay requests without a configured rate", func(t *testing.T) {
+		l := NewLimiter(Limit{})
+		l.Observe(header(map[string]string{HeaderLimit: "100", HeaderRemaining: "1"}))
+		for range 100 {
+			assert.Zero(t, l.reserve())
+		}
+	})
+
+	t.Run("requests are paused until next is allowed", func(t *testing.T) {
+		l := NewLimiter(Limit{})
+		now := time.Now().UTC()
+		l.Observe(header(map[string]string{
+			HeaderRemaining: "0",
+			HeaderNext:      now.Add(2 * time.Second).Format(time.RFC3339Nano),
+			"Date":          now.Format(time.RFC1123),
+		}))
+		assert.InDelta(t, 2*time.Second, l.reserve(), float64(time.Second))
+	})
+
+	t.Run("headers without remaining requests are ignored", func(t *testing.T) {
+		l := NewLimiter(Limit{RequestsPerSecond: 10, Burst: 10})
+		l.Observe(header(map[string]string{HeaderNext: time.Now().Add(time.Hour).Format(time.RFC3339Nano)}))
+		assert.Zero(t, l.reserve())
+	})
+
+	t.Run("next in the past is ignored", func(t *testing.T) {
+		l := NewLimiter(Limit{})
+		l.Observe(header(map[string]string{
+			HeaderRemaining: "0",
+			HeaderNext:      time.Now().Add(-time.Hour).Format(time.RFC3339Nano),
+		}))
+		assert.Zero(t, l.reserve())
+	})
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIs limited separately, with the prefixes of their request paths
+var apiPaths = map[string]string{
+	"appsec": "/appsec/",
+	"dns":    "/config-dns/",
+	"gtm":    "/config-gtm/",
+	"iam":    "/identity-management/",
+	"papi":   "/papi/",
+}
+
+// APIs returns the names of APIs whose requests can be limited
+func APIs() []string {
+	apis := make([]string, 0, len(apiPaths))
+	for api := range apiPaths {
+		apis = append(apis, api)
+	}
+	sort.Strings(apis)
+	return apis
+}
+
+// Transport limits the rate of requests separately for every API
+type Transport struct {
+	// Base is the transport sending the requests
+	Base     http.RoundTripper
+	limiters map[string]*Limiter
+}
+
+// NewTransport returns a transport sending requests through base at the rates given for APIs.
+// Requests to APIs without a limit are only paused when the server reports that no more requests are allowed.
+func NewTransport(base http.RoundTripper, limits map[string]Limit) (*Transport, error) {
+	limiters := make(map[string]*Limiter, len(apiPaths))
+	for api := range apiPaths {
+		limiters[api] = NewLimiter(Limit{})
+	}
+	for api, limit := range limits {
+		if _, ok := apiPaths[api]; !ok {
+			return nil, fmt.Errorf("rate limit configured for unsupported API %q, supported APIs are: %s", api, strings.Join(APIs(), ", "))
+		}
+		if limit.RequestsPerSecond < 0 || limit.Burst < 0 {
+			return nil, fmt.Errorf("rate limit of API %q cannot be negative", api)
+		}
+		limiters[api] = NewLimiter(limit)
+	}
+	return &Transport{Base: base, limiters: limiters}, nil
+}
+
+// RoundTrip waits until the request is allowed and sends it
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiter := t.limiter(req.URL.Path)
+	if limiter == nil {
+		return t.Base.RoundTrip(req)
+	}
+
+	if err := limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := t.Base.RoundTrip(req)
+	if resp != nil {
+		limiter.Observe(resp.Header)
+	}
+	return resp, err
+}
+
+func (t *Transport) limiter(path string) *Limiter {
+	for api, prefix := range apiPaths {
+		if strings.HasPrefix(path, prefix) {
+			return t.limiters[api]
+		}
+	}
+	return nil
+}
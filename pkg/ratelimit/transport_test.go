@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport(t *testing.T) {
+	var next time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/papi/v1/exhausted" {
+			next = time.Now().Add(200 * time.Millisecond)
+			w.Header().Set(HeaderRemaining, "0")
+			w.Header().Set(HeaderNext, next.UTC().Format(time.RFC3339Nano))
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport, err := NewTransport(http.DefaultTransport, map[string]Limit{
+		"appsec": {RequestsPerSecond: 20, Burst: 1},
+	})
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	get := func(path string) time.Duration {
+		start := time.Now()
+		resp, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return time.Since(start)
+	}
+
+	t.Run("requests are limited per API", func(t *testing.T) {
+		get("/appsec/v1/configs")
+		assert.GreaterOrEqual(t, get("/appsec/v1/configs"), 40*time.Millisecond)
+		assert.Less(t, get("/config-dns/v2/zones"), 40*time.Millisecond)
+		assert.Less(t, get("/other/v1"), 40*time.Millisecond)
+	})
+
+	t.Run("requests are paused when no more are allowed", func(t *testing.T) {
+		get("/papi/v1/exhausted")
+		get("/papi/v1/properties")
+		assert.False(t, time.Now().Before(next))
+	})
+}
+
+func TestNewTransport(t *testing.T) {
+	_, err := NewTransport(http.DefaultTransport, map[string]Limit{"ccu": {RequestsPerSecond: 1}})
+	assert.EqualError(t, err, `rate limit configured for unsupported API "ccu", supported APIs are: appsec, dns, gtm, iam, papi`)
+
+	_, err = NewTransport(http.DefaultTransport, map[string]Limit{"papi": {RequestsPerSecond: -1}})
+	assert.EqualError(t, err, `rate limit of API "papi" cannot be negative`)
+}
@@ -0,0 +1,85 @@
+// Package recorder contains HTTP transport recording API interactions to cassette files and replaying them,
+// which allows running the provider without access to Akamai APIs
+package recorder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+type (
+	// Cassette contains recorded API interactions
+	Cassette struct {
+		Interactions []Interaction `json:"interactions"`
+	}
+
+	// Interaction is a request sent to an API and the response to it
+	Interaction struct {
+		Request  Request  `json:"request"`
+		Response Response `json:"response"`
+	}
+
+	// Request is a recorded API request. It does not contain the host, so that it can be replayed
+	// with credentials for any account
+	Request struct {
+		Method  string              `json:"method"`
+		URL     string              `json:"url"`
+		Headers map[string][]string `json:"headers,omitempty"`
+		Body    string              `json:"body,omitempty"`
+	}
+
+	// Response is a recorded API response
+	Response struct {
+		StatusCode int                 `json:"status_code"`
+		Headers    map[string][]string `json:"headers,omitempty"`
+		Body       string              `json:"body,omitempty"`
+	}
+)
+
+// LoadCassette reads the cassette from the file. A missing file results in an empty cassette
+func LoadCassette(path string) (*Cassette, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &Cassette{}, nil
+		}
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(content, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to the file, replacing its previous content
+func (c *Cassette) Save(path string) error {
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	// write to a temporary file first, so that the cassette is not left partially written
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".cassette-*")
+	if err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
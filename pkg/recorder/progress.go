@@ -0,0 +1,77 @@
+package recorder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// progressSuffix is appended to the cassette file name to get the file with the replay progress
+const progressSuffix = ".progress"
+
+// progress keeps track of interactions already replayed. It is saved next to the cassette,
+// as every Terraform command, e.g. plan and apply, runs in a separate provider process.
+type progress struct {
+	path     string
+	replayed []bool
+}
+
+// ProgressFile returns the file in which the progress of replaying the cassette is kept.
+// Removing it makes the cassette replay from the beginning.
+func ProgressFile(cassettePath string) string {
+	return cassettePath + progressSuffix
+}
+
+func loadProgress(cassettePath string, interactions int) (*progress, error) {
+	p := &progress{path: ProgressFile(cassettePath), replayed: make([]bool, interactions)}
+
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return p, nil
+		}
+		return nil, fmt.Errorf("failed to read replay progress: %w", err)
+	}
+	var replayed []int
+	if err := json.Unmarshal(content, &replayed); err != nil {
+		return nil, fmt.Errorf("failed to parse replay progress %s: %w", p.path, err)
+	}
+	for _, i := range replayed {
+		if i < 0 || i >= interactions {
+			return nil, fmt.Errorf("replay progress %s does not match the cassette, remove it to replay the cassette from the beginning", p.path)
+		}
+		p.replayed[i] = true
+	}
+	return p, nil
+}
+
+func (p *progress) markReplayed(i int) error {
+	if p.replayed[i] {
+		return nil
+	}
+	p.replayed[i] = true
+
+	replayed := make([]int, 0, len(p.replayed))
+	for i, ok := range p.replayed {
+		if ok {
+			replayed = append(replayed, i)
+		}
+	}
+	content, err := json.Marshal(replayed)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.path, content, 0o644); err != nil {
+		return fmt.Errorf("failed to save replay progress: %w", err)
+	}
+	return nil
+}
+
+func removeProgress(cassettePath string) error {
+	if err := os.Remove(ProgressFile(cassettePath)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove replay progress: %w", err)
+	}
+	return nil
+}
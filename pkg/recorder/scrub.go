@@ -0,0 +1,105 @@
+package recorder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces secrets in recorded interactions
+const Redacted = "REDACTED"
+
+// scrubbedHeaders are removed from recorded requests and responses, as they carry credentials
+var scrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// secretKeys are parts of names of JSON fields whose values are redacted in recorded bodies
+var secretKeys = []string{"secret", "password", "token", "privatekey", "private_key", "credential"}
+
+// scrubbedQueryParams are redacted in recorded request URLs, as they identify the account the credentials act for
+var scrubbedQueryParams = []string{"accountSwitchKey"}
+
+// scrubURI redacts values of the query parameters identifying the account and of secret parameters in the request URI.
+// Parameters are sorted, so that the scrubbed URI of a request is always the same.
+func scrubURI(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.RequestURI()
+	}
+	query := u.Query()
+	for name, values := range query {
+		if !isScrubbedQueryParam(name) && !isSecretKey(name) {
+			continue
+		}
+		for i := range values {
+			values[i] = Redacted
+		}
+	}
+	scrubbed := *u
+	scrubbed.RawQuery = query.Encode()
+	return scrubbed.RequestURI()
+}
+
+func isScrubbedQueryParam(name string) bool {
+	for _, param := range scrubbedQueryParams {
+		if strings.EqualFold(name, param) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubHeaders returns a copy of the headers without the ones carrying credentials
+func scrubHeaders(header http.Header) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+	result := make(map[string][]string, len(header))
+	for name, values := range header {
+		result[name] = values
+	}
+	for _, name := range scrubbedHeaders {
+		delete(result, http.CanonicalHeaderKey(name))
+	}
+	return result
+}
+
+// scrubBody redacts values of secret fields in the JSON body. Bodies of other formats are returned unchanged
+func scrubBody(body []byte) string {
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(scrubValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+func scrubValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if _, isString := item.(string); isString && isSecretKey(key) {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = scrubValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = scrubValue(item)
+		}
+	}
+	return value
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package recorder
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubBody(t *testing.T) {
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"secrets are redacted at any depth": {
+			body:     `{"credentials":[{"clientSecret":"abc","id":1}],"nested":{"password":"p","privateKey":"k","name":"n"}}`,
+			expected: `{"credentials":[{"clientSecret":"REDACTED","id":1}],"nested":{"name":"n","password":"REDACTED","privateKey":"REDACTED"}}`,
+		},
+		"non-string values are kept": {
+			body:     `{"tokenCount":3}`,
+			expected: `{"tokenCount":3}`,
+		},
+		"non-JSON body is kept": {
+			body:     "client_secret=abc",
+			expected: "client_secret=abc",
+		},
+		"empty body": {},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, scrubBody([]byte(test.body)))
+		})
+	}
+}
+
+func TestScrubHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "EG1-HMAC-SHA256 client_token=a;access_token=b;signature=c")
+	header.Set("Set-Cookie", "session=abc")
+	header.Set("Content-Type", "application/json")
+
+	assert.Equal(t, map[string][]string{"Content-Type": {"application/json"}}, scrubHeaders(header))
+	assert.Equal(t, "EG1-HMAC-SHA256 client_token=a;access_token=b;signature=c", header.Get("Authorization"), "original headers are not modified")
+	assert.Nil(t, scrubHeaders(nil))
+}
+
+func TestScrubURI(t *testing.T) {
+	tests := map[string]struct {
+		url      string
+		expected string
+	}{
+		"account switch key is redacted": {
+			url:      "https://host/papi/v1/properties?groupId=grp_1&accountSwitchKey=1-ABC&contractId=ctr_1",
+			expected: "/papi/v1/properties?accountSwitchKey=REDACTED&contractId=ctr_1&groupId=grp_1",
+		},
+		"secret parameters are redacted": {
+			url:      "https://host/api/v1/sth?token=abc&name=n",
+			expected: "/api/v1/sth?name=n&token=REDACTED",
+		},
+		"URI without query is kept": {
+			url:      "https://host/papi/v1/groups",
+			expected: "/papi/v1/groups",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			u, err := url.Parse(test.url)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, scrubURI(u))
+		})
+	}
+}
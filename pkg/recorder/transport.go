@@ -0,0 +1,225 @@
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether the transport records or replays interactions
+type Mode string
+
+const (
+	// ModeRecord sends requests to the APIs and records the interactions to the cassette
+	ModeRecord Mode = "record"
+	// ModeReplay serves responses from the cassette without sending any requests
+	ModeReplay Mode = "replay"
+)
+
+// Modes returns the supported modes of the transport
+func Modes() []string {
+	return []string{string(ModeRecord), string(ModeReplay)}
+}
+
+// Transport records API interactions to a cassette file or replays them from it.
+// Credentials are scrubbed from recorded interactions, so that cassettes can be shared.
+type Transport struct {
+	// Base is the transport sending the requests in the record mode
+	Base  http.RoundTripper
+	state *cassetteState
+}
+
+// cassetteState is shared by all transports using the same cassette file in the provider process
+type cassetteState struct {
+	mu       sync.Mutex
+	mode     Mode
+	path     string
+	cassette *Cassette
+	progress *progress
+}
+
+var (
+	statesMu sync.Mutex
+	states   = map[string]*cassetteState{}
+)
+
+// NewTransport returns a transport recording interactions of base to the cassette file or replaying them from it.
+//
+// Recorded interactions are appended to the cassette, so that subsequent runs of the provider, such as plan
+// and apply, can be recorded to the same file. Replaying requires the cassette file to exist, and continues
+// from the interactions replayed by previous runs of the provider.
+func NewTransport(base http.RoundTripper, mode Mode, path string) (*Transport, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("unsupported recording mode %q, supported modes are: %s", mode, strings.Join(Modes(), ", "))
+	}
+	if path == "" {
+		return nil, fmt.Errorf("cassette file is required to %s API interactions", mode)
+	}
+
+	statesMu.Lock()
+	defer statesMu.Unlock()
+
+	if state, ok := states[path]; ok {
+		if state.mode != mode {
+			return nil, fmt.Errorf("cassette %s is already used in the %s mode", path, state.mode)
+		}
+		return &Transport{Base: base, state: state}, nil
+	}
+
+	state, err := openCassette(mode, path)
+	if err != nil {
+		return nil, err
+	}
+	states[path] = state
+	return &Transport{Base: base, state: state}, nil
+}
+
+func openCassette(mode Mode, path string) (*cassetteState, error) {
+	state := &cassetteState{mode: mode, path: path}
+	switch mode {
+	case ModeRecord:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		// progress of replaying the previous recording is no longer valid
+		if err := removeProgress(path); err != nil {
+			return nil, err
+		}
+		state.cassette = cassette
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(cassette.Interactions) == 0 {
+			return nil, fmt.Errorf("cassette %s contains no interactions to replay", path)
+		}
+		progress, err := loadProgress(path, len(cassette.Interactions))
+		if err != nil {
+			return nil, err
+		}
+		state.cassette = cassette
+		state.progress = progress
+	}
+	return state, nil
+}
+
+// RoundTrip records or replays the request, depending on the mode of the transport
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{
+		Method:  req.Method,
+		URL:     scrubURI(req.URL),
+		Headers: scrubHeaders(req.Header),
+		Body:    scrubBody(body),
+	}
+
+	if t.state.mode == ModeReplay {
+		return t.state.replay(req, recorded)
+	}
+	return t.record(req, recorded)
+}
+
+func (t *Transport) record(req *http.Request, recorded Request) (*http.Response, error) {
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	headers := scrubHeaders(resp.Header)
+	// scrubbing may change the length of the body
+	delete(headers, "Content-Length")
+
+	s := t.state
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cassette.Interactions = append(s.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       scrubBody(body),
+		},
+	})
+	// the cassette is saved after every interaction, as the provider process may be stopped at any time
+	if err := s.cassette.Save(s.path); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// replay serves the first matching interaction which has not been replayed yet, so that subsequent identical
+// requests, such as polling the status of an activation, receive the responses in the order they were recorded.
+// When all matching interactions were already replayed, the last of them is served again.
+func (s *cassetteState) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match := -1
+	for i, interaction := range s.cassette.Interactions {
+		if !interaction.Request.matches(recorded) {
+			continue
+		}
+		match = i
+		if !s.progress.replayed[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("no interaction recorded in cassette %s for request %s %s", s.path, recorded.Method, recorded.URL)
+	}
+	if err := s.progress.markReplayed(match); err != nil {
+		return nil, err
+	}
+
+	response := s.cassette.Interactions[match].Response
+	header := make(http.Header, len(response.Headers))
+	for name, values := range response.Headers {
+		header[name] = append([]string(nil), values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(response.Body)),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// matches reports whether the request is the same as the recorded one, ignoring headers.
+// URLs of both requests are scrubbed, so requests differing only in redacted query parameters match.
+func (r Request) matches(other Request) bool {
+	return r.Method == other.Method && r.URL == other.URL && r.Body == other.Body
+}
+
+// readRequestBody reads the body of the request and restores it, so that the request can still be sent
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
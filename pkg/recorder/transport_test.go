@@ -0,0 +1,153 @@
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProcess forgets the cassettes used so far, as if the provider was started again
+func newProcess() {
+	statesMu.Lock()
+	defer statesMu.Unlock()
+	states = map[string]*cassetteState{}
+}
+
+func TestTransport(t *testing.T) {
+	t.Cleanup(newProcess)
+	cassette := filepath.Join(t.TempDir(), "cassettes", "test.json")
+
+	status := "PENDING"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		switch {
+		case r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"name":"client","clientSecret":"s3cr3t"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1,"clientSecret":"s3cr3t"}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"` + status + `"}`))
+			status = "ACTIVE"
+		}
+	}))
+	defer server.Close()
+
+	send := func(t *testing.T, client *http.Client, method, path, body string) (int, string) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "EG1-HMAC-SHA256 client_token=akab-client;access_token=akab-access;signature=abc")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+		content, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(content)
+	}
+
+	t.Run("record", func(t *testing.T) {
+		transport, err := NewTransport(http.DefaultTransport, ModeRecord, cassette)
+		require.NoError(t, err)
+		client := &http.Client{Transport: transport}
+
+		code, body := send(t, client, http.MethodPost, "/identity-management/v3/api-clients", `{"name":"client","clientSecret":"s3cr3t"}`)
+		assert.Equal(t, http.StatusCreated, code)
+		assert.Equal(t, `{"id":1,"clientSecret":"s3cr3t"}`, body, "response is not scrubbed for the provider")
+
+		newProcess()
+		transport, err = NewTransport(http.DefaultTransport, ModeRecord, cassette)
+		require.NoError(t, err)
+		client = &http.Client{Transport: transport}
+		_, body = send(t, client, http.MethodGet, "/papi/v1/activations/1?contractId=ctr_1&accountSwitchKey=1-ABC", "")
+		assert.Equal(t, `{"status":"PENDING"}`, body)
+		_, body = send(t, client, http.MethodGet, "/papi/v1/activations/1?contractId=ctr_1&accountSwitchKey=1-ABC", "")
+		assert.Equal(t, `{"status":"ACTIVE"}`, body)
+
+		content, err := os.ReadFile(cassette)
+		require.NoError(t, err)
+		for _, secret := range []string{"s3cr3t", "akab-client", "akab-access", "signature", "session=secret", "127.0.0.1", "1-ABC"} {
+			assert.NotContains(t, string(content), secret)
+		}
+
+		recorded, err := LoadCassette(cassette)
+		require.NoError(t, err)
+		require.Len(t, recorded.Interactions, 3, "interactions of subsequent runs are appended")
+		assert.Equal(t, Request{
+			Method:  http.MethodPost,
+			URL:     "/identity-management/v3/api-clients",
+			Headers: recorded.Interactions[0].Request.Headers,
+			Body:    `{"clientSecret":"REDACTED","name":"client"}`,
+		}, recorded.Interactions[0].Request)
+		assert.Equal(t, `{"clientSecret":"REDACTED","id":1}`, recorded.Interactions[0].Response.Body)
+		assert.Equal(t, "/papi/v1/activations/1?accountSwitchKey=REDACTED&contractId=ctr_1", recorded.Interactions[1].Request.URL)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		server.Close()
+		newProcess()
+		transport, err := NewTransport(nil, ModeReplay, cassette)
+		require.NoError(t, err)
+		client := &http.Client{Transport: transport}
+
+		code, body := send(t, client, http.MethodPost, "/identity-management/v3/api-clients", `{"clientSecret":"other","name":"client"}`)
+		assert.Equal(t, http.StatusCreated, code)
+		assert.Equal(t, `{"clientSecret":"REDACTED","id":1}`, body)
+
+		_, body = send(t, client, http.MethodGet, "/papi/v1/activations/1?contractId=ctr_1&accountSwitchKey=1-XYZ", "")
+		assert.Equal(t, `{"status":"PENDING"}`, body)
+
+		// progress is kept for the next run of the provider
+		newProcess()
+		transport, err = NewTransport(nil, ModeReplay, cassette)
+		require.NoError(t, err)
+		client = &http.Client{Transport: transport}
+		_, body = send(t, client, http.MethodGet, "/papi/v1/activations/1?contractId=ctr_1&accountSwitchKey=1-ABC", "")
+		assert.Equal(t, `{"status":"ACTIVE"}`, body)
+		_, body = send(t, client, http.MethodGet, "/papi/v1/activations/1?contractId=ctr_1&accountSwitchKey=1-ABC", "")
+		assert.Equal(t, `{"status":"ACTIVE"}`, body, "last matching interaction is served again")
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/papi/v1/activations/2", nil)
+		require.NoError(t, err)
+		_, err = client.Do(req)
+		assert.ErrorContains(t, err, "no interaction recorded in cassette "+cassette+" for request GET /papi/v1/activations/2")
+	})
+
+	t.Run("recording again resets replay progress", func(t *testing.T) {
+		require.FileExists(t, ProgressFile(cassette))
+		newProcess()
+		_, err := NewTransport(http.DefaultTransport, ModeRecord, cassette)
+		require.NoError(t, err)
+		assert.NoFileExists(t, ProgressFile(cassette))
+	})
+}
+
+func TestNewTransport(t *testing.T) {
+	t.Cleanup(newProcess)
+	cassette := filepath.Join(t.TempDir(), "test.json")
+
+	_, err := NewTransport(http.DefaultTransport, ModeReplay, "")
+	assert.EqualError(t, err, "cassette file is required to replay API interactions")
+
+	_, err = NewTransport(http.DefaultTransport, "rewind", cassette)
+	assert.EqualError(t, err, `unsupported recording mode "rewind", supported modes are: record, replay`)
+
+	_, err = NewTransport(http.DefaultTransport, ModeReplay, cassette)
+	assert.EqualError(t, err, "cassette "+cassette+" contains no interactions to replay")
+
+	first, err := NewTransport(http.DefaultTransport, ModeRecord, cassette)
+	require.NoError(t, err)
+	second, err := NewTransport(http.DefaultTransport, ModeRecord, cassette)
+	require.NoError(t, err)
+	assert.Same(t, first.state, second.state)
+
+	_, err = NewTransport(http.DefaultTransport, ModeReplay, cassette)
+	assert.EqualError(t, err, "cassette "+cassette+" is already used in the record mode")
+}
@@ -395,6 +395,11 @@ type ErrorHandler func(resp *http.Response, err error, numTries int) (*http.Resp
 // PrepareRetry is called before retry operation. It can be used for example to re-sign the request
 type PrepareRetry func(req *http.Request) error
 
+// RetrySettings returns the maximum number of retries and the backoff policy for the request which resulted
+// in the response or error. It is called once CheckRetry decided to retry, with the same response and error,
+// and allows requests to different endpoints to be retried differently.
+type RetrySettings func(resp *http.Response, err error) (retryMax int, backoff Backoff)
+
 // Client is used to make HTTP requests. It adds additional functionality
 // like automatic retries to tolerate minor outages.
 type Client struct {
@@ -426,6 +431,9 @@ type Client struct {
 	// PrepareRetry can prepare the request for retry operation, for example re-sign it
 	PrepareRetry PrepareRetry
 
+	// RetrySettings, if set, overrides RetryMax and Backoff for each retry
+	RetrySettings RetrySettings
+
 	loggerInit sync.Once
 	clientInit sync.Once
 }
@@ -710,9 +718,14 @@ func (c *Client) Do(req *Request) (*http.Response, error) {
 			break
 		}
 
+		retryMax, backoff := c.RetryMax, c.Backoff
+		if c.RetrySettings != nil {
+			retryMax, backoff = c.RetrySettings(resp, err)
+		}
+
 		// We do this before drainBody because there's no need for the I/O if
 		// we're breaking out
-		remain := c.RetryMax - i
+		remain := retryMax - i
 		if remain <= 0 {
 			break
 		}
@@ -722,7 +735,7 @@ func (c *Client) Do(req *Request) (*http.Response, error) {
 			c.drainBody(resp.Body)
 		}
 
-		wait := c.Backoff(c.RetryWaitMin, c.RetryWaitMax, i, resp)
+		wait := backoff(c.RetryWaitMin, c.RetryWaitMax, i, resp)
 		if logger != nil {
 			desc := fmt.Sprintf("%s %s", req.Method, req.URL)
 			if resp != nil {
@@ -1155,6 +1155,39 @@ func TestClient_BackoffCustom(t *testing.T) {
 	}
 }
 
+func TestClient_RetrySettings(t *testing.T) {
+	var requests, backoffs int32
+
+	client := NewClient()
+	client.RetryMax = 10
+	client.RetrySettings = func(resp *http.Response, _ error) (int, Backoff) {
+		if resp.Request.URL.Path == "/foo/bar" {
+			return 2, func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+				atomic.AddInt32(&backoffs, 1)
+				return time.Millisecond
+			}
+		}
+		return client.RetryMax, client.Backoff
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(500)
+	}))
+	defer ts.Close()
+
+	_, err := client.Get(ts.URL + "/foo/bar")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if requests != 3 {
+		t.Fatalf("expected requests: 3 != %d", requests)
+	}
+	if backoffs != 2 {
+		t.Fatalf("expected backoffs: 2 != %d", backoffs)
+	}
+}
+
 func TestClient_StandardClient(t *testing.T) {
 	// Create a retryable HTTP client.
 	client := NewClient()
@@ -2,7 +2,11 @@
 package subprovider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,4 +24,16 @@ type Subprovider interface {
 
 	// FrameworkDataSources returns the data sources implemented using terraform-plugin-framework
 	FrameworkDataSources() []func() datasource.DataSource
+
+	// FrameworkFunctions returns the provider-defined functions implemented using terraform-plugin-framework
+	FrameworkFunctions() []func() function.Function
+
+	// FrameworkEphemeralResources returns the ephemeral resources implemented using terraform-plugin-framework
+	FrameworkEphemeralResources() []func() ephemeral.EphemeralResource
+
+	// FrameworkListResources returns the list resources implemented using terraform-plugin-framework
+	FrameworkListResources() []func() list.ListResource
+
+	// FrameworkActions returns the actions implemented using terraform-plugin-framework
+	FrameworkActions() []func() action.Action
 }